@@ -5,6 +5,7 @@ import (
 	"github.com/iotaledger/hive.go/app/components/profiling"
 	"github.com/iotaledger/hive.go/app/components/shutdown"
 	"github.com/iotaledger/inx-app/components/inx"
+	"github.com/iotaledger/inx-indexer/components/graphql"
 	"github.com/iotaledger/inx-indexer/components/indexer"
 	"github.com/iotaledger/inx-indexer/components/prometheus"
 	"github.com/iotaledger/inx-indexer/pkg/toolset"
@@ -24,6 +25,7 @@ func App() *app.App {
 		app.WithComponents(
 			inx.Component,
 			indexer.Component,
+			graphql.Component,
 			shutdown.Component,
 			profiling.Component,
 			prometheus.Component,
@@ -0,0 +1,55 @@
+package graphql
+
+import (
+	"github.com/labstack/echo/v4"
+	"go.uber.org/dig"
+
+	"github.com/iotaledger/hive.go/app"
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/inx-app/pkg/nodebridge"
+	"github.com/iotaledger/inx-indexer/pkg/apitypes"
+	"github.com/iotaledger/inx-indexer/pkg/graphql"
+	"github.com/iotaledger/inx-indexer/pkg/indexer"
+)
+
+func init() {
+	Component = &app.Component{
+		Name:      "GraphQL",
+		DepsFunc:  func(cDeps dependencies) { deps = cDeps },
+		Params:    params,
+		Configure: configure,
+		IsEnabled: func(*dig.Container) bool {
+			return ParamsGraphQL.Enabled
+		},
+	}
+}
+
+type dependencies struct {
+	dig.In
+	Indexer    *indexer.Indexer
+	Echo       *echo.Echo
+	NodeBridge nodebridge.NodeBridge
+}
+
+var (
+	Component *app.Component
+	deps      dependencies
+)
+
+func configure() error {
+	graphQLServer, err := graphql.NewServer(deps.Indexer, deps.NodeBridge.APIProvider(),
+		graphql.WithMaxPageSize(uint32(ParamsGraphQL.MaxPageSize)),
+		graphql.WithDefaultPageSize(uint32(ParamsGraphQL.DefaultPageSize)),
+		graphql.WithMaxDepth(ParamsGraphQL.MaxDepth),
+		graphql.WithMaxNodes(ParamsGraphQL.MaxNodes),
+		graphql.WithMaxParallelism(ParamsGraphQL.MaxParallelism),
+	)
+	if err != nil {
+		return ierrors.Wrap(err, "failed to create GraphQL server")
+	}
+
+	// the routes are served by the REST API server, which is started by the indexer component
+	graphQLServer.ConfigureRoutes(deps.Echo.Group(apitypes.APIRoute))
+
+	return nil
+}
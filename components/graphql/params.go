@@ -0,0 +1,30 @@
+package graphql
+
+import (
+	"github.com/iotaledger/hive.go/app"
+)
+
+// ParametersGraphQL contains the definition of the parameters used by the GraphQL API.
+type ParametersGraphQL struct {
+	// Enabled defines whether the GraphQL API is enabled.
+	Enabled bool `default:"false" usage:"whether the GraphQL API is enabled"`
+	// MaxPageSize defines the maximum number of outputs that may be returned for each connection.
+	MaxPageSize int `default:"1000" usage:"the maximum number of outputs that may be returned for each connection"`
+	// DefaultPageSize defines the number of outputs that are returned for each connection if "first" is not given.
+	DefaultPageSize int `default:"20" usage:"the number of outputs that are returned for each connection if \"first\" is not given"`
+	// MaxDepth defines the maximum nesting depth of a query.
+	MaxDepth int `default:"10" usage:"the maximum nesting depth of a query"`
+	// MaxNodes defines the maximum number of nodes a query may resolve.
+	MaxNodes int `default:"5000" usage:"the maximum number of nodes a query may resolve (every connection counts with its page size)"`
+	// MaxParallelism defines the maximum number of resolvers of a query that run concurrently.
+	MaxParallelism int `default:"4" usage:"the maximum number of resolvers of a query that run concurrently"`
+}
+
+var ParamsGraphQL = &ParametersGraphQL{}
+
+var params = &app.ComponentParams{
+	Params: map[string]any{
+		"graphQL": ParamsGraphQL,
+	},
+	Masked: nil,
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"net"
 	"net/http"
 	"slices"
 	"strings"
@@ -19,19 +20,24 @@ import (
 	"github.com/iotaledger/hive.go/db"
 	"github.com/iotaledger/hive.go/ds/shrinkingmap"
 	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/hive.go/runtime/options"
 	"github.com/iotaledger/hive.go/runtime/timeutil"
 	"github.com/iotaledger/hive.go/sql"
 	"github.com/iotaledger/inx-app/pkg/httpserver"
 	"github.com/iotaledger/inx-app/pkg/nodebridge"
+	"github.com/iotaledger/inx-indexer/pkg/apitypes"
+	"github.com/iotaledger/inx-indexer/pkg/auth"
 	"github.com/iotaledger/inx-indexer/pkg/daemon"
+	"github.com/iotaledger/inx-indexer/pkg/grpc"
 	"github.com/iotaledger/inx-indexer/pkg/indexer"
 	"github.com/iotaledger/inx-indexer/pkg/server"
+	"github.com/iotaledger/inx-indexer/pkg/webhook"
 	inx "github.com/iotaledger/inx/go"
 	iotago "github.com/iotaledger/iota.go/v4"
 )
 
 const (
-	DBVersion uint32 = 2
+	DBVersion uint32 = 16
 )
 
 func init() {
@@ -48,6 +54,7 @@ type dependencies struct {
 	dig.In
 	NodeBridge      nodebridge.NodeBridge
 	Indexer         *indexer.Indexer
+	Webhooks        *webhook.Manager
 	ShutdownHandler *shutdown.ShutdownHandler
 	Echo            *echo.Echo
 }
@@ -58,46 +65,167 @@ var (
 )
 
 func provide(c *dig.Container) error {
-	if err := c.Provide(func() (*indexer.Indexer, error) {
+	if err := c.Provide(func(nodeBridge nodebridge.NodeBridge) (*indexer.Indexer, error) {
 		Component.LogInfo("Setting up database ...")
 
-		engine := db.EngineFromString(ParamsIndexer.Database.Engine)
+		dbParams, err := databaseParameters("indexer.db")
+		if err != nil {
+			return nil, err
+		}
 
-		dbParams := sql.DatabaseParameters{
-			Engine: engine,
+		opts := []options.Option[indexer.Indexer]{
+			indexer.WithChangeLogRetention(iotago.SlotIndex(ParamsIndexer.ChangeLog.RetentionSlots)),
+		}
+		if ParamsIndexer.History.Enabled {
+			opts = append(opts, indexer.WithHistoryRetention(iotago.SlotIndex(ParamsIndexer.History.RetentionSlots)))
 		}
 
-		//nolint:exhaustive // we already checked the values is one of the valid ones
-		switch engine {
-		case db.EngineSQLite:
-			dbParams.Path = ParamsIndexer.Database.SQLite.Path
-			dbParams.Filename = "indexer.db"
+		return indexer.NewIndexer(dbParams, nodeBridge.APIProvider(), Component.Logger, opts...)
+	}); err != nil {
+		return err
+	}
 
-		case db.EnginePostgreSQL:
-			dbParams.Host = ParamsIndexer.Database.PostgreSQL.Host
-			dbParams.Port = ParamsIndexer.Database.PostgreSQL.Port
-			dbParams.Database = ParamsIndexer.Database.PostgreSQL.Database
-			dbParams.Username = ParamsIndexer.Database.PostgreSQL.Username
-			dbParams.Password = ParamsIndexer.Database.PostgreSQL.Password
+	if err := c.Provide(func(nodeBridge nodebridge.NodeBridge, idx *indexer.Indexer) (*webhook.Manager, error) {
+		if !ParamsIndexer.Webhooks.Enabled {
+			return nil, nil
+		}
 
-		default:
-			return nil, ierrors.Errorf("unknown database engine: %s, supported engines: %s", dbParams.Engine, db.GetSupportedEnginesString(indexer.AllowedEngines))
+		Component.LogInfo("Setting up webhooks ...")
+
+		// The watches are kept in their own database, so that they survive a re-import of the ledger
+		dbParams, err := databaseParameters("webhooks.db")
+		if err != nil {
+			return nil, err
 		}
 
-		return indexer.NewIndexer(dbParams, Component.Logger)
+		parseFilter := func(filter string) ([]options.Option[indexer.CombinedFilterOptions], error) {
+			return server.ParseCombinedOutputFilters(nodeBridge.APIProvider().CommittedAPI().ProtocolParameters().Bech32HRP(), filter)
+		}
+
+		return webhook.NewManager(dbParams, idx, parseFilter, Component.Logger.NewChildLogger("Webhooks"),
+			webhook.WithMaxAttempts(ParamsIndexer.Webhooks.MaxAttempts),
+			webhook.WithRetryInterval(ParamsIndexer.Webhooks.RetryInterval, ParamsIndexer.Webhooks.MaxRetryInterval),
+			webhook.WithTimeout(ParamsIndexer.Webhooks.Timeout),
+			webhook.WithDeliveryRetention(ParamsIndexer.Webhooks.DeliveryRetention),
+			webhook.WithMaxWatches(ParamsIndexer.Webhooks.MaxWatches),
+			webhook.WithAllowedHosts(ParamsIndexer.Webhooks.AllowedHosts...),
+			webhook.WithPrivateTargetsAllowed(ParamsIndexer.Webhooks.AllowPrivateTargets),
+			webhook.WithMaxConcurrentDeliveries(ParamsIndexer.Webhooks.MaxConcurrentDeliveries),
+		)
 	}); err != nil {
 		return err
 	}
 
-	return c.Provide(func() *echo.Echo {
-		return httpserver.NewEcho(
+	return c.Provide(func() (*echo.Echo, error) {
+		e := httpserver.NewEcho(
 			Component.Logger,
 			nil,
 			ParamsRestAPI.DebugRequestLoggerEnabled,
 		)
+
+		ipExtractor, err := newIPExtractor()
+		if err != nil {
+			return nil, err
+		}
+		e.IPExtractor = ipExtractor
+
+		if ParamsRestAPI.Auth.Enabled || ParamsRestAPI.RateLimit.Enabled {
+			authenticator, err := newAuthenticator()
+			if err != nil {
+				return nil, err
+			}
+			e.Use(authenticator.Middleware())
+		}
+
+		return e, nil
 	})
 }
 
+// newIPExtractor returns the extractor of the IP addresses of the clients, which identify the clients for rate limiting.
+// The X-Forwarded-For header is only used if trusted proxies are configured, otherwise clients could spoof their IP address.
+func newIPExtractor() (echo.IPExtractor, error) {
+	if len(ParamsRestAPI.TrustedProxies) == 0 {
+		return echo.ExtractIPDirect(), nil
+	}
+
+	trustOptions := []echo.TrustOption{
+		echo.TrustLoopback(false),
+		echo.TrustLinkLocal(false),
+		echo.TrustPrivateNet(false),
+	}
+	for _, proxy := range ParamsRestAPI.TrustedProxies {
+		_, ipRange, err := net.ParseCIDR(proxy)
+		if err != nil {
+			return nil, ierrors.Wrapf(err, "invalid trusted proxy range %s", proxy)
+		}
+		trustOptions = append(trustOptions, echo.TrustIPRange(ipRange))
+	}
+
+	return echo.ExtractIPFromXFFHeader(trustOptions...), nil
+}
+
+// newAuthenticator creates the authenticator of the REST API from the configured API keys, JWT secret and rate limits.
+func newAuthenticator() (*auth.Authenticator, error) {
+	var opts []options.Option[auth.Authenticator]
+
+	if ParamsRestAPI.Auth.Enabled {
+		apiKeys := make(map[string]*auth.Client, len(ParamsRestAPI.Auth.APIKeys))
+		for _, apiKey := range ParamsRestAPI.Auth.APIKeys {
+			key, client, err := auth.ParseAPIKey(apiKey)
+			if err != nil {
+				return nil, err
+			}
+			apiKeys[key] = client
+		}
+
+		if len(apiKeys) == 0 && ParamsRestAPI.Auth.JWTSecret == "" {
+			return nil, ierrors.New("authentication of the REST API is enabled, but neither API keys nor a JWT secret are configured")
+		}
+
+		opts = append(opts,
+			auth.WithAPIKeys(apiKeys),
+			auth.WithJWTSecret(ParamsRestAPI.Auth.JWTSecret),
+			auth.WithPublicRoutes(ParamsRestAPI.Auth.PublicRoutes...),
+			auth.WithFailedAttemptsLimit(ParamsRestAPI.Auth.FailedAttemptsPerMinute),
+		)
+	}
+
+	if ParamsRestAPI.RateLimit.Enabled {
+		opts = append(opts, auth.WithRateLimit(ParamsRestAPI.RateLimit.RequestsPerMinute, ParamsRestAPI.RateLimit.Burst))
+	}
+
+	return auth.New(opts...), nil
+}
+
+// databaseParameters returns the parameters of the configured database engine.
+// The filename is only used by the SQLite engine, all other engines share the configured database.
+func databaseParameters(filename string) (sql.DatabaseParameters, error) {
+	engine := db.EngineFromString(ParamsIndexer.Database.Engine)
+
+	dbParams := sql.DatabaseParameters{
+		Engine: engine,
+	}
+
+	//nolint:exhaustive // we already checked the values is one of the valid ones
+	switch engine {
+	case db.EngineSQLite:
+		dbParams.Path = ParamsIndexer.Database.SQLite.Path
+		dbParams.Filename = filename
+
+	case db.EnginePostgreSQL:
+		dbParams.Host = ParamsIndexer.Database.PostgreSQL.Host
+		dbParams.Port = ParamsIndexer.Database.PostgreSQL.Port
+		dbParams.Database = ParamsIndexer.Database.PostgreSQL.Database
+		dbParams.Username = ParamsIndexer.Database.PostgreSQL.Username
+		dbParams.Password = ParamsIndexer.Database.PostgreSQL.Password
+
+	default:
+		return dbParams, ierrors.Errorf("unknown database engine: %s, supported engines: %s", dbParams.Engine, db.GetSupportedEnginesString(indexer.AllowedEngines))
+	}
+
+	return dbParams, nil
+}
+
 func run() error {
 	indexerInitWait := make(chan struct{})
 
@@ -227,6 +355,107 @@ func run() error {
 		Component.LogPanicf("failed to start worker: %s", err)
 	}
 
+	if ParamsIndexer.History.Enabled && ParamsIndexer.History.RetentionSlots > 0 {
+		// create a background worker that prunes the spent outputs that are outside of the retention window
+		if err := Component.Daemon().BackgroundWorker("Indexer - HistoryPruning", func(ctx context.Context) {
+			Component.LogInfo("Starting HistoryPruning")
+
+			// we need to wait until the indexer is initialized before starting to prune.
+			select {
+			case <-ctx.Done():
+				return
+			case <-indexerInitWait:
+			}
+
+			Component.LogInfo("Starting HistoryPruning ... done")
+
+			ticker := timeutil.NewTicker(func() {
+				ts := time.Now()
+				pruned, err := deps.Indexer.PruneHistory()
+				if err != nil {
+					Component.LogWarnf("Pruning spent outputs failed, error: %s", err)
+					return
+				}
+
+				if pruned > 0 {
+					Component.LogInfof("Pruning %d spent outputs took %s", pruned, time.Since(ts).Truncate(time.Millisecond))
+				}
+			}, ParamsIndexer.History.PruningInterval, ctx)
+
+			<-ctx.Done()
+			ticker.WaitForGracefulShutdown()
+
+			Component.LogInfo("Stopping HistoryPruning ... done")
+		}, daemon.PriorityStopIndexerHistoryPruning); err != nil {
+			Component.LogPanicf("failed to start worker: %s", err)
+		}
+	}
+
+	if ParamsIndexer.ChangeLog.RetentionSlots > 0 {
+		// create a background worker that prunes the entries of the change log that are outside of the retention window
+		if err := Component.Daemon().BackgroundWorker("Indexer - ChangeLogPruning", func(ctx context.Context) {
+			Component.LogInfo("Starting ChangeLogPruning")
+
+			// we need to wait until the indexer is initialized before starting to prune.
+			select {
+			case <-ctx.Done():
+				return
+			case <-indexerInitWait:
+			}
+
+			Component.LogInfo("Starting ChangeLogPruning ... done")
+
+			ticker := timeutil.NewTicker(func() {
+				ts := time.Now()
+				pruned, err := deps.Indexer.PruneChangeLog()
+				if err != nil {
+					Component.LogWarnf("Pruning change log failed, error: %s", err)
+					return
+				}
+
+				if pruned > 0 {
+					Component.LogInfof("Pruning %d change log entries took %s", pruned, time.Since(ts).Truncate(time.Millisecond))
+				}
+			}, ParamsIndexer.ChangeLog.PruningInterval, ctx)
+
+			<-ctx.Done()
+			ticker.WaitForGracefulShutdown()
+
+			Component.LogInfo("Stopping ChangeLogPruning ... done")
+		}, daemon.PriorityStopIndexerChangeLogPruning); err != nil {
+			Component.LogPanicf("failed to start worker: %s", err)
+		}
+	}
+
+	if deps.Webhooks != nil {
+		// create a background worker that delivers the notifications of the webhooks
+		if err := Component.Daemon().BackgroundWorker("Indexer - Webhooks", func(ctx context.Context) {
+			Component.LogInfo("Starting Webhooks")
+			defer func() {
+				if err := deps.Webhooks.Close(); err != nil {
+					Component.LogErrorf("Failed to close webhooks database: %s", err.Error())
+				}
+			}()
+
+			// we need to wait until the indexer is initialized before subscribing to its outputs.
+			select {
+			case <-ctx.Done():
+				return
+			case <-indexerInitWait:
+			}
+
+			Component.LogInfo("Starting Webhooks ... done")
+
+			if err := deps.Webhooks.Run(ctx); err != nil {
+				deps.ShutdownHandler.SelfShutdown(fmt.Sprintf("Delivering webhooks failed, error: %s", err), false)
+			}
+
+			Component.LogInfo("Stopping Webhooks ... done")
+		}, daemon.PriorityStopIndexerWebhooks); err != nil {
+			Component.LogPanicf("failed to start worker: %s", err)
+		}
+	}
+
 	// create a background worker that handles the API
 	if err := Component.Daemon().BackgroundWorker("API", func(ctx context.Context) {
 		Component.LogInfo("Starting API")
@@ -241,7 +470,7 @@ func run() error {
 
 		Component.LogInfo("Starting API server ...")
 
-		_ = server.NewIndexerServer(deps.Indexer, deps.Echo, deps.NodeBridge, ParamsRestAPI.MaxPageSize)
+		_ = server.NewIndexerServer(deps.Indexer, deps.Webhooks, deps.Echo, deps.NodeBridge, Component.App().Info().Version, ParamsRestAPI.MaxPageSize, ParamsRestAPI.MaxAddressesPerRequest, ParamsRestAPI.MaxSubscriptions, ParamsRestAPI.MaxSubscriptionsPerClient, ParamsIndexer.Webhooks.AdminKey)
 
 		go func() {
 			Component.LogInfof("You can now access the API using: http://%s", ParamsRestAPI.BindAddress)
@@ -257,8 +486,8 @@ func run() error {
 			advertisedAddress = ParamsRestAPI.AdvertiseAddress
 		}
 
-		routeName := strings.Replace(server.APIRoute, "/api/", "", 1)
-		if err := deps.NodeBridge.RegisterAPIRoute(ctxRegister, routeName, advertisedAddress, server.APIRoute); err != nil {
+		routeName := strings.Replace(apitypes.APIRoute, "/api/", "", 1)
+		if err := deps.NodeBridge.RegisterAPIRoute(ctxRegister, routeName, advertisedAddress, apitypes.APIRoute); err != nil {
 			Component.LogFatalf("Registering INX api route failed: %s", err)
 		}
 		cancelRegister()
@@ -288,6 +517,52 @@ func run() error {
 		Component.LogPanicf("failed to start worker: %s", err)
 	}
 
+	if ParamsGRPC.Enabled {
+		// create a background worker that handles the gRPC server
+		if err := Component.Daemon().BackgroundWorker("gRPC", func(ctx context.Context) {
+			Component.LogInfo("Starting gRPC server ...")
+
+			// we need to wait until the indexer is initialized before starting the gRPC server or the daemon is canceled before that is done.
+			select {
+			case <-ctx.Done():
+				return
+			case <-indexerInitWait:
+			}
+
+			listener, err := net.Listen("tcp", ParamsGRPC.BindAddress)
+			if err != nil {
+				Component.LogFatalf("failed to listen on %s: %s", ParamsGRPC.BindAddress, err)
+			}
+
+			grpcServer := grpc.NewServer(deps.Indexer, deps.NodeBridge.APIProvider(),
+				grpc.WithMaxPageSize(uint32(ParamsGRPC.MaxPageSize)),
+				grpc.WithMaxAddresses(ParamsGRPC.MaxAddressesPerRequest),
+				grpc.WithMaxStreams(ParamsGRPC.MaxStreams),
+			)
+
+			go func() {
+				Component.LogInfof("You can now access the gRPC server using: %s", ParamsGRPC.BindAddress)
+				if err := grpcServer.Serve(listener); err != nil {
+					Component.LogFatalf("Stopped gRPC server due to an error (%s)", err)
+				}
+			}()
+
+			Component.LogInfo("Starting gRPC server ... done")
+			<-ctx.Done()
+			Component.LogInfo("Stopping gRPC server ...")
+
+			shutdownCtx, shutdownCtxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer shutdownCtxCancel()
+
+			//nolint:contextcheck // false positive
+			grpcServer.Shutdown(shutdownCtx)
+
+			Component.LogInfo("Stopping gRPC server ... done")
+		}, daemon.PriorityStopIndexerGRPC); err != nil {
+			Component.LogPanicf("failed to start worker: %s", err)
+		}
+	}
+
 	return nil
 }
 
@@ -372,6 +647,17 @@ func checkIndexerStatus(ctx context.Context) (*indexer.Status, error) {
 			return nil, err
 		}
 
+		if !ParamsIndexer.History.Enabled {
+			// Clean up spent outputs that might have been kept while the history was enabled
+			pruned, err := deps.Indexer.PruneHistory()
+			if err != nil {
+				return nil, err
+			}
+			if pruned > 0 {
+				Component.LogInfof("Removed %d spent outputs because the history is disabled", pruned)
+			}
+		}
+
 		Component.LogInfof("> Indexer started at committedSlot %d", status.CommittedSlot)
 	}
 
@@ -1,6 +1,8 @@
 package indexer
 
 import (
+	"time"
+
 	"github.com/iotaledger/hive.go/app"
 )
 
@@ -29,6 +31,60 @@ type ParametersIndexer struct {
 			Port uint `default:"5432" usage:"database port"`
 		} `name:"postgresql"`
 	} `name:"db"`
+
+	History struct {
+		// Enabled defines whether committed spent outputs are kept in the database instead of being deleted
+		Enabled bool `default:"false" usage:"whether committed spent outputs are kept in the database instead of being deleted"`
+
+		// RetentionSlots defines the amount of slots spent outputs are kept in the database
+		RetentionSlots uint32 `default:"0" usage:"the amount of slots spent outputs are kept in the database (0 = keep forever)"`
+
+		// PruningInterval defines the interval in which spent outputs outside of the retention window are pruned
+		PruningInterval time.Duration `default:"1m" usage:"the interval in which spent outputs outside of the retention window are pruned"`
+	}
+
+	ChangeLog struct {
+		// RetentionSlots defines the amount of slots the entries of the change log are kept in the database
+		RetentionSlots uint32 `default:"8640" usage:"the amount of slots the entries of the change log are kept in the database (0 = keep forever)"`
+
+		// PruningInterval defines the interval in which the entries of the change log outside of the retention window are pruned
+		PruningInterval time.Duration `default:"1m" usage:"the interval in which the entries of the change log outside of the retention window are pruned"`
+	}
+
+	Webhooks struct {
+		// Enabled defines whether notifications about the outputs of registered watches are posted to their URLs
+		Enabled bool `default:"false" usage:"whether notifications about the outputs of registered watches are posted to their URLs"`
+
+		// MaxAttempts defines the maximum number of attempts to deliver a notification
+		MaxAttempts uint `default:"10" usage:"the maximum number of attempts to deliver a notification"`
+
+		// RetryInterval defines the interval before the first retry of a failed delivery, it is doubled for every further attempt
+		RetryInterval time.Duration `default:"10s" usage:"the interval before the first retry of a failed delivery, it is doubled for every further attempt"`
+
+		// MaxRetryInterval defines the maximum interval between two delivery attempts
+		MaxRetryInterval time.Duration `default:"1h" usage:"the maximum interval between two delivery attempts"`
+
+		// Timeout defines the timeout of a single delivery attempt
+		Timeout time.Duration `default:"10s" usage:"the timeout of a single delivery attempt"`
+
+		// DeliveryRetention defines for how long finished deliveries are kept in the delivery log
+		DeliveryRetention time.Duration `default:"24h" usage:"for how long finished deliveries are kept in the delivery log"`
+
+		// AdminKey defines the key that needs to be sent in the X-Admin-Key header to manage the watches via the REST API
+		AdminKey string `default:"" usage:"the key that needs to be sent in the X-Admin-Key header to manage the watches via the REST API (empty = webhook routes disabled)"`
+
+		// MaxWatches defines the maximum number of registered watches
+		MaxWatches int `default:"100" usage:"the maximum number of registered watches"`
+
+		// AllowedHosts defines the hosts the notifications may be posted to
+		AllowedHosts []string `usage:"the hosts the notifications may be posted to (\"*.\" prefix matches subdomains, empty = all public hosts)"`
+
+		// AllowPrivateTargets defines whether notifications may be posted to loopback, private and link-local addresses
+		AllowPrivateTargets bool `default:"false" usage:"whether notifications may be posted to loopback, private and link-local addresses"`
+
+		// MaxConcurrentDeliveries defines the maximum number of webhooks whose notifications are delivered concurrently
+		MaxConcurrentDeliveries int `default:"10" usage:"the maximum number of webhooks whose notifications are delivered concurrently"`
+	}
 }
 
 // ParametersRestAPI contains the definition of the parameters used by the Indexer HTTP server.
@@ -42,17 +98,77 @@ type ParametersRestAPI struct {
 	// MaxPageSize defines the maximum number of results that may be returned for each page
 	MaxPageSize int `default:"1000" usage:"the maximum number of results that may be returned for each page"`
 
+	// MaxAddressesPerRequest defines the maximum number of addresses that may be queried in a single request
+	MaxAddressesPerRequest int `default:"1000" usage:"the maximum number of addresses that may be queried in a single request"`
+
+	// MaxSubscriptions defines the maximum number of concurrent output event subscriptions
+	MaxSubscriptions int `default:"100" usage:"the maximum number of concurrent output event subscriptions"`
+
+	// MaxSubscriptionsPerClient defines the maximum number of concurrent output event subscriptions of a single client
+	MaxSubscriptionsPerClient int `default:"5" usage:"the maximum number of concurrent output event subscriptions of a single client"`
+
+	// TrustedProxies defines the IP ranges of the reverse proxies whose X-Forwarded-For header is trusted
+	TrustedProxies []string `usage:"the IP ranges (CIDR) of the reverse proxies whose X-Forwarded-For header is trusted (empty = the IP address of the connection is used)"`
+
 	// DebugRequestLoggerEnabled defines whether the debug logging for requests should be enabled
 	DebugRequestLoggerEnabled bool `default:"false" usage:"whether the debug logging for requests should be enabled"`
+
+	Auth struct {
+		// Enabled defines whether requests to non-public routes need to be authenticated by an API key or a JWT
+		Enabled bool `default:"false" usage:"whether requests to non-public routes need to be authenticated by an API key or a JWT"`
+
+		// APIKeys defines the API keys accepted in the X-API-Key header
+		APIKeys []string `usage:"the API keys accepted in the X-API-Key header, in the format \"key[:requestsPerMinute[:maxPageSize]]\""`
+
+		// JWTSecret defines the secret used to verify HS256 signed JWTs in the Authorization header, the JWTs need to contain an expiration
+		JWTSecret string `default:"" usage:"the secret used to verify HS256 signed JWTs with an expiration in the Authorization header (optional)"`
+
+		// PublicRoutes defines the routes that can be accessed without authentication
+		PublicRoutes []string `default:"/api/indexer/v2/health,/api/indexer/v2/openapi.json" usage:"the routes that can be accessed without authentication (\"*\" suffix matches prefixes)"`
+
+		// FailedAttemptsPerMinute defines the number of failed authentication attempts per minute of an IP address
+		FailedAttemptsPerMinute int `default:"10" usage:"the number of failed authentication attempts per minute of an IP address, further requests are rejected (0 = unlimited)"`
+	}
+
+	RateLimit struct {
+		// Enabled defines whether the requests per client are rate limited
+		Enabled bool `default:"false" usage:"whether the requests per client are rate limited"`
+
+		// RequestsPerMinute defines the default number of requests per minute of a client
+		RequestsPerMinute int `default:"600" usage:"the default number of requests per minute of a client"`
+
+		// Burst defines the number of requests a client may send at once
+		Burst int `default:"20" usage:"the number of requests a client may send at once"`
+	}
+}
+
+// ParametersGRPC contains the definition of the parameters used by the Indexer gRPC server.
+type ParametersGRPC struct {
+	// Enabled defines whether the gRPC server is enabled.
+	Enabled bool `default:"false" usage:"whether the gRPC server is enabled"`
+
+	// BindAddress defines the bind address on which the Indexer gRPC server listens.
+	BindAddress string `default:"localhost:9092" usage:"the bind address on which the Indexer gRPC server listens"`
+
+	// MaxPageSize defines the maximum number of results that may be returned for each page
+	MaxPageSize int `default:"1000" usage:"the maximum number of results that may be returned for each page"`
+
+	// MaxAddressesPerRequest defines the maximum number of addresses that may be queried in a single request
+	MaxAddressesPerRequest int `default:"1000" usage:"the maximum number of addresses that may be queried in a single request"`
+
+	// MaxStreams defines the maximum number of concurrent streams
+	MaxStreams int `default:"100" usage:"the maximum number of concurrent streams"`
 }
 
 var ParamsIndexer = &ParametersIndexer{}
 var ParamsRestAPI = &ParametersRestAPI{}
+var ParamsGRPC = &ParametersGRPC{}
 
 var params = &app.ComponentParams{
 	Params: map[string]any{
 		"indexer": ParamsIndexer,
 		"restAPI": ParamsRestAPI,
+		"grpc":    ParamsGRPC,
 	},
-	Masked: nil,
+	Masked: []string{"indexer.webhooks.adminKey", "restAPI.auth.apiKeys", "restAPI.auth.jwtSecret"},
 }
@@ -0,0 +1,20 @@
+package apitypes
+
+// AddressesRequest defines the request body of a POST REST API call that takes a list of addresses.
+type AddressesRequest struct {
+	// The bech32 encoded addresses.
+	Addresses []string `serix:",lenPrefix=uint16"`
+}
+
+// WebhookRequest defines the request body of a POST webhooks REST API call.
+// Either the address or the filter has to be given.
+type WebhookRequest struct {
+	// The bech32 encoded address the outputs are unlockable by.
+	Address string `serix:",lenPrefix=uint8,omitempty"`
+	// The query parameters of the combined outputs endpoint the outputs are matched against, e.g. "unlockableByAddress=...&minAmount=1000".
+	Filter string `serix:",lenPrefix=uint16,omitempty"`
+	// The URL the notifications are posted to.
+	URL string `serix:",lenPrefix=uint16"`
+	// The key of the HMAC-SHA256 signatures of the notifications. A secret is generated if none is given.
+	Secret string `serix:",lenPrefix=uint8,omitempty"`
+}
@@ -0,0 +1,256 @@
+package apitypes
+
+import (
+	"math/big"
+
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+// BalanceResponse defines the response of a GET balance REST API call.
+type BalanceResponse struct {
+	// The committed slot at which the balance was calculated.
+	CommittedSlot iotago.SlotIndex `serix:""`
+	// The balance of the outputs that are directly owned by the address.
+	Owned *BalanceEntry `serix:""`
+	// The balance of the outputs that can be unlocked by the address via an expiration or storage deposit return unlock condition.
+	Unlockable *BalanceEntry `serix:""`
+}
+
+// OutputEventResponse defines the data of an event sent on the event stream of an output list endpoint.
+type OutputEventResponse struct {
+	// The outputID of the output that was created or spent.
+	OutputID iotago.OutputID `serix:""`
+	// The slot of the ledger update that created or spent the output.
+	Slot iotago.SlotIndex `serix:""`
+	// Whether the output was spent instead of created.
+	Spent bool `serix:""`
+	// Whether the ledger update was committed instead of only accepted.
+	Committed bool `serix:""`
+}
+
+// ChangesResponse defines the response of a GET changes REST API call.
+type ChangesResponse struct {
+	// The ID of the change log the sequence numbers belong to, it changes whenever the ledger is re-imported.
+	ChangeLogID string `serix:",lenPrefix=uint8"`
+	// The committed slot at which the changes were read.
+	CommittedSlot iotago.SlotIndex `serix:""`
+	// The maximum amount of items returned in one call.
+	PageSize uint32 `serix:""`
+	// The sequence number to continue reading from.
+	NextSequenceNumber uint64 `serix:""`
+	// The entries of the change log.
+	Items []*ChangeResponse `serix:",lenPrefix=uint16"`
+}
+
+// ChangeResponse defines an entry of the change log.
+type ChangeResponse struct {
+	// The position of the change in the change log.
+	SequenceNumber uint64 `serix:""`
+	// The slot of the committed ledger update that created or spent the output.
+	Slot iotago.SlotIndex `serix:""`
+	// The outputID of the output that was created or spent.
+	OutputID iotago.OutputID `serix:""`
+	// The type of the output.
+	OutputType iotago.OutputType `serix:""`
+	// Whether the output was spent instead of created.
+	Spent bool `serix:""`
+}
+
+// WebhookResponse defines a registered webhook.
+type WebhookResponse struct {
+	ID uint64 `serix:""`
+	// The query parameters of the combined outputs endpoint the outputs are matched against.
+	Filter string `serix:",lenPrefix=uint16"`
+	// The URL the notifications are posted to.
+	URL string `serix:",lenPrefix=uint16"`
+	// The key of the signatures of the notifications, only returned when the webhook is registered.
+	Secret string `serix:",lenPrefix=uint8,omitempty"`
+}
+
+// WebhooksResponse defines the response of a GET webhooks REST API call.
+type WebhooksResponse struct {
+	Items []*WebhookResponse `serix:",lenPrefix=uint16"`
+}
+
+// WebhookDeliveryResponse defines an entry of the delivery log of a webhook.
+type WebhookDeliveryResponse struct {
+	ID        uint64           `serix:""`
+	OutputID  iotago.OutputID  `serix:""`
+	Slot      iotago.SlotIndex `serix:""`
+	Spent     bool             `serix:""`
+	Committed bool             `serix:""`
+	// Whether the delivery notifies that the accepted event of the output was not committed.
+	Retracted bool `serix:""`
+	// The status of the delivery: "pending", "delivered" or "failed".
+	Status   string `serix:",lenPrefix=uint8"`
+	Attempts uint32 `serix:""`
+	// The error of the last failed attempt.
+	LastError string `serix:",lenPrefix=uint16,omitempty"`
+}
+
+// WebhookDeliveriesResponse defines the response of a GET webhook deliveries REST API call.
+type WebhookDeliveriesResponse struct {
+	Items []*WebhookDeliveryResponse `serix:",lenPrefix=uint16"`
+}
+
+// BalanceEntry defines the aggregated amounts of a set of outputs.
+type BalanceEntry struct {
+	// The total amount of base tokens.
+	BaseTokens iotago.BaseToken `serix:""`
+	// The total amounts of the native tokens.
+	NativeTokens []*NativeTokenBalance `serix:",lenPrefix=uint16"`
+	// The amount of outputs per output type.
+	OutputCounts []*OutputTypeCount `serix:",lenPrefix=uint8"`
+}
+
+// NativeTokenBalance defines the total amount of a native token.
+type NativeTokenBalance struct {
+	ID     iotago.NativeTokenID `serix:""`
+	Amount *big.Int             `serix:""`
+}
+
+// OutputTypeCount defines the amount of outputs of a certain output type.
+type OutputTypeCount struct {
+	Type  iotago.OutputType `serix:""`
+	Count uint64            `serix:""`
+}
+
+// NativeTokenHoldersResponse defines the response of a GET native token holders REST API call.
+type NativeTokenHoldersResponse struct {
+	// The committed slot at which the holders were calculated.
+	CommittedSlot iotago.SlotIndex `serix:""`
+	// The total amount of the native token held in unspent outputs.
+	TotalAmount *big.Int `serix:""`
+	// The amount of addresses holding the native token.
+	HolderCount uint64 `serix:""`
+	// The maximum amount of items returned in one call. If there are more items, a cursor to the next page is returned too.
+	PageSize uint32 `serix:""`
+	// The cursor to use for getting the next results.
+	Cursor string `serix:",omitempty,lenPrefix=uint8"`
+	// The holders of the native token.
+	Holders []*NativeTokenHolderResponse `serix:",lenPrefix=uint16"`
+}
+
+// NativeTokenHolderResponse defines the amount of a native token held by an address.
+type NativeTokenHolderResponse struct {
+	Address string   `serix:",lenPrefix=uint8"`
+	Amount  *big.Int `serix:""`
+}
+
+// FoundryTokenSupplyResponse defines the response of a GET foundry token supply REST API call.
+type FoundryTokenSupplyResponse struct {
+	// The committed slot at which the token supply was calculated.
+	CommittedSlot iotago.SlotIndex `serix:""`
+	// The ID of the foundry.
+	FoundryID iotago.FoundryID `serix:""`
+	// The ID of the output of the foundry.
+	OutputID iotago.OutputID `serix:""`
+	// The serial number of the foundry.
+	SerialNumber uint32 `serix:""`
+	// The amount of tokens minted by the foundry.
+	MintedTokens *big.Int `serix:""`
+	// The amount of tokens melted by the foundry.
+	MeltedTokens *big.Int `serix:""`
+	// The maximum supply of tokens controlled by the foundry.
+	MaximumSupply *big.Int `serix:""`
+	// The amount of tokens in circulation (minted tokens - melted tokens).
+	CirculatingSupply *big.Int `serix:""`
+}
+
+// NativeTokensResponse defines the response of a GET native tokens REST API call.
+type NativeTokensResponse struct {
+	// The committed slot at which the native tokens were found.
+	CommittedSlot iotago.SlotIndex `serix:""`
+	// The maximum amount of items returned in one call. If there are more items, a cursor to the next page is returned too.
+	PageSize uint32 `serix:""`
+	// The cursor to use for getting the next results.
+	Cursor string `serix:",omitempty,lenPrefix=uint8"`
+	// The found native tokens.
+	Items []*NativeTokenResponse `serix:",lenPrefix=uint16"`
+}
+
+// NativeTokenResponse defines the IRC30 metadata of a native token.
+type NativeTokenResponse struct {
+	FoundryID   iotago.FoundryID `serix:""`
+	Name        string           `serix:",lenPrefix=uint16"`
+	Symbol      string           `serix:",lenPrefix=uint16"`
+	Decimals    uint32           `serix:""`
+	Description string           `serix:",omitempty,lenPrefix=uint16"`
+	URL         string           `serix:",omitempty,lenPrefix=uint16"`
+	LogoURL     string           `serix:",omitempty,lenPrefix=uint16"`
+}
+
+// ValidatorsResponse defines the response of a GET validators REST API call.
+type ValidatorsResponse struct {
+	// The committed slot at which the validators were found.
+	CommittedSlot iotago.SlotIndex `serix:""`
+	// The maximum amount of items returned in one call. If there are more items, a cursor to the next page is returned too.
+	PageSize uint32 `serix:""`
+	// The cursor to use for getting the next results.
+	Cursor string `serix:",omitempty,lenPrefix=uint8"`
+	// The found validators.
+	Items []*ValidatorResponse `serix:",lenPrefix=uint16"`
+}
+
+// ValidatorResponse defines the staking data of a validator and the delegations pointing to it.
+type ValidatorResponse struct {
+	// The bech32 address of the validator account.
+	Address string `serix:",lenPrefix=uint8"`
+	// The ID of the output of the validator account.
+	OutputID iotago.OutputID `serix:""`
+	// The amount of base tokens staked by the validator itself.
+	StakedAmount iotago.BaseToken `serix:""`
+	// The fixed cost of the validator.
+	FixedCost iotago.Mana `serix:""`
+	// The epoch in which the staking started.
+	StartEpoch iotago.EpochIndex `serix:""`
+	// The epoch in which the staking ends.
+	EndEpoch iotago.EpochIndex `serix:""`
+	// The sum of the amounts of the unspent delegation outputs pointing to the validator.
+	DelegatedAmount iotago.BaseToken `serix:""`
+	// The amount of unspent delegation outputs pointing to the validator.
+	DelegationCount uint64 `serix:""`
+}
+
+// OutputsResponse defines the response of a GET outputs REST API call that includes the outputs and/or their metadata.
+type OutputsResponse struct {
+	// The committed slot at which these outputs where available at.
+	CommittedSlot iotago.SlotIndex `serix:""`
+	// The maximum amount of items returned in one call. If there are more items, a cursor to the next page is returned too.
+	PageSize uint32 `serix:""`
+	// The output IDs of the found outputs.
+	Items iotago.HexOutputIDs `serix:",lenPrefix=uint16"`
+	// The cursor to use for getting the next results.
+	Cursor string `serix:",omitempty,lenPrefix=uint8"`
+	// The found outputs in the same order as their outputIDs, there is exactly one entry for each outputID.
+	Outputs []*OutputWithMetadataResponse `serix:",lenPrefix=uint16"`
+}
+
+// OutputWithMetadataResponse defines a found output and its metadata.
+type OutputWithMetadataResponse struct {
+	// The ID of the output.
+	OutputID iotago.OutputID `serix:""`
+	// The output, if it was requested.
+	Output iotago.TxEssenceOutput `serix:",optional,omitempty"`
+	// The metadata of the output, if it was requested.
+	Metadata *OutputMetadataResponse `serix:",optional,omitempty"`
+	// Whether the output is no longer stored, e.g. because it was spent after its outputID was queried.
+	// The output and its metadata are omitted then.
+	Missing bool `serix:",omitempty"`
+}
+
+// OutputMetadataResponse defines the metadata the indexer keeps about an output.
+type OutputMetadataResponse struct {
+	// The slot in which the output was booked.
+	SlotBooked iotago.SlotIndex `serix:""`
+	// Whether the creation of the output was already committed.
+	Committed bool `serix:""`
+}
+
+// CountResponse defines the response of a GET outputs count REST API call.
+type CountResponse struct {
+	// The committed slot at which the outputs were counted.
+	CommittedSlot iotago.SlotIndex `serix:""`
+	// The amount of outputs matching the filters.
+	Count uint64 `serix:""`
+}
@@ -0,0 +1,321 @@
+// Package apitypes contains the routes, parameters, requests and responses of the REST API that are shared by the server and the client.
+package apitypes
+
+const (
+	// APIRoute is the route of the REST API.
+	APIRoute = "/api/indexer/v2"
+
+	// MaxTagLength is the maximum length of the tag that is filtered for, in bytes.
+	MaxTagLength = 64
+)
+
+const (
+	// EndpointBalanceByAddress is the endpoint for getting the aggregated balance of an address.
+	// GET returns the base tokens, native tokens and output counts of the outputs owned by and unlockable by the address.
+	// "Accept" header:
+	//		MIMEApplicationJSON => json.
+	//		MIMEApplicationVendorIOTASerializerV2 => bytes.
+	EndpointBalanceByAddress = "/balance/{bech32Address}"
+
+	// EndpointNativeTokens is the endpoint for searching native tokens by their IRC30 metadata.
+	// GET returns the foundryIDs and the metadata of the matching native tokens.
+	// "Accept" header:
+	//		MIMEApplicationJSON => json.
+	//		MIMEApplicationVendorIOTASerializerV2 => bytes.
+	// Query parameters: "symbolPrefix", "namePrefix", "pageSize", "cursor"
+	// Returns an empty list if no results are found.
+	EndpointNativeTokens = "/native-tokens"
+
+	// EndpointNativeTokenByID is the endpoint for getting the IRC30 metadata of a native token.
+	// GET returns the metadata of the native token or 404 if no record is found.
+	// "Accept" header:
+	//		MIMEApplicationJSON => json.
+	//		MIMEApplicationVendorIOTASerializerV2 => bytes.
+	EndpointNativeTokenByID = "/native-tokens/{nativeTokenId}"
+
+	// EndpointNativeTokenHolders is the endpoint for getting the holders of a native token.
+	// GET returns the addresses holding the native token with their balances, the amount of holders and the total amount held in unspent outputs.
+	// "Accept" header:
+	//		MIMEApplicationJSON => json.
+	//		MIMEApplicationVendorIOTASerializerV2 => bytes.
+	// Query parameters: "pageSize", "cursor"
+	EndpointNativeTokenHolders = "/native-tokens/{nativeTokenId}/holders"
+
+	// EndpointFoundryTokenSupplyByID is the endpoint for getting the token supply of a foundry by its foundryID.
+	// GET returns the minted tokens, melted tokens, maximum supply and circulating supply of the foundry or 404 if no record is found.
+	// "Accept" header:
+	//		MIMEApplicationJSON => json.
+	//		MIMEApplicationVendorIOTASerializerV2 => bytes.
+	// Query parameters: "asOfSlot"
+	EndpointFoundryTokenSupplyByID = "/outputs/foundry/{foundryId}/supply"
+
+	// EndpointAccountsByBlockIssuerKey is the endpoint for getting the accounts that contain a block issuer key in their BlockIssuerFeature.
+	// GET returns the outputIDs or an empty list if no results are found.
+	// The key is given as the hex encoded serialized block issuer key, including its type prefix.
+	// "Accept" header:
+	//		MIMEApplicationJSON => json.
+	//		MIMEApplicationVendorIOTASerializerV2 => bytes.
+	// Query parameters: "pageSize", "cursor", "sort", "asOfSlot", "include"
+	EndpointAccountsByBlockIssuerKey = "/outputs/account/block-issuer-key/{blockIssuerKey}"
+
+	// EndpointValidators is the endpoint for listing the accounts with a staking feature.
+	// GET returns the staking data of the validators and the sum of the delegations pointing to them.
+	// "Accept" header:
+	//		MIMEApplicationJSON => json.
+	//		MIMEApplicationVendorIOTASerializerV2 => bytes.
+	// Query parameters: "pageSize", "cursor"
+	// Returns an empty list if no results are found.
+	EndpointValidators = "/validators"
+
+	// EndpointOutputsUnlockableByAddresses is the endpoint for getting the outputs unlockable by any of a list of addresses.
+	// POST takes the bech32 addresses in the body and returns the outputIDs or an empty list if no results are found.
+	// Outputs unlockable by several of the addresses are only returned once.
+	// "Content-Type" header:
+	//		MIMEApplicationJSON => json.
+	// "Accept" header:
+	//		MIMEApplicationJSON => json.
+	//		MIMEApplicationVendorIOTASerializerV2 => bytes.
+	// Query parameters: the filters of the combined outputs endpoint, "pageSize", "cursor", "sort", "include"
+	EndpointOutputsUnlockableByAddresses = "/outputs/unlockable-by-addresses"
+
+	// EndpointChanges is the endpoint for reading the change log of the committed ledger updates.
+	// GET returns the created and spent outputs in the order they were committed, starting at the given sequence number,
+	// together with the sequence number to continue reading from. The change log starts empty whenever the ledger is (re-)imported,
+	// so the sequence numbers are only valid together with the returned change log ID.
+	// "Accept" header:
+	//		MIMEApplicationJSON => json.
+	//		MIMEApplicationVendorIOTASerializerV2 => bytes.
+	// Query parameters: "fromSequenceNumber", "changeLogId", "pageSize"
+	// Returns an empty list if there are no further changes.
+	// Returns 410 if the change log was replaced or the sequence number was already pruned.
+	EndpointChanges = "/changes"
+
+	// EndpointWebhooks is the endpoint for managing the webhooks, it is only available if webhooks are enabled and an admin key is configured.
+	// All webhook endpoints need the admin key in the HeaderAdminKey header.
+	// GET returns the registered webhooks without their secrets.
+	// POST registers a webhook that is notified about the outputs matching an address or filter and returns it together with its secret.
+	// "Content-Type" header:
+	//		MIMEApplicationJSON => json.
+	// "Accept" header:
+	//		MIMEApplicationJSON => json.
+	//		MIMEApplicationVendorIOTASerializerV2 => bytes.
+	EndpointWebhooks = "/webhooks"
+
+	// EndpointWebhookByID is the endpoint for removing a webhook together with its delivery log.
+	// DELETE removes the webhook or returns 404 if no record is found.
+	EndpointWebhookByID = "/webhooks/{webhookId}"
+
+	// EndpointWebhookDeliveries is the endpoint for getting the delivery log of a webhook.
+	// GET returns the latest deliveries of the webhook, newest first, or 404 if no record is found.
+	// "Accept" header:
+	//		MIMEApplicationJSON => json.
+	//		MIMEApplicationVendorIOTASerializerV2 => bytes.
+	// Query parameters: "pageSize"
+	EndpointWebhookDeliveries = "/webhooks/{webhookId}/deliveries"
+
+	// EndpointOpenAPI is the endpoint for getting the OpenAPI document of the REST API.
+	// GET returns the OpenAPI 3 document describing the routes, their parameters and the schemas of the requests and responses.
+	// "Accept" header:
+	//		MIMEApplicationJSON => json.
+	EndpointOpenAPI = "/openapi.json"
+
+	// EndpointSuffixCount is appended to the output list endpoints to only count the matching outputs.
+	// GET returns the amount of outputs matching the filters of the list endpoint and the committed slot it was counted at.
+	// "Accept" header:
+	//		MIMEApplicationJSON => json.
+	//		MIMEApplicationVendorIOTASerializerV2 => bytes.
+	// Query parameters: the filters of the list endpoint, "pageSize", "cursor" and "sort" are ignored.
+	EndpointSuffixCount = "/count"
+
+	// EndpointSuffixEvents is appended to the output list endpoints to subscribe to the matching outputs.
+	// GET returns a stream of server-sent events, one for each matching output that is created or spent by an accepted or committed ledger update.
+	// The data of each event is the json encoded OutputEventResponse.
+	// Query parameters: the filters of the list endpoint, "pageSize", "cursor", "sort" and "asOfSlot" are ignored.
+	EndpointSuffixEvents = "/events"
+)
+
+const (
+	// HeaderAPIKey contains the API key of the client.
+	HeaderAPIKey = "X-API-Key"
+
+	// HeaderAdminKey contains the admin key that is needed to manage the webhooks.
+	HeaderAdminKey = "X-Admin-Key"
+
+	// MIMETextEventStream is the content type of server-sent event streams.
+	MIMETextEventStream = "text/event-stream"
+
+	// OutputEventName is the name of the server-sent events of the output event streams.
+	OutputEventName = "output"
+)
+
+const (
+	// ParameterNativeTokenID is used to identify a native token by its ID.
+	ParameterNativeTokenID = "nativeTokenId"
+
+	// ParameterBlockIssuerKey is used to identify a block issuer key by its hex encoded serialized form.
+	ParameterBlockIssuerKey = "blockIssuerKey"
+
+	// ParameterWebhookID is used to identify a webhook.
+	ParameterWebhookID = "webhookId"
+)
+
+const (
+	// QueryParameterUnlockableByAddress is used to filter for all unlock conditions regarding a certain address.
+	QueryParameterUnlockableByAddress = "unlockableByAddress"
+
+	// QueryParameterAddress is used to filter for a certain address.
+	QueryParameterAddress = "address"
+
+	// QueryParameterAccount is used to filter for a certain account.
+	QueryParameterAccount = "account"
+
+	// QueryParameterIssuer is used to filter for a certain issuer.
+	QueryParameterIssuer = "issuer"
+
+	// QueryParameterCollection is used to filter for NFTs issued by a certain NFT.
+	QueryParameterCollection = "collection"
+
+	// QueryParameterCollectionName is used to filter for NFTs with a certain IRC27 collection name.
+	QueryParameterCollectionName = "collectionName"
+
+	// QueryParameterMediaType is used to filter for NFTs with a certain IRC27 media type.
+	QueryParameterMediaType = "mediaType"
+
+	// QueryParameterNamePrefix is used to filter for NFTs or native tokens with a name starting with a certain prefix.
+	QueryParameterNamePrefix = "namePrefix"
+
+	// QueryParameterSymbolPrefix is used to filter for native tokens with an IRC30 symbol starting with a certain prefix.
+	QueryParameterSymbolPrefix = "symbolPrefix"
+
+	// QueryParameterSender is used to filter for a certain sender.
+	QueryParameterSender = "sender"
+
+	// QueryParameterTag is used to filter for a certain tag.
+	QueryParameterTag = "tag"
+
+	// QueryParameterValidator is used to filter for a certain validator.
+	QueryParameterValidator = "validator"
+
+	// QueryParameterHasStorageDepositReturn is used to filter for outputs having a storage deposit return unlock condition.
+	QueryParameterHasStorageDepositReturn = "hasStorageDepositReturn"
+
+	// QueryParameterStorageDepositReturnAddress is used to filter for outputs with a certain storage deposit return address.
+	QueryParameterStorageDepositReturnAddress = "storageDepositReturnAddress"
+
+	// QueryParameterHasExpiration is used to filter for outputs having an expiration unlock condition.
+	QueryParameterHasExpiration = "hasExpiration"
+
+	// QueryParameterExpiresBefore is used to filter for outputs that expire before a certain unix time.
+	QueryParameterExpiresBefore = "expiresBefore"
+
+	// QueryParameterExpiresAfter is used to filter for outputs that expire after a certain unix time.
+	QueryParameterExpiresAfter = "expiresAfter"
+
+	// QueryParameterExpirationReturnAddress is used to filter for outputs with a certain expiration return address.
+	QueryParameterExpirationReturnAddress = "expirationReturnAddress"
+
+	// QueryParameterHasTimelock is used to filter for outputs having a timelock unlock condition.
+	QueryParameterHasTimelock = "hasTimelock"
+
+	// QueryParameterTimelockedBefore is used to filter for outputs that are timelocked before a certain slot.
+	QueryParameterTimelockedBefore = "timelockedBefore"
+
+	// QueryParameterTimelockedAfter is used to filter for outputs that are timelocked after a certain slot.
+	QueryParameterTimelockedAfter = "timelockedAfter"
+
+	// QueryParameterStateController is used to filter for a certain state controller address.
+	QueryParameterStateController = "stateController"
+
+	// QueryParameterGovernor is used to filter for a certain governance controller address.
+	QueryParameterGovernor = "governor"
+
+	// QueryParameterSerialNumber is used to filter for foundries with a certain serial number.
+	QueryParameterSerialNumber = "serialNumber"
+
+	// QueryParameterHasMintCapacity is used to filter for foundries that can still mint new tokens.
+	QueryParameterHasMintCapacity = "hasMintCapacity"
+
+	// QueryParameterIsBlockIssuer is used to filter for accounts having a block issuer feature.
+	QueryParameterIsBlockIssuer = "isBlockIssuer"
+
+	// QueryParameterBlockIssuerExpiresBefore is used to filter for accounts whose block issuer feature expires before a certain slot.
+	QueryParameterBlockIssuerExpiresBefore = "blockIssuerExpiresBefore"
+
+	// QueryParameterBlockIssuerExpiresAfter is used to filter for accounts whose block issuer feature expires after a certain slot.
+	QueryParameterBlockIssuerExpiresAfter = "blockIssuerExpiresAfter"
+
+	// QueryParameterMinAmount is used to filter for outputs holding at least the given amount of base tokens.
+	QueryParameterMinAmount = "minAmount"
+
+	// QueryParameterMaxAmount is used to filter for outputs holding at most the given amount of base tokens.
+	QueryParameterMaxAmount = "maxAmount"
+
+	// QueryParameterMinDelegatedAmount is used to filter for delegations with a delegated amount greater than or equal to the given amount.
+	QueryParameterMinDelegatedAmount = "minDelegatedAmount"
+
+	// QueryParameterMaxDelegatedAmount is used to filter for delegations with a delegated amount less than or equal to the given amount.
+	QueryParameterMaxDelegatedAmount = "maxDelegatedAmount"
+
+	// QueryParameterStartEpochBefore is used to filter for delegations that started before a certain epoch.
+	QueryParameterStartEpochBefore = "startEpochBefore"
+
+	// QueryParameterStartEpochAfter is used to filter for delegations that started after a certain epoch.
+	QueryParameterStartEpochAfter = "startEpochAfter"
+
+	// QueryParameterHasEndEpoch is used to filter for delegations that were already ended.
+	QueryParameterHasEndEpoch = "hasEndEpoch"
+
+	// QueryParameterPageSize is used to define the page size for the results.
+	QueryParameterPageSize = "pageSize"
+
+	// QueryParameterSort is used to define the order of the results ("createdAsc", "createdDesc", "amountAsc" or "amountDesc").
+	QueryParameterSort = "sort"
+
+	// QueryParameterCursor is used to pass the offset we want to start the next results from.
+	QueryParameterCursor = "cursor"
+
+	// QueryParameterCreatedBefore is used to filter for outputs that were created before the given slot.
+	QueryParameterCreatedBefore = "createdBefore"
+
+	// QueryParameterCreatedAfter is used to filter for outputs that were created after the given slot.
+	QueryParameterCreatedAfter = "createdAfter"
+
+	// QueryParameterAsOfSlot is used to query the outputs that were unspent at the given slot.
+	QueryParameterAsOfSlot = "asOfSlot"
+
+	// QueryParameterHasNativeToken is used to filter for outputs that have native tokens.
+	QueryParameterHasNativeToken = "hasNativeToken"
+
+	// QueryParameterNativeToken is used to filter for outputs that have a certain native token.
+	QueryParameterNativeToken = "nativeToken"
+
+	// QueryParameterFromSequenceNumber is used to define the sequence number of the first returned change log entry.
+	QueryParameterFromSequenceNumber = "fromSequenceNumber"
+	// QueryParameterChangeLogID is used to define the ID of the change log the sequence number belongs to.
+	QueryParameterChangeLogID = "changeLogId"
+
+	// QueryParameterInclude is used to return the outputs and/or their metadata together with the outputIDs ("outputs", "metadata" or "outputs,metadata").
+	QueryParameterInclude = "include"
+)
+
+const (
+	// IncludeOutputs returns the full outputs together with the outputIDs.
+	IncludeOutputs = "outputs"
+
+	// IncludeMetadata returns the creation slot and the committed state of the outputs together with the outputIDs.
+	IncludeMetadata = "metadata"
+)
+
+// SortOrder is a value of the "sort" query parameter.
+type SortOrder string
+
+const (
+	// SortCreatedAscending returns the oldest outputs first. This is the default.
+	SortCreatedAscending SortOrder = "createdAsc"
+	// SortCreatedDescending returns the newest outputs first.
+	SortCreatedDescending SortOrder = "createdDesc"
+	// SortAmountAscending returns the outputs holding the least base tokens first.
+	SortAmountAscending SortOrder = "amountAsc"
+	// SortAmountDescending returns the outputs holding the most base tokens first.
+	SortAmountDescending SortOrder = "amountDesc"
+)
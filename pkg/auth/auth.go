@@ -0,0 +1,389 @@
+package auth
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/labstack/echo/v4"
+	"golang.org/x/time/rate"
+
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/hive.go/runtime/options"
+	"github.com/iotaledger/inx-indexer/pkg/apitypes"
+)
+
+const (
+	// ClaimRequestsPerMinute is the optional JWT claim that overrides the rate limit of the client.
+	ClaimRequestsPerMinute = "requestsPerMinute"
+	// ClaimMaxPageSize is the optional JWT claim that overrides the maximum page size of the client.
+	ClaimMaxPageSize = "maxPageSize"
+
+	// contextKeyClient is the key of the authenticated client in the echo context.
+	contextKeyClient = "auth.client"
+	// failedAttemptsIDPrefix prefixes the client IDs of the limiters of failed authentication attempts.
+	failedAttemptsIDPrefix = "failed:"
+	// limiterIdleTimeout is the duration after which the rate limiter of an idle client is removed.
+	limiterIdleTimeout = 10 * time.Minute
+)
+
+var (
+	// ErrUnauthorized is returned if a request is neither authenticated by a valid API key nor by a valid JWT.
+	ErrUnauthorized = echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	// ErrTooManyRequests is returned if a client exceeded its rate limit.
+	ErrTooManyRequests = echo.NewHTTPError(http.StatusTooManyRequests, "too many requests")
+	// ErrInvalidAPIKey is returned if an API key of the configuration can't be parsed.
+	ErrInvalidAPIKey = ierrors.New("invalid API key")
+)
+
+// Client is the client of a request and its limits.
+type Client struct {
+	// ID identifies the client for rate limiting.
+	ID string
+	// RequestsPerMinute overrides the default rate limit of the client if it is not 0.
+	RequestsPerMinute int
+	// MaxPageSize overrides the maximum page size of the REST and GraphQL API if it is not 0.
+	MaxPageSize int
+}
+
+// ParseAPIKey parses an API key in the format "key[:requestsPerMinute[:maxPageSize]]".
+func ParseAPIKey(value string) (string, *Client, error) {
+	parts := strings.Split(value, ":")
+	if len(parts) > 3 || parts[0] == "" {
+		return "", nil, ierrors.Wrap(ErrInvalidAPIKey, "expected format \"key[:requestsPerMinute[:maxPageSize]]\"")
+	}
+
+	limits := make([]int, 2)
+	for i, part := range parts[1:] {
+		if part == "" {
+			continue
+		}
+
+		limit, err := strconv.Atoi(part)
+		if err != nil || limit < 0 {
+			return "", nil, ierrors.Wrapf(ErrInvalidAPIKey, "invalid limit %q", part)
+		}
+		limits[i] = limit
+	}
+
+	key := parts[0]
+
+	return key, &Client{
+		ID:                "key:" + keyHash(key)[:16],
+		RequestsPerMinute: limits[0],
+		MaxPageSize:       limits[1],
+	}, nil
+}
+
+// keyHash returns the hex encoded SHA-256 hash of the key.
+// The API keys are looked up by their hash so that the lookup doesn't leak the keys via timing.
+func keyHash(key string) string {
+	hash := sha256.Sum256([]byte(key))
+
+	return hex.EncodeToString(hash[:])
+}
+
+// MaxPageSize returns the maximum page size of the client of the request, or 0 if the default applies.
+func MaxPageSize(c echo.Context) int {
+	client, ok := c.Get(contextKeyClient).(*Client)
+	if !ok {
+		return 0
+	}
+
+	return client.MaxPageSize
+}
+
+// ClientID returns the ID of the client of the request.
+// Requests that didn't pass the middleware are identified by their IP address.
+func ClientID(c echo.Context) string {
+	client, ok := c.Get(contextKeyClient).(*Client)
+	if !ok {
+		return anonymousClientID(c)
+	}
+
+	return client.ID
+}
+
+func anonymousClientID(c echo.Context) string {
+	return "ip:" + c.RealIP()
+}
+
+type limiter struct {
+	*rate.Limiter
+	lastSeen time.Time
+}
+
+// Authenticator authenticates the requests to the REST API and limits the rate of requests per client.
+type Authenticator struct {
+	apiKeys map[string]*Client
+
+	limitersMutex sync.Mutex
+	limiters      map[string]*limiter
+	lastCleanup   time.Time
+
+	optsJWTSecret         []byte
+	optsPublicRoutes      []string
+	optsRequestsPerMinute int
+	optsBurst             int
+	// optsFailedAttemptsPerMinute limits the failed authentication attempts per IP address, so that keys and secrets can't be brute-forced.
+	optsFailedAttemptsPerMinute int
+}
+
+// New creates a new Authenticator.
+// Requests are only authenticated if API keys or a JWT secret are configured.
+func New(opts ...options.Option[Authenticator]) *Authenticator {
+	return options.Apply(&Authenticator{
+		apiKeys:                     make(map[string]*Client),
+		limiters:                    make(map[string]*limiter),
+		optsBurst:                   1,
+		optsFailedAttemptsPerMinute: 10,
+	}, opts)
+}
+
+// WithAPIKeys adds the given API keys and the limits of their clients.
+func WithAPIKeys(apiKeys map[string]*Client) options.Option[Authenticator] {
+	return func(a *Authenticator) {
+		for key, client := range apiKeys {
+			a.apiKeys[keyHash(key)] = client
+		}
+	}
+}
+
+// WithJWTSecret sets the secret used to verify the HS256 signed JWTs of the clients.
+func WithJWTSecret(secret string) options.Option[Authenticator] {
+	return func(a *Authenticator) {
+		a.optsJWTSecret = []byte(secret)
+	}
+}
+
+// WithPublicRoutes sets the routes that don't need authentication.
+// A route ending with "*" matches all routes with the given prefix.
+func WithPublicRoutes(routes ...string) options.Option[Authenticator] {
+	return func(a *Authenticator) {
+		a.optsPublicRoutes = routes
+	}
+}
+
+// WithRateLimit sets the default number of requests per minute and the burst of every client.
+// A rate of 0 disables the rate limit for all clients without their own limit.
+func WithRateLimit(requestsPerMinute int, burst int) options.Option[Authenticator] {
+	return func(a *Authenticator) {
+		a.optsRequestsPerMinute = requestsPerMinute
+		a.optsBurst = max(burst, 1)
+	}
+}
+
+// WithFailedAttemptsLimit sets the number of failed authentication attempts per minute of an IP address.
+// Further requests of the IP address that need authentication are rejected until the limit allows another attempt.
+// A limit of 0 disables the limit.
+func WithFailedAttemptsLimit(attemptsPerMinute int) options.Option[Authenticator] {
+	return func(a *Authenticator) {
+		a.optsFailedAttemptsPerMinute = attemptsPerMinute
+	}
+}
+
+// Middleware returns the echo middleware that authenticates and rate limits the requests.
+func (a *Authenticator) Middleware() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			// the failed attempts are checked before the credentials, otherwise a blocked IP address could still tell valid from invalid credentials
+			if err := a.limitFailedAttempts(c); err != nil {
+				return err
+			}
+
+			client, err := a.authenticate(c)
+			if err != nil {
+				a.recordFailedAttempt(c)
+
+				if len(a.optsJWTSecret) > 0 {
+					c.Response().Header().Set(echo.HeaderWWWAuthenticate, "Bearer")
+				}
+
+				return err
+			}
+
+			if err := a.limit(c, client); err != nil {
+				return err
+			}
+
+			c.Set(contextKeyClient, client)
+
+			return next(c)
+		}
+	}
+}
+
+func (a *Authenticator) authenticationEnabled() bool {
+	return len(a.apiKeys) > 0 || len(a.optsJWTSecret) > 0
+}
+
+func (a *Authenticator) isPublicRoute(path string) bool {
+	for _, route := range a.optsPublicRoutes {
+		if prefix, found := strings.CutSuffix(route, "*"); found {
+			if strings.HasPrefix(path, prefix) {
+				return true
+			}
+
+			continue
+		}
+
+		if path == route {
+			return true
+		}
+	}
+
+	return false
+}
+
+// authenticate returns the client of the request.
+// Requests without authentication are identified by their IP address.
+func (a *Authenticator) authenticate(c echo.Context) (*Client, error) {
+	anonymous := &Client{ID: anonymousClientID(c)}
+
+	if !a.requiresAuthentication(c) {
+		return anonymous, nil
+	}
+
+	if key := c.Request().Header.Get(apitypes.HeaderAPIKey); key != "" {
+		client, exists := a.apiKeys[keyHash(key)]
+		if !exists {
+			return nil, ierrors.WithMessage(ErrUnauthorized, "invalid API key")
+		}
+
+		return client, nil
+	}
+
+	if token, found := strings.CutPrefix(c.Request().Header.Get(echo.HeaderAuthorization), "Bearer "); found && len(a.optsJWTSecret) > 0 {
+		return a.parseJWT(token)
+	}
+
+	return nil, ierrors.WithMessage(ErrUnauthorized, "missing API key or JWT")
+}
+
+func (a *Authenticator) parseJWT(token string) (*Client, error) {
+	claims := jwt.MapClaims{}
+	// tokens without expiration are rejected, so that a leaked token isn't valid forever
+	if _, err := jwt.ParseWithClaims(token, claims, func(token *jwt.Token) (interface{}, error) {
+		return a.optsJWTSecret, nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}), jwt.WithExpirationRequired()); err != nil {
+		return nil, ierrors.WithMessagef(ErrUnauthorized, "invalid JWT: %s", err)
+	}
+
+	subject, ok := claims["sub"].(string)
+	if !ok || subject == "" {
+		return nil, ierrors.WithMessage(ErrUnauthorized, "invalid JWT: missing subject")
+	}
+
+	return &Client{
+		ID:                "jwt:" + subject,
+		RequestsPerMinute: intClaim(claims, ClaimRequestsPerMinute),
+		MaxPageSize:       intClaim(claims, ClaimMaxPageSize),
+	}, nil
+}
+
+// intClaim returns the value of a numeric claim, or 0 if the claim is missing or invalid.
+func intClaim(claims jwt.MapClaims, name string) int {
+	value, ok := claims[name].(float64)
+	if !ok || value < 0 || value > math.MaxInt32 {
+		return 0
+	}
+
+	return int(value)
+}
+
+// limit returns ErrTooManyRequests if the client exceeded its rate limit.
+func (a *Authenticator) limit(c echo.Context, client *Client) error {
+	requestsPerMinute := a.optsRequestsPerMinute
+	if client.RequestsPerMinute > 0 {
+		requestsPerMinute = client.RequestsPerMinute
+	}
+
+	if requestsPerMinute == 0 {
+		return nil
+	}
+
+	now := time.Now()
+	reservation := a.limiter(client.ID, requestsPerMinute, a.optsBurst, now).ReserveN(now, 1)
+	if delay := reservation.DelayFrom(now); delay > 0 {
+		reservation.CancelAt(now)
+		c.Response().Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(delay.Seconds()))))
+
+		return ierrors.WithMessagef(ErrTooManyRequests, "rate limit of %d requests per minute exceeded", requestsPerMinute)
+	}
+
+	return nil
+}
+
+// requiresAuthentication returns whether the request needs to be authenticated.
+func (a *Authenticator) requiresAuthentication(c echo.Context) bool {
+	return a.authenticationEnabled() && !a.isPublicRoute(c.Request().URL.Path)
+}
+
+// limitFailedAttempts returns ErrTooManyRequests if the IP address of the request exceeded its failed authentication attempts.
+func (a *Authenticator) limitFailedAttempts(c echo.Context) error {
+	if a.optsFailedAttemptsPerMinute == 0 || !a.requiresAuthentication(c) {
+		return nil
+	}
+
+	// only IP addresses with failed attempts have a limiter
+	a.limitersMutex.Lock()
+	l, exists := a.limiters[failedAttemptsIDPrefix+anonymousClientID(c)]
+	a.limitersMutex.Unlock()
+	if !exists {
+		return nil
+	}
+
+	if tokens := l.TokensAt(time.Now()); tokens < 1 {
+		delay := time.Duration((1 - tokens) / float64(l.Limit()) * float64(time.Second))
+		c.Response().Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(delay.Seconds()))))
+
+		return ierrors.WithMessagef(ErrTooManyRequests, "limit of %d failed authentication attempts per minute exceeded", a.optsFailedAttemptsPerMinute)
+	}
+
+	return nil
+}
+
+// recordFailedAttempt counts a failed authentication attempt of the IP address of the request.
+func (a *Authenticator) recordFailedAttempt(c echo.Context) {
+	if a.optsFailedAttemptsPerMinute == 0 {
+		return
+	}
+
+	now := time.Now()
+	a.limiter(failedAttemptsIDPrefix+anonymousClientID(c), a.optsFailedAttemptsPerMinute, a.optsFailedAttemptsPerMinute, now).AllowN(now, 1)
+}
+
+// limiter returns the rate limiter with the given ID and removes the limiters of idle clients.
+func (a *Authenticator) limiter(id string, requestsPerMinute int, burst int, now time.Time) *limiter {
+	a.limitersMutex.Lock()
+	defer a.limitersMutex.Unlock()
+
+	if now.Sub(a.lastCleanup) > limiterIdleTimeout {
+		for limiterID, l := range a.limiters {
+			if now.Sub(l.lastSeen) > limiterIdleTimeout {
+				delete(a.limiters, limiterID)
+			}
+		}
+		a.lastCleanup = now
+	}
+
+	limit := rate.Limit(float64(requestsPerMinute) / time.Minute.Seconds())
+
+	l, exists := a.limiters[id]
+	if !exists {
+		l = &limiter{Limiter: rate.NewLimiter(limit, burst)}
+		a.limiters[id] = l
+	} else if l.Limit() != limit {
+		// the limit of a JWT client can change with a new token
+		l.SetLimitAt(now, limit)
+	}
+	l.lastSeen = now
+
+	return l
+}
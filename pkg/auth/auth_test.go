@@ -0,0 +1,176 @@
+package auth_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/hive.go/log"
+	"github.com/iotaledger/hive.go/runtime/options"
+	"github.com/iotaledger/inx-app/pkg/httpserver"
+	"github.com/iotaledger/inx-indexer/pkg/apitypes"
+	"github.com/iotaledger/inx-indexer/pkg/auth"
+)
+
+const (
+	routePublic  = "/health"
+	routePrivate = "/outputs"
+)
+
+// newTestEcho returns an echo server with a public and a private route guarded by the authenticator.
+// The private route responds with the maximum page size of the client.
+func newTestEcho(t *testing.T, opts ...options.Option[auth.Authenticator]) *echo.Echo {
+	t.Helper()
+
+	e := httpserver.NewEcho(log.NewLogger(), nil, false)
+	e.Use(auth.New(opts...).Middleware())
+	e.GET(routePublic, func(c echo.Context) error {
+		return c.NoContent(http.StatusOK)
+	})
+	e.GET(routePrivate, func(c echo.Context) error {
+		return c.String(http.StatusOK, strconv.Itoa(auth.MaxPageSize(c)))
+	})
+
+	return e
+}
+
+func request(e *echo.Echo, path string, headers map[string]string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	return rec
+}
+
+func TestParseAPIKey(t *testing.T) {
+	key, client, err := auth.ParseAPIKey("secret:60:100")
+	require.NoError(t, err)
+	require.Equal(t, "secret", key)
+	require.Equal(t, 60, client.RequestsPerMinute)
+	require.Equal(t, 100, client.MaxPageSize)
+
+	key, client, err = auth.ParseAPIKey("secret::100")
+	require.NoError(t, err)
+	require.Equal(t, "secret", key)
+	require.Zero(t, client.RequestsPerMinute)
+	require.Equal(t, 100, client.MaxPageSize)
+
+	for _, invalid := range []string{"", ":60", "secret:-1", "secret:x", "secret:1:2:3"} {
+		_, _, err = auth.ParseAPIKey(invalid)
+		require.ErrorIs(t, err, auth.ErrInvalidAPIKey, invalid)
+	}
+}
+
+func TestAuthenticator_APIKeys(t *testing.T) {
+	key, client, err := auth.ParseAPIKey("secret:0:50")
+	require.NoError(t, err)
+
+	e := newTestEcho(t,
+		auth.WithAPIKeys(map[string]*auth.Client{key: client}),
+		auth.WithPublicRoutes(routePublic),
+	)
+
+	require.Equal(t, http.StatusOK, request(e, routePublic, nil).Code)
+	require.Equal(t, http.StatusUnauthorized, request(e, routePrivate, nil).Code)
+	require.Equal(t, http.StatusUnauthorized, request(e, routePrivate, map[string]string{apitypes.HeaderAPIKey: "wrong"}).Code)
+
+	rec := request(e, routePrivate, map[string]string{apitypes.HeaderAPIKey: key})
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, "50", rec.Body.String())
+}
+
+func TestAuthenticator_JWT(t *testing.T) {
+	secret := "jwt-secret"
+	e := newTestEcho(t, auth.WithJWTSecret(secret))
+
+	sign := func(method jwt.SigningMethod, key interface{}, claims jwt.MapClaims) string {
+		token, err := jwt.NewWithClaims(method, claims).SignedString(key)
+		require.NoError(t, err)
+
+		return token
+	}
+
+	exp := time.Now().Add(time.Hour).Unix()
+
+	token := sign(jwt.SigningMethodHS256, []byte(secret), jwt.MapClaims{"sub": "partner", "exp": exp, auth.ClaimMaxPageSize: 25})
+	rec := request(e, routePrivate, map[string]string{echo.HeaderAuthorization: "Bearer " + token})
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, "25", rec.Body.String())
+
+	for name, token := range map[string]string{
+		"wrong secret":       sign(jwt.SigningMethodHS256, []byte("wrong"), jwt.MapClaims{"sub": "partner", "exp": exp}),
+		"missing subject":    sign(jwt.SigningMethodHS256, []byte(secret), jwt.MapClaims{"exp": exp}),
+		"missing expiration": sign(jwt.SigningMethodHS256, []byte(secret), jwt.MapClaims{"sub": "partner"}),
+		"expired":            sign(jwt.SigningMethodHS256, []byte(secret), jwt.MapClaims{"sub": "partner", "exp": 1}),
+		"unsigned":           sign(jwt.SigningMethodNone, jwt.UnsafeAllowNoneSignatureType, jwt.MapClaims{"sub": "partner", "exp": exp}),
+	} {
+		rec := request(e, routePrivate, map[string]string{echo.HeaderAuthorization: "Bearer " + token})
+		require.Equal(t, http.StatusUnauthorized, rec.Code, name)
+		require.Equal(t, "Bearer", rec.Header().Get(echo.HeaderWWWAuthenticate), name)
+	}
+}
+
+func TestAuthenticator_RateLimit(t *testing.T) {
+	key, client, err := auth.ParseAPIKey("secret:1")
+	require.NoError(t, err)
+
+	e := newTestEcho(t,
+		auth.WithAPIKeys(map[string]*auth.Client{key: client}),
+		auth.WithPublicRoutes(routePublic),
+		auth.WithRateLimit(60, 2),
+	)
+
+	// anonymous clients of public routes are limited by the default rate
+	require.Equal(t, http.StatusOK, request(e, routePublic, nil).Code)
+	require.Equal(t, http.StatusOK, request(e, routePublic, nil).Code)
+	require.Equal(t, http.StatusTooManyRequests, request(e, routePublic, nil).Code)
+
+	// the API key has its own limit
+	headers := map[string]string{apitypes.HeaderAPIKey: key}
+	require.Equal(t, http.StatusOK, request(e, routePrivate, headers).Code)
+	require.Equal(t, http.StatusOK, request(e, routePrivate, headers).Code)
+
+	rec := request(e, routePrivate, headers)
+	require.Equal(t, http.StatusTooManyRequests, rec.Code)
+	require.NotEmpty(t, rec.Header().Get("Retry-After"))
+}
+
+func TestAuthenticator_FailedAttempts(t *testing.T) {
+	key, client, err := auth.ParseAPIKey("secret")
+	require.NoError(t, err)
+
+	e := newTestEcho(t,
+		auth.WithAPIKeys(map[string]*auth.Client{key: client}),
+		auth.WithPublicRoutes(routePublic),
+		auth.WithFailedAttemptsLimit(2),
+	)
+
+	require.Equal(t, http.StatusUnauthorized, request(e, routePrivate, map[string]string{apitypes.HeaderAPIKey: "wrong"}).Code)
+	require.Equal(t, http.StatusUnauthorized, request(e, routePrivate, map[string]string{apitypes.HeaderAPIKey: "wrong"}).Code)
+
+	// the IP address is blocked, even for valid keys, so that it can't tell valid from invalid keys
+	rec := request(e, routePrivate, map[string]string{apitypes.HeaderAPIKey: key})
+	require.Equal(t, http.StatusTooManyRequests, rec.Code)
+	require.NotEmpty(t, rec.Header().Get("Retry-After"))
+
+	// public routes don't need authentication
+	require.Equal(t, http.StatusOK, request(e, routePublic, nil).Code)
+
+	// other IP addresses are not affected
+	req := httptest.NewRequest(http.MethodGet, routePrivate, nil)
+	req.RemoteAddr = "192.0.2.2:1234"
+	req.Header.Set(apitypes.HeaderAPIKey, key)
+	rec = httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code)
+}
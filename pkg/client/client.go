@@ -0,0 +1,224 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/hive.go/runtime/options"
+	"github.com/iotaledger/inx-app/pkg/httpserver"
+	"github.com/iotaledger/inx-indexer/pkg/apitypes"
+	iotago "github.com/iotaledger/iota.go/v4"
+	"github.com/iotaledger/iota.go/v4/api"
+)
+
+var (
+	// ErrNotFound is returned if the requested record does not exist.
+	ErrNotFound = ierrors.New("not found")
+	// ErrInvalidParameter is returned if the indexer rejected a parameter of the request.
+	ErrInvalidParameter = ierrors.New("invalid parameter")
+	// ErrUnauthorized is returned if the indexer rejected the API key or JWT of the client.
+	ErrUnauthorized = ierrors.New("unauthorized")
+	// ErrTooManyRequests is returned if the client exceeded its rate limit.
+	ErrTooManyRequests = ierrors.New("too many requests")
+	// ErrRequestFailed is returned if the request failed for any other reason.
+	ErrRequestFailed = ierrors.New("request failed")
+)
+
+// Client is a client of the REST API of the indexer.
+type Client struct {
+	baseURL     string
+	apiProvider iotago.APIProvider
+
+	optsHTTPClient      *http.Client
+	optsBinaryResponses bool
+	optsAPIKey          string
+	optsJWT             string
+}
+
+// New creates a client of the indexer REST API at the given base URL, e.g. "http://localhost:9091".
+// The API provider is used to decode the responses and to bech32 encode the addresses of the filters.
+func New(baseURL string, apiProvider iotago.APIProvider, opts ...options.Option[Client]) *Client {
+	return options.Apply(&Client{
+		baseURL:        strings.TrimSuffix(baseURL, "/"),
+		apiProvider:    apiProvider,
+		optsHTTPClient: http.DefaultClient,
+	}, opts)
+}
+
+// WithHTTPClient sets the HTTP client used to send the requests.
+func WithHTTPClient(httpClient *http.Client) options.Option[Client] {
+	return func(c *Client) {
+		c.optsHTTPClient = httpClient
+	}
+}
+
+// WithBinaryResponses requests the responses in their binary serialized form instead of JSON.
+func WithBinaryResponses() options.Option[Client] {
+	return func(c *Client) {
+		c.optsBinaryResponses = true
+	}
+}
+
+// WithAPIKey sets the API key sent with every request.
+func WithAPIKey(apiKey string) options.Option[Client] {
+	return func(c *Client) {
+		c.optsAPIKey = apiKey
+	}
+}
+
+// WithJWT sets the JWT sent as bearer token with every request.
+func WithJWT(token string) options.Option[Client] {
+	return func(c *Client) {
+		c.optsJWT = token
+	}
+}
+
+// Health returns whether the indexer is synced with its node.
+func (c *Client) Health(ctx context.Context) (bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.url(api.RouteHealth, nil), nil)
+	if err != nil {
+		return false, err
+	}
+	c.setAuthHeaders(req)
+
+	resp, err := c.optsHTTPClient.Do(req)
+	if err != nil {
+		return false, ierrors.Wrapf(ErrRequestFailed, "%s", err)
+	}
+	defer resp.Body.Close()
+
+	return resp.StatusCode == http.StatusOK, nil
+}
+
+// Balance returns the aggregated balance of the outputs owned by and unlockable by the address.
+func (c *Client) Balance(ctx context.Context, address iotago.Address) (*apitypes.BalanceResponse, error) {
+	resp := &apitypes.BalanceResponse{}
+	if err := c.do(ctx, http.MethodGet, route(apitypes.EndpointBalanceByAddress, api.ParameterBech32Address, c.bech32(address)), nil, nil, resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (c *Client) bech32HRP() iotago.NetworkPrefix {
+	return c.apiProvider.CommittedAPI().ProtocolParameters().Bech32HRP()
+}
+
+func (c *Client) bech32(address iotago.Address) string {
+	return address.Bech32(c.bech32HRP())
+}
+
+func (c *Client) url(path string, query url.Values) string {
+	u := c.baseURL + apitypes.APIRoute + path
+	if len(query) > 0 {
+		u += "?" + query.Encode()
+	}
+
+	return u
+}
+
+// route replaces the parameter in the path of an endpoint.
+func route(endpoint string, parameter string, value string) string {
+	return strings.Replace(endpoint, "{"+parameter+"}", url.PathEscape(value), 1)
+}
+
+// do sends the request and decodes the response into result.
+// The request body is JSON encoded, the response is decoded as JSON or in its binary form depending on the options of the client.
+func (c *Client) do(ctx context.Context, method string, path string, query url.Values, body interface{}, result interface{}) error {
+	var reqBody io.Reader
+	if body != nil {
+		data, err := c.apiProvider.CommittedAPI().JSONEncode(body)
+		if err != nil {
+			return ierrors.Wrapf(err, "encoding request body failed")
+		}
+		reqBody = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.url(path, query), reqBody)
+	if err != nil {
+		return err
+	}
+
+	if body != nil {
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	}
+	if c.optsBinaryResponses {
+		req.Header.Set(echo.HeaderAccept, api.MIMEApplicationVendorIOTASerializerV2)
+	} else {
+		req.Header.Set(echo.HeaderAccept, echo.MIMEApplicationJSON)
+	}
+	c.setAuthHeaders(req)
+
+	resp, err := c.optsHTTPClient.Do(req)
+	if err != nil {
+		return ierrors.Wrapf(ErrRequestFailed, "%s", err)
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return ierrors.Wrapf(ErrRequestFailed, "reading response body failed: %s", err)
+	}
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		return errorFromResponse(resp.StatusCode, data)
+	}
+
+	if result == nil || resp.StatusCode == http.StatusNoContent {
+		return nil
+	}
+
+	if strings.HasPrefix(resp.Header.Get(echo.HeaderContentType), api.MIMEApplicationVendorIOTASerializerV2) {
+		if _, err := c.apiProvider.CommittedAPI().Decode(data, result); err != nil {
+			return ierrors.Wrapf(err, "decoding response failed")
+		}
+
+		return nil
+	}
+
+	if err := c.apiProvider.CommittedAPI().JSONDecode(data, result); err != nil {
+		return ierrors.Wrapf(err, "decoding response failed")
+	}
+
+	return nil
+}
+
+func (c *Client) setAuthHeaders(req *http.Request) {
+	if c.optsAPIKey != "" {
+		req.Header.Set(apitypes.HeaderAPIKey, c.optsAPIKey)
+	}
+	if c.optsJWT != "" {
+		req.Header.Set(echo.HeaderAuthorization, "Bearer "+c.optsJWT)
+	}
+}
+
+// errorFromResponse maps the status code of a failed request to the errors of the client.
+func errorFromResponse(statusCode int, data []byte) error {
+	message := http.StatusText(statusCode)
+
+	envelope := &httpserver.HTTPErrorResponseEnvelope{}
+	if err := json.Unmarshal(data, envelope); err == nil && envelope.Error.Message != "" {
+		message = envelope.Error.Message
+	}
+
+	switch statusCode {
+	case http.StatusNotFound:
+		return ierrors.Wrap(ErrNotFound, message)
+	case http.StatusBadRequest:
+		return ierrors.Wrap(ErrInvalidParameter, message)
+	case http.StatusUnauthorized:
+		return ierrors.Wrap(ErrUnauthorized, message)
+	case http.StatusTooManyRequests:
+		return ierrors.Wrap(ErrTooManyRequests, message)
+	default:
+		return ierrors.Wrapf(ErrRequestFailed, "status code %d: %s", statusCode, message)
+	}
+}
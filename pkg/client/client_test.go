@@ -0,0 +1,165 @@
+package client_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/hive.go/log"
+	"github.com/iotaledger/hive.go/runtime/options"
+	"github.com/iotaledger/inx-app/pkg/httpserver"
+	"github.com/iotaledger/inx-indexer/pkg/apitypes"
+	"github.com/iotaledger/inx-indexer/pkg/auth"
+	"github.com/iotaledger/inx-indexer/pkg/client"
+	iotago "github.com/iotaledger/iota.go/v4"
+	"github.com/iotaledger/iota.go/v4/api"
+	iotago_tpkg "github.com/iotaledger/iota.go/v4/tpkg"
+)
+
+// newTestServer starts an echo server with the given route and returns a client for it.
+func newTestServer(t *testing.T, method string, path string, handler echo.HandlerFunc, opts ...options.Option[client.Client]) *client.Client {
+	t.Helper()
+
+	e := httpserver.NewEcho(log.NewLogger(), nil, false)
+	e.Add(method, apitypes.APIRoute+api.EndpointWithEchoParameters(path), handler)
+
+	ts := httptest.NewServer(e)
+	t.Cleanup(ts.Close)
+
+	return client.New(ts.URL, iotago.SingleVersionProvider(iotago_tpkg.ZeroCostTestAPI), opts...)
+}
+
+func binaryOption(binary bool) []options.Option[client.Client] {
+	if binary {
+		return []options.Option[client.Client]{client.WithBinaryResponses()}
+	}
+
+	return nil
+}
+
+func TestClient_IterateBasic(t *testing.T) {
+	for name, binary := range map[string]bool{"json": false, "binary": true} {
+		t.Run(name, func(t *testing.T) {
+			testAPI := iotago_tpkg.ZeroCostTestAPI
+			address := iotago_tpkg.RandEd25519Address()
+			outputIDs := iotago.OutputIDs{
+				iotago_tpkg.RandOutputID(0),
+				iotago_tpkg.RandOutputID(1),
+				iotago_tpkg.RandOutputID(2),
+				iotago_tpkg.RandOutputID(3),
+				iotago_tpkg.RandOutputID(4),
+			}
+
+			// The handler returns pages of two outputIDs, the cursor is the index of the next page
+			c := newTestServer(t, http.MethodGet, api.IndexerEndpointOutputsBasic, func(c echo.Context) error {
+				require.Equal(t, address.Bech32(testAPI.ProtocolParameters().Bech32HRP()), c.QueryParam(apitypes.QueryParameterAddress))
+				require.Equal(t, "true", c.QueryParam(apitypes.QueryParameterHasNativeToken))
+				require.Equal(t, "1000", c.QueryParam(apitypes.QueryParameterMinAmount))
+				require.Equal(t, string(apitypes.SortAmountDescending), c.QueryParam(apitypes.QueryParameterSort))
+
+				start := 0
+				if cursor := c.QueryParam(apitypes.QueryParameterCursor); cursor != "" {
+					var err error
+					start, err = strconv.Atoi(cursor)
+					require.NoError(t, err)
+				}
+
+				end := min(start+2, len(outputIDs))
+				resp := &api.IndexerResponse{
+					CommittedSlot: 10,
+					PageSize:      2,
+					Items:         iotago.HexOutputIDsFromOutputIDs(outputIDs[start:end]...),
+				}
+				if end < len(outputIDs) {
+					resp.Cursor = strconv.Itoa(end)
+				}
+
+				return httpserver.SendResponseByHeader(c, testAPI, resp)
+			}, binaryOption(binary)...)
+
+			iterator := c.IterateBasic(
+				client.BasicUnlockAddress(address),
+				client.BasicHasNativeToken(true),
+				client.BasicMinAmount(1000),
+				client.BasicSortOrder(apitypes.SortAmountDescending),
+			)
+
+			collected, err := iterator.OutputIDs(context.Background())
+			require.NoError(t, err)
+			require.Equal(t, outputIDs, collected)
+			require.EqualValues(t, 10, iterator.Page().CommittedSlot)
+		})
+	}
+}
+
+func TestClient_OutputsUnlockableByAddresses(t *testing.T) {
+	testAPI := iotago_tpkg.ZeroCostTestAPI
+	addresses := []iotago.Address{iotago_tpkg.RandEd25519Address(), iotago_tpkg.RandAccountAddress()}
+
+	c := newTestServer(t, http.MethodPost, apitypes.EndpointOutputsUnlockableByAddresses+apitypes.EndpointSuffixCount, func(c echo.Context) error {
+		request, err := httpserver.ParseRequestByHeader[*apitypes.AddressesRequest](c, testAPI, nil)
+		require.NoError(t, err)
+		require.Len(t, request.Addresses, len(addresses))
+		for i, address := range addresses {
+			require.Equal(t, address.Bech32(testAPI.ProtocolParameters().Bech32HRP()), request.Addresses[i])
+		}
+		require.Equal(t, "5", c.QueryParam(apitypes.QueryParameterCreatedAfter))
+
+		return httpserver.SendResponseByHeader(c, testAPI, &apitypes.CountResponse{CommittedSlot: 10, Count: 42})
+	})
+
+	resp, err := c.OutputsUnlockableByAddressesCount(context.Background(), addresses, client.CombinedCreatedAfter(5))
+	require.NoError(t, err)
+	require.EqualValues(t, 42, resp.Count)
+}
+
+func TestClient_Errors(t *testing.T) {
+	accountAddress := iotago_tpkg.RandAccountAddress()
+
+	c := newTestServer(t, http.MethodGet, api.IndexerEndpointOutputsAccountByAddress, func(c echo.Context) error {
+		if c.Param(api.ParameterBech32Address) == accountAddress.Bech32(iotago_tpkg.ZeroCostTestAPI.ProtocolParameters().Bech32HRP()) {
+			return ierrors.WithMessage(echo.ErrNotFound, "account not found")
+		}
+
+		return ierrors.WithMessage(httpserver.ErrInvalidParameter, "invalid address")
+	})
+
+	_, err := c.AccountByAddress(context.Background(), accountAddress)
+	require.ErrorIs(t, err, client.ErrNotFound)
+
+	_, err = c.AccountByAddress(context.Background(), iotago_tpkg.RandAccountAddress())
+	require.ErrorIs(t, err, client.ErrInvalidParameter)
+	require.ErrorContains(t, err, "invalid address")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = c.AccountByAddress(ctx, accountAddress)
+	require.ErrorIs(t, err, client.ErrRequestFailed)
+}
+
+func TestClient_APIKey(t *testing.T) {
+	testAPI := iotago_tpkg.ZeroCostTestAPI
+
+	newClient := func(opts ...options.Option[client.Client]) *client.Client {
+		return newTestServer(t, http.MethodGet, apitypes.EndpointBalanceByAddress, func(c echo.Context) error {
+			if c.Request().Header.Get(apitypes.HeaderAPIKey) != "secret" {
+				return ierrors.WithMessage(auth.ErrUnauthorized, "invalid API key")
+			}
+
+			return httpserver.SendResponseByHeader(c, testAPI, &apitypes.BalanceResponse{CommittedSlot: 10, Owned: &apitypes.BalanceEntry{}, Unlockable: &apitypes.BalanceEntry{}})
+		}, opts...)
+	}
+
+	_, err := newClient().Balance(context.Background(), iotago_tpkg.RandEd25519Address())
+	require.ErrorIs(t, err, client.ErrUnauthorized)
+
+	resp, err := newClient(client.WithAPIKey("secret")).Balance(context.Background(), iotago_tpkg.RandEd25519Address())
+	require.NoError(t, err)
+	require.EqualValues(t, 10, resp.CommittedSlot)
+}
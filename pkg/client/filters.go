@@ -0,0 +1,818 @@
+package client
+
+import (
+	"net/url"
+	"strconv"
+
+	"github.com/iotaledger/hive.go/runtime/options"
+	"github.com/iotaledger/inx-indexer/pkg/apitypes"
+	iotago "github.com/iotaledger/iota.go/v4"
+	"github.com/iotaledger/iota.go/v4/hexutil"
+)
+
+// queryParameters collects the query parameters of a request.
+// The addresses are only bech32 encoded when the request is sent, because the prefix depends on the network of the indexer.
+type queryParameters struct {
+	values    url.Values
+	addresses map[string]iotago.Address
+}
+
+func (q *queryParameters) set(name string, value string) {
+	if q.values == nil {
+		q.values = make(url.Values)
+	}
+	q.values.Set(name, value)
+}
+
+func (q *queryParameters) setBool(name string, value bool) {
+	q.set(name, strconv.FormatBool(value))
+}
+
+func (q *queryParameters) setUint(name string, value uint64) {
+	q.set(name, strconv.FormatUint(value, 10))
+}
+
+func (q *queryParameters) setAddress(name string, address iotago.Address) {
+	if q.addresses == nil {
+		q.addresses = make(map[string]iotago.Address)
+	}
+	q.addresses[name] = address
+}
+
+// encode returns the URL query parameters with the addresses encoded for the network with the given prefix.
+func (q *queryParameters) encode(bech32HRP iotago.NetworkPrefix) url.Values {
+	values := make(url.Values, len(q.values)+len(q.addresses))
+	for name, value := range q.values {
+		values[name] = value
+	}
+	for name, address := range q.addresses {
+		values.Set(name, address.Bech32(bech32HRP))
+	}
+
+	return values
+}
+
+// CombinedFilterOptions are the query parameters of the outputs endpoint, which returns the outputs of all types.
+type CombinedFilterOptions struct {
+	queryParameters
+}
+
+func CombinedHasNativeToken(value bool) options.Option[CombinedFilterOptions] {
+	return func(o *CombinedFilterOptions) {
+		o.setBool(apitypes.QueryParameterHasNativeToken, value)
+	}
+}
+
+func CombinedNativeToken(tokenID iotago.NativeTokenID) options.Option[CombinedFilterOptions] {
+	return func(o *CombinedFilterOptions) {
+		o.set(apitypes.QueryParameterNativeToken, tokenID.ToHex())
+	}
+}
+
+func CombinedUnlockableByAddress(address iotago.Address) options.Option[CombinedFilterOptions] {
+	return func(o *CombinedFilterOptions) {
+		o.setAddress(apitypes.QueryParameterUnlockableByAddress, address)
+	}
+}
+
+// CombinedMinAmount filters for outputs holding at least the given amount of base tokens.
+func CombinedMinAmount(amount iotago.BaseToken) options.Option[CombinedFilterOptions] {
+	return func(o *CombinedFilterOptions) {
+		o.setUint(apitypes.QueryParameterMinAmount, uint64(amount))
+	}
+}
+
+// CombinedMaxAmount filters for outputs holding at most the given amount of base tokens.
+func CombinedMaxAmount(amount iotago.BaseToken) options.Option[CombinedFilterOptions] {
+	return func(o *CombinedFilterOptions) {
+		o.setUint(apitypes.QueryParameterMaxAmount, uint64(amount))
+	}
+}
+
+func CombinedPageSize(pageSize uint32) options.Option[CombinedFilterOptions] {
+	return func(o *CombinedFilterOptions) {
+		o.setUint(apitypes.QueryParameterPageSize, uint64(pageSize))
+	}
+}
+
+// CombinedCursor continues the query at the cursor returned with the previous page.
+func CombinedCursor(cursor string) options.Option[CombinedFilterOptions] {
+	return func(o *CombinedFilterOptions) {
+		o.set(apitypes.QueryParameterCursor, cursor)
+	}
+}
+
+// CombinedSortOrder defines the order in which the outputIDs are returned.
+func CombinedSortOrder(sortOrder apitypes.SortOrder) options.Option[CombinedFilterOptions] {
+	return func(o *CombinedFilterOptions) {
+		o.set(apitypes.QueryParameterSort, string(sortOrder))
+	}
+}
+
+func CombinedCreatedBefore(slot iotago.SlotIndex) options.Option[CombinedFilterOptions] {
+	return func(o *CombinedFilterOptions) {
+		o.setUint(apitypes.QueryParameterCreatedBefore, uint64(slot))
+	}
+}
+
+func CombinedCreatedAfter(slot iotago.SlotIndex) options.Option[CombinedFilterOptions] {
+	return func(o *CombinedFilterOptions) {
+		o.setUint(apitypes.QueryParameterCreatedAfter, uint64(slot))
+	}
+}
+
+// CombinedAsOfSlot returns the outputs that were unspent at the given slot.
+func CombinedAsOfSlot(slot iotago.SlotIndex) options.Option[CombinedFilterOptions] {
+	return func(o *CombinedFilterOptions) {
+		o.setUint(apitypes.QueryParameterAsOfSlot, uint64(slot))
+	}
+}
+
+// BasicFilterOptions are the query parameters of the basic outputs endpoint.
+type BasicFilterOptions struct {
+	queryParameters
+}
+
+func BasicHasNativeToken(value bool) options.Option[BasicFilterOptions] {
+	return func(o *BasicFilterOptions) {
+		o.setBool(apitypes.QueryParameterHasNativeToken, value)
+	}
+}
+
+func BasicNativeToken(tokenID iotago.NativeTokenID) options.Option[BasicFilterOptions] {
+	return func(o *BasicFilterOptions) {
+		o.set(apitypes.QueryParameterNativeToken, tokenID.ToHex())
+	}
+}
+
+func BasicUnlockableByAddress(address iotago.Address) options.Option[BasicFilterOptions] {
+	return func(o *BasicFilterOptions) {
+		o.setAddress(apitypes.QueryParameterUnlockableByAddress, address)
+	}
+}
+
+func BasicUnlockAddress(address iotago.Address) options.Option[BasicFilterOptions] {
+	return func(o *BasicFilterOptions) {
+		o.setAddress(apitypes.QueryParameterAddress, address)
+	}
+}
+
+func BasicHasStorageDepositReturnCondition(value bool) options.Option[BasicFilterOptions] {
+	return func(o *BasicFilterOptions) {
+		o.setBool(apitypes.QueryParameterHasStorageDepositReturn, value)
+	}
+}
+
+func BasicStorageDepositReturnAddress(address iotago.Address) options.Option[BasicFilterOptions] {
+	return func(o *BasicFilterOptions) {
+		o.setAddress(apitypes.QueryParameterStorageDepositReturnAddress, address)
+	}
+}
+
+func BasicHasExpirationCondition(value bool) options.Option[BasicFilterOptions] {
+	return func(o *BasicFilterOptions) {
+		o.setBool(apitypes.QueryParameterHasExpiration, value)
+	}
+}
+
+func BasicExpiresBefore(slot iotago.SlotIndex) options.Option[BasicFilterOptions] {
+	return func(o *BasicFilterOptions) {
+		o.setUint(apitypes.QueryParameterExpiresBefore, uint64(slot))
+	}
+}
+
+func BasicExpiresAfter(slot iotago.SlotIndex) options.Option[BasicFilterOptions] {
+	return func(o *BasicFilterOptions) {
+		o.setUint(apitypes.QueryParameterExpiresAfter, uint64(slot))
+	}
+}
+
+func BasicHasTimelockCondition(value bool) options.Option[BasicFilterOptions] {
+	return func(o *BasicFilterOptions) {
+		o.setBool(apitypes.QueryParameterHasTimelock, value)
+	}
+}
+
+func BasicTimelockedBefore(slot iotago.SlotIndex) options.Option[BasicFilterOptions] {
+	return func(o *BasicFilterOptions) {
+		o.setUint(apitypes.QueryParameterTimelockedBefore, uint64(slot))
+	}
+}
+
+func BasicTimelockedAfter(slot iotago.SlotIndex) options.Option[BasicFilterOptions] {
+	return func(o *BasicFilterOptions) {
+		o.setUint(apitypes.QueryParameterTimelockedAfter, uint64(slot))
+	}
+}
+
+func BasicExpirationReturnAddress(address iotago.Address) options.Option[BasicFilterOptions] {
+	return func(o *BasicFilterOptions) {
+		o.setAddress(apitypes.QueryParameterExpirationReturnAddress, address)
+	}
+}
+
+func BasicSender(address iotago.Address) options.Option[BasicFilterOptions] {
+	return func(o *BasicFilterOptions) {
+		o.setAddress(apitypes.QueryParameterSender, address)
+	}
+}
+
+func BasicTag(tag []byte) options.Option[BasicFilterOptions] {
+	return func(o *BasicFilterOptions) {
+		o.set(apitypes.QueryParameterTag, hexutil.EncodeHex(tag))
+	}
+}
+
+// BasicMinAmount filters for outputs holding at least the given amount of base tokens.
+func BasicMinAmount(amount iotago.BaseToken) options.Option[BasicFilterOptions] {
+	return func(o *BasicFilterOptions) {
+		o.setUint(apitypes.QueryParameterMinAmount, uint64(amount))
+	}
+}
+
+// BasicMaxAmount filters for outputs holding at most the given amount of base tokens.
+func BasicMaxAmount(amount iotago.BaseToken) options.Option[BasicFilterOptions] {
+	return func(o *BasicFilterOptions) {
+		o.setUint(apitypes.QueryParameterMaxAmount, uint64(amount))
+	}
+}
+
+func BasicPageSize(pageSize uint32) options.Option[BasicFilterOptions] {
+	return func(o *BasicFilterOptions) {
+		o.setUint(apitypes.QueryParameterPageSize, uint64(pageSize))
+	}
+}
+
+// BasicCursor continues the query at the cursor returned with the previous page.
+func BasicCursor(cursor string) options.Option[BasicFilterOptions] {
+	return func(o *BasicFilterOptions) {
+		o.set(apitypes.QueryParameterCursor, cursor)
+	}
+}
+
+// BasicSortOrder defines the order in which the outputIDs are returned.
+func BasicSortOrder(sortOrder apitypes.SortOrder) options.Option[BasicFilterOptions] {
+	return func(o *BasicFilterOptions) {
+		o.set(apitypes.QueryParameterSort, string(sortOrder))
+	}
+}
+
+func BasicCreatedBefore(slot iotago.SlotIndex) options.Option[BasicFilterOptions] {
+	return func(o *BasicFilterOptions) {
+		o.setUint(apitypes.QueryParameterCreatedBefore, uint64(slot))
+	}
+}
+
+func BasicCreatedAfter(slot iotago.SlotIndex) options.Option[BasicFilterOptions] {
+	return func(o *BasicFilterOptions) {
+		o.setUint(apitypes.QueryParameterCreatedAfter, uint64(slot))
+	}
+}
+
+// BasicAsOfSlot returns the outputs that were unspent at the given slot.
+func BasicAsOfSlot(slot iotago.SlotIndex) options.Option[BasicFilterOptions] {
+	return func(o *BasicFilterOptions) {
+		o.setUint(apitypes.QueryParameterAsOfSlot, uint64(slot))
+	}
+}
+
+// AccountFilterOptions are the query parameters of the account outputs endpoint.
+type AccountFilterOptions struct {
+	queryParameters
+}
+
+func AccountUnlockAddress(address iotago.Address) options.Option[AccountFilterOptions] {
+	return func(o *AccountFilterOptions) {
+		o.setAddress(apitypes.QueryParameterAddress, address)
+	}
+}
+
+func AccountSender(address iotago.Address) options.Option[AccountFilterOptions] {
+	return func(o *AccountFilterOptions) {
+		o.setAddress(apitypes.QueryParameterSender, address)
+	}
+}
+
+func AccountIssuer(address iotago.Address) options.Option[AccountFilterOptions] {
+	return func(o *AccountFilterOptions) {
+		o.setAddress(apitypes.QueryParameterIssuer, address)
+	}
+}
+
+func AccountIsBlockIssuer(value bool) options.Option[AccountFilterOptions] {
+	return func(o *AccountFilterOptions) {
+		o.setBool(apitypes.QueryParameterIsBlockIssuer, value)
+	}
+}
+
+func AccountBlockIssuerExpiresBefore(slot iotago.SlotIndex) options.Option[AccountFilterOptions] {
+	return func(o *AccountFilterOptions) {
+		o.setUint(apitypes.QueryParameterBlockIssuerExpiresBefore, uint64(slot))
+	}
+}
+
+func AccountBlockIssuerExpiresAfter(slot iotago.SlotIndex) options.Option[AccountFilterOptions] {
+	return func(o *AccountFilterOptions) {
+		o.setUint(apitypes.QueryParameterBlockIssuerExpiresAfter, uint64(slot))
+	}
+}
+
+// AccountMinAmount filters for outputs holding at least the given amount of base tokens.
+func AccountMinAmount(amount iotago.BaseToken) options.Option[AccountFilterOptions] {
+	return func(o *AccountFilterOptions) {
+		o.setUint(apitypes.QueryParameterMinAmount, uint64(amount))
+	}
+}
+
+// AccountMaxAmount filters for outputs holding at most the given amount of base tokens.
+func AccountMaxAmount(amount iotago.BaseToken) options.Option[AccountFilterOptions] {
+	return func(o *AccountFilterOptions) {
+		o.setUint(apitypes.QueryParameterMaxAmount, uint64(amount))
+	}
+}
+
+func AccountPageSize(pageSize uint32) options.Option[AccountFilterOptions] {
+	return func(o *AccountFilterOptions) {
+		o.setUint(apitypes.QueryParameterPageSize, uint64(pageSize))
+	}
+}
+
+// AccountCursor continues the query at the cursor returned with the previous page.
+func AccountCursor(cursor string) options.Option[AccountFilterOptions] {
+	return func(o *AccountFilterOptions) {
+		o.set(apitypes.QueryParameterCursor, cursor)
+	}
+}
+
+// AccountSortOrder defines the order in which the outputIDs are returned.
+func AccountSortOrder(sortOrder apitypes.SortOrder) options.Option[AccountFilterOptions] {
+	return func(o *AccountFilterOptions) {
+		o.set(apitypes.QueryParameterSort, string(sortOrder))
+	}
+}
+
+func AccountCreatedBefore(slot iotago.SlotIndex) options.Option[AccountFilterOptions] {
+	return func(o *AccountFilterOptions) {
+		o.setUint(apitypes.QueryParameterCreatedBefore, uint64(slot))
+	}
+}
+
+func AccountCreatedAfter(slot iotago.SlotIndex) options.Option[AccountFilterOptions] {
+	return func(o *AccountFilterOptions) {
+		o.setUint(apitypes.QueryParameterCreatedAfter, uint64(slot))
+	}
+}
+
+// AccountAsOfSlot returns the outputs that were unspent at the given slot.
+func AccountAsOfSlot(slot iotago.SlotIndex) options.Option[AccountFilterOptions] {
+	return func(o *AccountFilterOptions) {
+		o.setUint(apitypes.QueryParameterAsOfSlot, uint64(slot))
+	}
+}
+
+// AnchorFilterOptions are the query parameters of the anchor outputs endpoint.
+type AnchorFilterOptions struct {
+	queryParameters
+}
+
+func AnchorUnlockableByAddress(address iotago.Address) options.Option[AnchorFilterOptions] {
+	return func(o *AnchorFilterOptions) {
+		o.setAddress(apitypes.QueryParameterUnlockableByAddress, address)
+	}
+}
+
+func AnchorStateController(address iotago.Address) options.Option[AnchorFilterOptions] {
+	return func(o *AnchorFilterOptions) {
+		o.setAddress(apitypes.QueryParameterStateController, address)
+	}
+}
+
+func AnchorGovernor(address iotago.Address) options.Option[AnchorFilterOptions] {
+	return func(o *AnchorFilterOptions) {
+		o.setAddress(apitypes.QueryParameterGovernor, address)
+	}
+}
+
+func AnchorSender(address iotago.Address) options.Option[AnchorFilterOptions] {
+	return func(o *AnchorFilterOptions) {
+		o.setAddress(apitypes.QueryParameterSender, address)
+	}
+}
+
+func AnchorIssuer(address iotago.Address) options.Option[AnchorFilterOptions] {
+	return func(o *AnchorFilterOptions) {
+		o.setAddress(apitypes.QueryParameterIssuer, address)
+	}
+}
+
+// AnchorMinAmount filters for outputs holding at least the given amount of base tokens.
+func AnchorMinAmount(amount iotago.BaseToken) options.Option[AnchorFilterOptions] {
+	return func(o *AnchorFilterOptions) {
+		o.setUint(apitypes.QueryParameterMinAmount, uint64(amount))
+	}
+}
+
+// AnchorMaxAmount filters for outputs holding at most the given amount of base tokens.
+func AnchorMaxAmount(amount iotago.BaseToken) options.Option[AnchorFilterOptions] {
+	return func(o *AnchorFilterOptions) {
+		o.setUint(apitypes.QueryParameterMaxAmount, uint64(amount))
+	}
+}
+
+func AnchorPageSize(pageSize uint32) options.Option[AnchorFilterOptions] {
+	return func(o *AnchorFilterOptions) {
+		o.setUint(apitypes.QueryParameterPageSize, uint64(pageSize))
+	}
+}
+
+// AnchorCursor continues the query at the cursor returned with the previous page.
+func AnchorCursor(cursor string) options.Option[AnchorFilterOptions] {
+	return func(o *AnchorFilterOptions) {
+		o.set(apitypes.QueryParameterCursor, cursor)
+	}
+}
+
+// AnchorSortOrder defines the order in which the outputIDs are returned.
+func AnchorSortOrder(sortOrder apitypes.SortOrder) options.Option[AnchorFilterOptions] {
+	return func(o *AnchorFilterOptions) {
+		o.set(apitypes.QueryParameterSort, string(sortOrder))
+	}
+}
+
+func AnchorCreatedBefore(slot iotago.SlotIndex) options.Option[AnchorFilterOptions] {
+	return func(o *AnchorFilterOptions) {
+		o.setUint(apitypes.QueryParameterCreatedBefore, uint64(slot))
+	}
+}
+
+func AnchorCreatedAfter(slot iotago.SlotIndex) options.Option[AnchorFilterOptions] {
+	return func(o *AnchorFilterOptions) {
+		o.setUint(apitypes.QueryParameterCreatedAfter, uint64(slot))
+	}
+}
+
+// AnchorAsOfSlot returns the outputs that were unspent at the given slot.
+func AnchorAsOfSlot(slot iotago.SlotIndex) options.Option[AnchorFilterOptions] {
+	return func(o *AnchorFilterOptions) {
+		o.setUint(apitypes.QueryParameterAsOfSlot, uint64(slot))
+	}
+}
+
+// FoundryFilterOptions are the query parameters of the foundry outputs endpoint.
+type FoundryFilterOptions struct {
+	queryParameters
+}
+
+func FoundryHasNativeToken(value bool) options.Option[FoundryFilterOptions] {
+	return func(o *FoundryFilterOptions) {
+		o.setBool(apitypes.QueryParameterHasNativeToken, value)
+	}
+}
+
+func FoundryNativeToken(tokenID iotago.NativeTokenID) options.Option[FoundryFilterOptions] {
+	return func(o *FoundryFilterOptions) {
+		o.set(apitypes.QueryParameterNativeToken, tokenID.ToHex())
+	}
+}
+
+func FoundryWithAccountAddress(address *iotago.AccountAddress) options.Option[FoundryFilterOptions] {
+	return func(o *FoundryFilterOptions) {
+		o.setAddress(apitypes.QueryParameterAccount, address)
+	}
+}
+
+func FoundrySerialNumber(serialNumber uint32) options.Option[FoundryFilterOptions] {
+	return func(o *FoundryFilterOptions) {
+		o.setUint(apitypes.QueryParameterSerialNumber, uint64(serialNumber))
+	}
+}
+
+func FoundryHasMintCapacity(value bool) options.Option[FoundryFilterOptions] {
+	return func(o *FoundryFilterOptions) {
+		o.setBool(apitypes.QueryParameterHasMintCapacity, value)
+	}
+}
+
+// FoundryMinAmount filters for outputs holding at least the given amount of base tokens.
+func FoundryMinAmount(amount iotago.BaseToken) options.Option[FoundryFilterOptions] {
+	return func(o *FoundryFilterOptions) {
+		o.setUint(apitypes.QueryParameterMinAmount, uint64(amount))
+	}
+}
+
+// FoundryMaxAmount filters for outputs holding at most the given amount of base tokens.
+func FoundryMaxAmount(amount iotago.BaseToken) options.Option[FoundryFilterOptions] {
+	return func(o *FoundryFilterOptions) {
+		o.setUint(apitypes.QueryParameterMaxAmount, uint64(amount))
+	}
+}
+
+func FoundryPageSize(pageSize uint32) options.Option[FoundryFilterOptions] {
+	return func(o *FoundryFilterOptions) {
+		o.setUint(apitypes.QueryParameterPageSize, uint64(pageSize))
+	}
+}
+
+// FoundryCursor continues the query at the cursor returned with the previous page.
+func FoundryCursor(cursor string) options.Option[FoundryFilterOptions] {
+	return func(o *FoundryFilterOptions) {
+		o.set(apitypes.QueryParameterCursor, cursor)
+	}
+}
+
+// FoundrySortOrder defines the order in which the outputIDs are returned.
+func FoundrySortOrder(sortOrder apitypes.SortOrder) options.Option[FoundryFilterOptions] {
+	return func(o *FoundryFilterOptions) {
+		o.set(apitypes.QueryParameterSort, string(sortOrder))
+	}
+}
+
+func FoundryCreatedBefore(slot iotago.SlotIndex) options.Option[FoundryFilterOptions] {
+	return func(o *FoundryFilterOptions) {
+		o.setUint(apitypes.QueryParameterCreatedBefore, uint64(slot))
+	}
+}
+
+func FoundryCreatedAfter(slot iotago.SlotIndex) options.Option[FoundryFilterOptions] {
+	return func(o *FoundryFilterOptions) {
+		o.setUint(apitypes.QueryParameterCreatedAfter, uint64(slot))
+	}
+}
+
+// FoundryAsOfSlot returns the outputs that were unspent at the given slot.
+func FoundryAsOfSlot(slot iotago.SlotIndex) options.Option[FoundryFilterOptions] {
+	return func(o *FoundryFilterOptions) {
+		o.setUint(apitypes.QueryParameterAsOfSlot, uint64(slot))
+	}
+}
+
+// NFTFilterOptions are the query parameters of the NFT outputs endpoint.
+type NFTFilterOptions struct {
+	queryParameters
+}
+
+func NFTUnlockableByAddress(address iotago.Address) options.Option[NFTFilterOptions] {
+	return func(o *NFTFilterOptions) {
+		o.setAddress(apitypes.QueryParameterUnlockableByAddress, address)
+	}
+}
+
+func NFTUnlockAddress(address iotago.Address) options.Option[NFTFilterOptions] {
+	return func(o *NFTFilterOptions) {
+		o.setAddress(apitypes.QueryParameterAddress, address)
+	}
+}
+
+func NFTHasStorageDepositReturnCondition(value bool) options.Option[NFTFilterOptions] {
+	return func(o *NFTFilterOptions) {
+		o.setBool(apitypes.QueryParameterHasStorageDepositReturn, value)
+	}
+}
+
+func NFTStorageDepositReturnAddress(address iotago.Address) options.Option[NFTFilterOptions] {
+	return func(o *NFTFilterOptions) {
+		o.setAddress(apitypes.QueryParameterStorageDepositReturnAddress, address)
+	}
+}
+
+func NFTExpirationReturnAddress(address iotago.Address) options.Option[NFTFilterOptions] {
+	return func(o *NFTFilterOptions) {
+		o.setAddress(apitypes.QueryParameterExpirationReturnAddress, address)
+	}
+}
+
+func NFTHasExpirationCondition(value bool) options.Option[NFTFilterOptions] {
+	return func(o *NFTFilterOptions) {
+		o.setBool(apitypes.QueryParameterHasExpiration, value)
+	}
+}
+
+func NFTExpiresBefore(slot iotago.SlotIndex) options.Option[NFTFilterOptions] {
+	return func(o *NFTFilterOptions) {
+		o.setUint(apitypes.QueryParameterExpiresBefore, uint64(slot))
+	}
+}
+
+func NFTExpiresAfter(slot iotago.SlotIndex) options.Option[NFTFilterOptions] {
+	return func(o *NFTFilterOptions) {
+		o.setUint(apitypes.QueryParameterExpiresAfter, uint64(slot))
+	}
+}
+
+func NFTHasTimelockCondition(value bool) options.Option[NFTFilterOptions] {
+	return func(o *NFTFilterOptions) {
+		o.setBool(apitypes.QueryParameterHasTimelock, value)
+	}
+}
+
+func NFTTimelockedBefore(slot iotago.SlotIndex) options.Option[NFTFilterOptions] {
+	return func(o *NFTFilterOptions) {
+		o.setUint(apitypes.QueryParameterTimelockedBefore, uint64(slot))
+	}
+}
+
+func NFTTimelockedAfter(slot iotago.SlotIndex) options.Option[NFTFilterOptions] {
+	return func(o *NFTFilterOptions) {
+		o.setUint(apitypes.QueryParameterTimelockedAfter, uint64(slot))
+	}
+}
+
+func NFTIssuer(address iotago.Address) options.Option[NFTFilterOptions] {
+	return func(o *NFTFilterOptions) {
+		o.setAddress(apitypes.QueryParameterIssuer, address)
+	}
+}
+
+// NFTCollection filters for NFTs that were issued by the NFT with the given NFTID.
+func NFTCollection(nftID iotago.NFTID) options.Option[NFTFilterOptions] {
+	return func(o *NFTFilterOptions) {
+		o.set(apitypes.QueryParameterCollection, nftID.ToHex())
+	}
+}
+
+// NFTCollectionName filters for NFTs with the given IRC27 collection name.
+func NFTCollectionName(value string) options.Option[NFTFilterOptions] {
+	return func(o *NFTFilterOptions) {
+		o.set(apitypes.QueryParameterCollectionName, value)
+	}
+}
+
+// NFTMediaType filters for NFTs with the given IRC27 media type.
+func NFTMediaType(value string) options.Option[NFTFilterOptions] {
+	return func(o *NFTFilterOptions) {
+		o.set(apitypes.QueryParameterMediaType, value)
+	}
+}
+
+// NFTNamePrefix filters for NFTs with an IRC27 name starting with the given prefix (case-insensitive).
+func NFTNamePrefix(value string) options.Option[NFTFilterOptions] {
+	return func(o *NFTFilterOptions) {
+		o.set(apitypes.QueryParameterNamePrefix, value)
+	}
+}
+
+func NFTSender(address iotago.Address) options.Option[NFTFilterOptions] {
+	return func(o *NFTFilterOptions) {
+		o.setAddress(apitypes.QueryParameterSender, address)
+	}
+}
+
+func NFTTag(tag []byte) options.Option[NFTFilterOptions] {
+	return func(o *NFTFilterOptions) {
+		o.set(apitypes.QueryParameterTag, hexutil.EncodeHex(tag))
+	}
+}
+
+// NFTMinAmount filters for outputs holding at least the given amount of base tokens.
+func NFTMinAmount(amount iotago.BaseToken) options.Option[NFTFilterOptions] {
+	return func(o *NFTFilterOptions) {
+		o.setUint(apitypes.QueryParameterMinAmount, uint64(amount))
+	}
+}
+
+// NFTMaxAmount filters for outputs holding at most the given amount of base tokens.
+func NFTMaxAmount(amount iotago.BaseToken) options.Option[NFTFilterOptions] {
+	return func(o *NFTFilterOptions) {
+		o.setUint(apitypes.QueryParameterMaxAmount, uint64(amount))
+	}
+}
+
+func NFTPageSize(pageSize uint32) options.Option[NFTFilterOptions] {
+	return func(o *NFTFilterOptions) {
+		o.setUint(apitypes.QueryParameterPageSize, uint64(pageSize))
+	}
+}
+
+// NFTCursor continues the query at the cursor returned with the previous page.
+func NFTCursor(cursor string) options.Option[NFTFilterOptions] {
+	return func(o *NFTFilterOptions) {
+		o.set(apitypes.QueryParameterCursor, cursor)
+	}
+}
+
+// NFTSortOrder defines the order in which the outputIDs are returned.
+func NFTSortOrder(sortOrder apitypes.SortOrder) options.Option[NFTFilterOptions] {
+	return func(o *NFTFilterOptions) {
+		o.set(apitypes.QueryParameterSort, string(sortOrder))
+	}
+}
+
+func NFTCreatedBefore(slot iotago.SlotIndex) options.Option[NFTFilterOptions] {
+	return func(o *NFTFilterOptions) {
+		o.setUint(apitypes.QueryParameterCreatedBefore, uint64(slot))
+	}
+}
+
+func NFTCreatedAfter(slot iotago.SlotIndex) options.Option[NFTFilterOptions] {
+	return func(o *NFTFilterOptions) {
+		o.setUint(apitypes.QueryParameterCreatedAfter, uint64(slot))
+	}
+}
+
+// NFTAsOfSlot returns the outputs that were unspent at the given slot.
+func NFTAsOfSlot(slot iotago.SlotIndex) options.Option[NFTFilterOptions] {
+	return func(o *NFTFilterOptions) {
+		o.setUint(apitypes.QueryParameterAsOfSlot, uint64(slot))
+	}
+}
+
+// DelegationFilterOptions are the query parameters of the delegation outputs endpoint.
+type DelegationFilterOptions struct {
+	queryParameters
+}
+
+func DelegationAddress(address iotago.Address) options.Option[DelegationFilterOptions] {
+	return func(o *DelegationFilterOptions) {
+		o.setAddress(apitypes.QueryParameterAddress, address)
+	}
+}
+
+func DelegationValidator(address *iotago.AccountAddress) options.Option[DelegationFilterOptions] {
+	return func(o *DelegationFilterOptions) {
+		o.setAddress(apitypes.QueryParameterValidator, address)
+	}
+}
+
+func DelegationMinDelegatedAmount(amount iotago.BaseToken) options.Option[DelegationFilterOptions] {
+	return func(o *DelegationFilterOptions) {
+		o.setUint(apitypes.QueryParameterMinDelegatedAmount, uint64(amount))
+	}
+}
+
+func DelegationMaxDelegatedAmount(amount iotago.BaseToken) options.Option[DelegationFilterOptions] {
+	return func(o *DelegationFilterOptions) {
+		o.setUint(apitypes.QueryParameterMaxDelegatedAmount, uint64(amount))
+	}
+}
+
+func DelegationStartEpochBefore(epoch iotago.EpochIndex) options.Option[DelegationFilterOptions] {
+	return func(o *DelegationFilterOptions) {
+		o.setUint(apitypes.QueryParameterStartEpochBefore, uint64(epoch))
+	}
+}
+
+func DelegationStartEpochAfter(epoch iotago.EpochIndex) options.Option[DelegationFilterOptions] {
+	return func(o *DelegationFilterOptions) {
+		o.setUint(apitypes.QueryParameterStartEpochAfter, uint64(epoch))
+	}
+}
+
+// DelegationHasEndEpoch filters for delegations that were already ended (end epoch set) or that are still active (end epoch unset).
+func DelegationHasEndEpoch(value bool) options.Option[DelegationFilterOptions] {
+	return func(o *DelegationFilterOptions) {
+		o.setBool(apitypes.QueryParameterHasEndEpoch, value)
+	}
+}
+
+// DelegationMinAmount filters for outputs holding at least the given amount of base tokens.
+func DelegationMinAmount(amount iotago.BaseToken) options.Option[DelegationFilterOptions] {
+	return func(o *DelegationFilterOptions) {
+		o.setUint(apitypes.QueryParameterMinAmount, uint64(amount))
+	}
+}
+
+// DelegationMaxAmount filters for outputs holding at most the given amount of base tokens.
+func DelegationMaxAmount(amount iotago.BaseToken) options.Option[DelegationFilterOptions] {
+	return func(o *DelegationFilterOptions) {
+		o.setUint(apitypes.QueryParameterMaxAmount, uint64(amount))
+	}
+}
+
+func DelegationPageSize(pageSize uint32) options.Option[DelegationFilterOptions] {
+	return func(o *DelegationFilterOptions) {
+		o.setUint(apitypes.QueryParameterPageSize, uint64(pageSize))
+	}
+}
+
+// DelegationCursor continues the query at the cursor returned with the previous page.
+func DelegationCursor(cursor string) options.Option[DelegationFilterOptions] {
+	return func(o *DelegationFilterOptions) {
+		o.set(apitypes.QueryParameterCursor, cursor)
+	}
+}
+
+// DelegationSortOrder defines the order in which the outputIDs are returned.
+func DelegationSortOrder(sortOrder apitypes.SortOrder) options.Option[DelegationFilterOptions] {
+	return func(o *DelegationFilterOptions) {
+		o.set(apitypes.QueryParameterSort, string(sortOrder))
+	}
+}
+
+func DelegationCreatedBefore(slot iotago.SlotIndex) options.Option[DelegationFilterOptions] {
+	return func(o *DelegationFilterOptions) {
+		o.setUint(apitypes.QueryParameterCreatedBefore, uint64(slot))
+	}
+}
+
+func DelegationCreatedAfter(slot iotago.SlotIndex) options.Option[DelegationFilterOptions] {
+	return func(o *DelegationFilterOptions) {
+		o.setUint(apitypes.QueryParameterCreatedAfter, uint64(slot))
+	}
+}
+
+// DelegationAsOfSlot returns the outputs that were unspent at the given slot.
+func DelegationAsOfSlot(slot iotago.SlotIndex) options.Option[DelegationFilterOptions] {
+	return func(o *DelegationFilterOptions) {
+		o.setUint(apitypes.QueryParameterAsOfSlot, uint64(slot))
+	}
+}
@@ -0,0 +1,275 @@
+package client
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/hive.go/runtime/options"
+	"github.com/iotaledger/inx-indexer/pkg/apitypes"
+	iotago "github.com/iotaledger/iota.go/v4"
+	"github.com/iotaledger/iota.go/v4/api"
+)
+
+// OutputIterator follows the cursors of a query and returns its results page by page.
+type OutputIterator struct {
+	fetch  func(ctx context.Context, cursor string) (*api.IndexerResponse, error)
+	page   *api.IndexerResponse
+	cursor string
+	done   bool
+	err    error
+}
+
+// Next fetches the next page of the query.
+// It returns false if there are no further pages or if the request failed, which is returned by Err.
+func (i *OutputIterator) Next(ctx context.Context) bool {
+	if i.done {
+		return false
+	}
+
+	page, err := i.fetch(ctx, i.cursor)
+	if err != nil {
+		i.err = err
+		i.done = true
+
+		return false
+	}
+
+	i.page = page
+	i.cursor = page.Cursor
+	i.done = page.Cursor == ""
+
+	return true
+}
+
+// Page returns the page fetched by the last call to Next.
+func (i *OutputIterator) Page() *api.IndexerResponse {
+	return i.page
+}
+
+// Err returns the error that stopped the iteration.
+func (i *OutputIterator) Err() error {
+	return i.err
+}
+
+// OutputIDs fetches the remaining pages and returns the outputIDs of all of them.
+func (i *OutputIterator) OutputIDs(ctx context.Context) (iotago.OutputIDs, error) {
+	var outputIDs iotago.OutputIDs
+	for i.Next(ctx) {
+		pageOutputIDs, err := i.page.Items.OutputIDs()
+		if err != nil {
+			return nil, ierrors.Wrapf(err, "parsing outputIDs failed")
+		}
+		outputIDs = append(outputIDs, pageOutputIDs...)
+	}
+
+	if i.err != nil {
+		return nil, i.err
+	}
+
+	return outputIDs, nil
+}
+
+// Outputs returns the first page of the outputs of all types matching the filters.
+func (c *Client) Outputs(ctx context.Context, opts ...options.Option[CombinedFilterOptions]) (*api.IndexerResponse, error) {
+	return c.outputs(ctx, http.MethodGet, api.IndexerEndpointOutputs, options.Apply(new(CombinedFilterOptions), opts).queryParameters, nil, "")
+}
+
+// OutputsCount returns the amount of outputs of all types matching the filters.
+func (c *Client) OutputsCount(ctx context.Context, opts ...options.Option[CombinedFilterOptions]) (*apitypes.CountResponse, error) {
+	return c.count(ctx, http.MethodGet, api.IndexerEndpointOutputs, options.Apply(new(CombinedFilterOptions), opts).queryParameters, nil)
+}
+
+// IterateOutputs returns an iterator over the pages of the outputs of all types matching the filters.
+func (c *Client) IterateOutputs(opts ...options.Option[CombinedFilterOptions]) *OutputIterator {
+	return c.outputIterator(http.MethodGet, api.IndexerEndpointOutputs, options.Apply(new(CombinedFilterOptions), opts).queryParameters, nil)
+}
+
+// OutputsUnlockableByAddresses returns the first page of the outputs of all types matching the filters that can be unlocked by any of the addresses.
+func (c *Client) OutputsUnlockableByAddresses(ctx context.Context, addresses []iotago.Address, opts ...options.Option[CombinedFilterOptions]) (*api.IndexerResponse, error) {
+	return c.outputs(ctx, http.MethodPost, apitypes.EndpointOutputsUnlockableByAddresses, options.Apply(new(CombinedFilterOptions), opts).queryParameters, c.addressesRequest(addresses), "")
+}
+
+// OutputsUnlockableByAddressesCount returns the amount of outputs of all types matching the filters that can be unlocked by any of the addresses.
+func (c *Client) OutputsUnlockableByAddressesCount(ctx context.Context, addresses []iotago.Address, opts ...options.Option[CombinedFilterOptions]) (*apitypes.CountResponse, error) {
+	return c.count(ctx, http.MethodPost, apitypes.EndpointOutputsUnlockableByAddresses, options.Apply(new(CombinedFilterOptions), opts).queryParameters, c.addressesRequest(addresses))
+}
+
+// IterateOutputsUnlockableByAddresses returns an iterator over the pages of the outputs of all types matching the filters that can be unlocked by any of the addresses.
+func (c *Client) IterateOutputsUnlockableByAddresses(addresses []iotago.Address, opts ...options.Option[CombinedFilterOptions]) *OutputIterator {
+	return c.outputIterator(http.MethodPost, apitypes.EndpointOutputsUnlockableByAddresses, options.Apply(new(CombinedFilterOptions), opts).queryParameters, c.addressesRequest(addresses))
+}
+
+// Basic returns the first page of the basic outputs matching the filters.
+func (c *Client) Basic(ctx context.Context, opts ...options.Option[BasicFilterOptions]) (*api.IndexerResponse, error) {
+	return c.outputs(ctx, http.MethodGet, api.IndexerEndpointOutputsBasic, options.Apply(new(BasicFilterOptions), opts).queryParameters, nil, "")
+}
+
+// BasicCount returns the amount of basic outputs matching the filters.
+func (c *Client) BasicCount(ctx context.Context, opts ...options.Option[BasicFilterOptions]) (*apitypes.CountResponse, error) {
+	return c.count(ctx, http.MethodGet, api.IndexerEndpointOutputsBasic, options.Apply(new(BasicFilterOptions), opts).queryParameters, nil)
+}
+
+// IterateBasic returns an iterator over the pages of the basic outputs matching the filters.
+func (c *Client) IterateBasic(opts ...options.Option[BasicFilterOptions]) *OutputIterator {
+	return c.outputIterator(http.MethodGet, api.IndexerEndpointOutputsBasic, options.Apply(new(BasicFilterOptions), opts).queryParameters, nil)
+}
+
+// Account returns the first page of the account outputs matching the filters.
+func (c *Client) Account(ctx context.Context, opts ...options.Option[AccountFilterOptions]) (*api.IndexerResponse, error) {
+	return c.outputs(ctx, http.MethodGet, api.IndexerEndpointOutputsAccounts, options.Apply(new(AccountFilterOptions), opts).queryParameters, nil, "")
+}
+
+// AccountCount returns the amount of account outputs matching the filters.
+func (c *Client) AccountCount(ctx context.Context, opts ...options.Option[AccountFilterOptions]) (*apitypes.CountResponse, error) {
+	return c.count(ctx, http.MethodGet, api.IndexerEndpointOutputsAccounts, options.Apply(new(AccountFilterOptions), opts).queryParameters, nil)
+}
+
+// IterateAccount returns an iterator over the pages of the account outputs matching the filters.
+func (c *Client) IterateAccount(opts ...options.Option[AccountFilterOptions]) *OutputIterator {
+	return c.outputIterator(http.MethodGet, api.IndexerEndpointOutputsAccounts, options.Apply(new(AccountFilterOptions), opts).queryParameters, nil)
+}
+
+// AccountByAddress returns the outputID of the current output of the account.
+func (c *Client) AccountByAddress(ctx context.Context, address *iotago.AccountAddress) (iotago.OutputID, error) {
+	return c.singleOutput(ctx, route(api.IndexerEndpointOutputsAccountByAddress, api.ParameterBech32Address, c.bech32(address)))
+}
+
+// Anchor returns the first page of the anchor outputs matching the filters.
+func (c *Client) Anchor(ctx context.Context, opts ...options.Option[AnchorFilterOptions]) (*api.IndexerResponse, error) {
+	return c.outputs(ctx, http.MethodGet, api.IndexerEndpointOutputsAnchors, options.Apply(new(AnchorFilterOptions), opts).queryParameters, nil, "")
+}
+
+// AnchorCount returns the amount of anchor outputs matching the filters.
+func (c *Client) AnchorCount(ctx context.Context, opts ...options.Option[AnchorFilterOptions]) (*apitypes.CountResponse, error) {
+	return c.count(ctx, http.MethodGet, api.IndexerEndpointOutputsAnchors, options.Apply(new(AnchorFilterOptions), opts).queryParameters, nil)
+}
+
+// IterateAnchor returns an iterator over the pages of the anchor outputs matching the filters.
+func (c *Client) IterateAnchor(opts ...options.Option[AnchorFilterOptions]) *OutputIterator {
+	return c.outputIterator(http.MethodGet, api.IndexerEndpointOutputsAnchors, options.Apply(new(AnchorFilterOptions), opts).queryParameters, nil)
+}
+
+// AnchorByAddress returns the outputID of the current output of the anchor.
+func (c *Client) AnchorByAddress(ctx context.Context, address *iotago.AnchorAddress) (iotago.OutputID, error) {
+	return c.singleOutput(ctx, route(api.IndexerEndpointOutputsAnchorByAddress, api.ParameterBech32Address, c.bech32(address)))
+}
+
+// Foundry returns the first page of the foundry outputs matching the filters.
+func (c *Client) Foundry(ctx context.Context, opts ...options.Option[FoundryFilterOptions]) (*api.IndexerResponse, error) {
+	return c.outputs(ctx, http.MethodGet, api.IndexerEndpointOutputsFoundries, options.Apply(new(FoundryFilterOptions), opts).queryParameters, nil, "")
+}
+
+// FoundryCount returns the amount of foundry outputs matching the filters.
+func (c *Client) FoundryCount(ctx context.Context, opts ...options.Option[FoundryFilterOptions]) (*apitypes.CountResponse, error) {
+	return c.count(ctx, http.MethodGet, api.IndexerEndpointOutputsFoundries, options.Apply(new(FoundryFilterOptions), opts).queryParameters, nil)
+}
+
+// IterateFoundry returns an iterator over the pages of the foundry outputs matching the filters.
+func (c *Client) IterateFoundry(opts ...options.Option[FoundryFilterOptions]) *OutputIterator {
+	return c.outputIterator(http.MethodGet, api.IndexerEndpointOutputsFoundries, options.Apply(new(FoundryFilterOptions), opts).queryParameters, nil)
+}
+
+// FoundryByID returns the outputID of the current output of the foundry.
+func (c *Client) FoundryByID(ctx context.Context, foundryID iotago.FoundryID) (iotago.OutputID, error) {
+	return c.singleOutput(ctx, route(api.IndexerEndpointOutputsFoundryByID, api.ParameterFoundryID, foundryID.ToHex()))
+}
+
+// NFT returns the first page of the NFT outputs matching the filters.
+func (c *Client) NFT(ctx context.Context, opts ...options.Option[NFTFilterOptions]) (*api.IndexerResponse, error) {
+	return c.outputs(ctx, http.MethodGet, api.IndexerEndpointOutputsNFTs, options.Apply(new(NFTFilterOptions), opts).queryParameters, nil, "")
+}
+
+// NFTCount returns the amount of NFT outputs matching the filters.
+func (c *Client) NFTCount(ctx context.Context, opts ...options.Option[NFTFilterOptions]) (*apitypes.CountResponse, error) {
+	return c.count(ctx, http.MethodGet, api.IndexerEndpointOutputsNFTs, options.Apply(new(NFTFilterOptions), opts).queryParameters, nil)
+}
+
+// IterateNFT returns an iterator over the pages of the NFT outputs matching the filters.
+func (c *Client) IterateNFT(opts ...options.Option[NFTFilterOptions]) *OutputIterator {
+	return c.outputIterator(http.MethodGet, api.IndexerEndpointOutputsNFTs, options.Apply(new(NFTFilterOptions), opts).queryParameters, nil)
+}
+
+// NFTByAddress returns the outputID of the current output of the NFT.
+func (c *Client) NFTByAddress(ctx context.Context, address *iotago.NFTAddress) (iotago.OutputID, error) {
+	return c.singleOutput(ctx, route(api.IndexerEndpointOutputsNFTByAddress, api.ParameterBech32Address, c.bech32(address)))
+}
+
+// Delegation returns the first page of the delegation outputs matching the filters.
+func (c *Client) Delegation(ctx context.Context, opts ...options.Option[DelegationFilterOptions]) (*api.IndexerResponse, error) {
+	return c.outputs(ctx, http.MethodGet, api.IndexerEndpointOutputsDelegations, options.Apply(new(DelegationFilterOptions), opts).queryParameters, nil, "")
+}
+
+// DelegationCount returns the amount of delegation outputs matching the filters.
+func (c *Client) DelegationCount(ctx context.Context, opts ...options.Option[DelegationFilterOptions]) (*apitypes.CountResponse, error) {
+	return c.count(ctx, http.MethodGet, api.IndexerEndpointOutputsDelegations, options.Apply(new(DelegationFilterOptions), opts).queryParameters, nil)
+}
+
+// IterateDelegation returns an iterator over the pages of the delegation outputs matching the filters.
+func (c *Client) IterateDelegation(opts ...options.Option[DelegationFilterOptions]) *OutputIterator {
+	return c.outputIterator(http.MethodGet, api.IndexerEndpointOutputsDelegations, options.Apply(new(DelegationFilterOptions), opts).queryParameters, nil)
+}
+
+// DelegationByID returns the outputID of the current output of the delegation.
+func (c *Client) DelegationByID(ctx context.Context, delegationID iotago.DelegationID) (iotago.OutputID, error) {
+	return c.singleOutput(ctx, route(api.IndexerEndpointOutputsDelegationByID, api.ParameterDelegationID, delegationID.ToHex()))
+}
+
+func (c *Client) addressesRequest(addresses []iotago.Address) *apitypes.AddressesRequest {
+	request := &apitypes.AddressesRequest{Addresses: make([]string, 0, len(addresses))}
+	for _, address := range addresses {
+		request.Addresses = append(request.Addresses, c.bech32(address))
+	}
+
+	return request
+}
+
+// outputs queries a page of an output list endpoint, the cursor overrides the one of the query parameters if given.
+func (c *Client) outputs(ctx context.Context, method string, path string, params queryParameters, body interface{}, cursor string) (*api.IndexerResponse, error) {
+	query := params.encode(c.bech32HRP())
+	if cursor != "" {
+		query.Set(apitypes.QueryParameterCursor, cursor)
+	}
+
+	resp := &api.IndexerResponse{}
+	if err := c.do(ctx, method, path, query, body, resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (c *Client) count(ctx context.Context, method string, path string, params queryParameters, body interface{}) (*apitypes.CountResponse, error) {
+	resp := &apitypes.CountResponse{}
+	if err := c.do(ctx, method, path+apitypes.EndpointSuffixCount, params.encode(c.bech32HRP()), body, resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (c *Client) outputIterator(method string, path string, params queryParameters, body interface{}) *OutputIterator {
+	return &OutputIterator{
+		fetch: func(ctx context.Context, cursor string) (*api.IndexerResponse, error) {
+			return c.outputs(ctx, method, path, params, body, cursor)
+		},
+	}
+}
+
+// singleOutput returns the outputID returned by an endpoint that looks up a single output.
+func (c *Client) singleOutput(ctx context.Context, path string) (iotago.OutputID, error) {
+	resp := &api.IndexerResponse{}
+	if err := c.do(ctx, http.MethodGet, path, nil, nil, resp); err != nil {
+		return iotago.EmptyOutputID, err
+	}
+
+	outputIDs, err := resp.Items.OutputIDs()
+	if err != nil {
+		return iotago.EmptyOutputID, ierrors.Wrapf(err, "parsing outputIDs failed")
+	}
+	if len(outputIDs) == 0 {
+		return iotago.EmptyOutputID, ierrors.Wrapf(ErrNotFound, "no output found at %s", path)
+	}
+
+	return outputIDs[0], nil
+}
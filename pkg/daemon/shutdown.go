@@ -4,6 +4,10 @@ const (
 	PriorityDisconnectINX = iota // no dependencies
 	PriorityStopIndexer
 	PriorityStopIndexerAcceptedTransactions
+	PriorityStopIndexerHistoryPruning
+	PriorityStopIndexerChangeLogPruning
+	PriorityStopIndexerWebhooks
 	PriorityStopIndexerAPI
+	PriorityStopIndexerGRPC
 	PriorityStopPrometheus
 )
@@ -0,0 +1,44 @@
+package graphql
+
+import (
+	"context"
+	"sync/atomic"
+
+	"github.com/iotaledger/hive.go/ierrors"
+)
+
+var (
+	ErrBudgetExceeded = ierrors.New("query budget exceeded")
+)
+
+type nodeBudgetContextKey struct{}
+
+// nodeBudget limits the number of nodes a single query may resolve.
+// Every connection is charged with its page size before the outputs are queried and every lookup of a single output with one node,
+// so that nested connections can't multiply the load on the database.
+type nodeBudget struct {
+	maxNodes  int64
+	remaining atomic.Int64
+}
+
+// withNodeBudget returns a context that carries the budget of a single query.
+func withNodeBudget(ctx context.Context, maxNodes int) context.Context {
+	budget := &nodeBudget{maxNodes: int64(maxNodes)}
+	budget.remaining.Store(int64(maxNodes))
+
+	return context.WithValue(ctx, nodeBudgetContextKey{}, budget)
+}
+
+// chargeNodes takes the given amount of nodes from the budget of the query. The resolvers run concurrently, so the budget is shared atomically.
+func chargeNodes(ctx context.Context, nodes uint32) error {
+	budget, ok := ctx.Value(nodeBudgetContextKey{}).(*nodeBudget)
+	if !ok {
+		return nil
+	}
+
+	if budget.remaining.Add(-int64(nodes)) < 0 {
+		return ierrors.Wrapf(ErrBudgetExceeded, "the query resolves more than %d nodes, request smaller pages or less nested connections", budget.maxNodes)
+	}
+
+	return nil
+}
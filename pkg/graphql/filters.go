@@ -0,0 +1,646 @@
+package graphql
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/hive.go/runtime/options"
+	"github.com/iotaledger/inx-indexer/pkg/apitypes"
+	"github.com/iotaledger/inx-indexer/pkg/indexer"
+	iotago "github.com/iotaledger/iota.go/v4"
+	"github.com/iotaledger/iota.go/v4/hexutil"
+)
+
+var (
+	ErrInvalidArgument = ierrors.New("invalid argument")
+)
+
+// pageArgs are the pagination arguments of all output connections.
+type pageArgs struct {
+	First *int32
+	After *string
+	Sort  *string
+}
+
+var sortOrders = map[string]indexer.SortOrder{
+	"CREATED_ASC":  indexer.SortCreatedAscending,
+	"CREATED_DESC": indexer.SortCreatedDescending,
+	"AMOUNT_ASC":   indexer.SortAmountAscending,
+	"AMOUNT_DESC":  indexer.SortAmountDescending,
+}
+
+type maxPageSizeContextKey struct{}
+
+// withMaxPageSize returns a context that carries the maximum page size of the client of the query.
+func withMaxPageSize(ctx context.Context, maxPageSize uint32) context.Context {
+	return context.WithValue(ctx, maxPageSizeContextKey{}, maxPageSize)
+}
+
+// page returns the page size, cursor and sort order of the pagination arguments and charges the page size to the budget of the query.
+func (s *Server) page(ctx context.Context, args pageArgs) (uint32, *string, indexer.SortOrder, error) {
+	maxPageSize, ok := ctx.Value(maxPageSizeContextKey{}).(uint32)
+	if !ok {
+		maxPageSize = s.optsMaxPageSize
+	}
+
+	pageSize := min(s.optsDefaultPageSize, maxPageSize)
+	if args.First != nil {
+		if *args.First <= 0 {
+			return 0, nil, 0, ierrors.Wrapf(ErrInvalidArgument, "first must be positive: %d", *args.First)
+		}
+		pageSize = min(uint32(*args.First), maxPageSize)
+	}
+
+	sortOrder := indexer.SortCreatedAscending
+	if args.Sort != nil {
+		var exists bool
+		if sortOrder, exists = sortOrders[*args.Sort]; !exists {
+			return 0, nil, 0, ierrors.Wrapf(ErrInvalidArgument, "unknown sort order: %s", *args.Sort)
+		}
+	}
+
+	if err := chargeNodes(ctx, pageSize); err != nil {
+		return 0, nil, 0, err
+	}
+
+	return pageSize, args.After, sortOrder, nil
+}
+
+// filterParser collects the first error of the parsed filter values, so that the filters can be converted field by field.
+type filterParser struct {
+	bech32HRP iotago.NetworkPrefix
+	err       error
+}
+
+// filterParser returns a new filterParser for the network of the server.
+func (s *Server) filterParser() *filterParser {
+	return &filterParser{bech32HRP: s.bech32HRP}
+}
+
+func (p *filterParser) fail(err error) {
+	if p.err == nil {
+		p.err = err
+	}
+}
+
+func (p *filterParser) address(name string, value string) iotago.Address {
+	hrp, address, err := iotago.ParseBech32(value)
+	if err != nil {
+		p.fail(ierrors.Wrapf(ErrInvalidArgument, "invalid address in %s: %s", name, err))
+		return nil
+	}
+	if hrp != p.bech32HRP {
+		p.fail(ierrors.Wrapf(ErrInvalidArgument, "invalid bech32 address prefix in %s: %s, expected %s", name, hrp, p.bech32HRP))
+		return nil
+	}
+
+	return address
+}
+
+func (p *filterParser) accountAddress(name string, value string) *iotago.AccountAddress {
+	address := p.address(name, value)
+	if address == nil {
+		return nil
+	}
+
+	accountAddress, ok := address.(*iotago.AccountAddress)
+	if !ok {
+		p.fail(ierrors.Wrapf(ErrInvalidArgument, "invalid address in %s: %s, not an account address", name, value))
+		return nil
+	}
+
+	return accountAddress
+}
+
+func (p *filterParser) hex(name string, value string, maxLength int) []byte {
+	bytes, err := hexutil.DecodeHex(value)
+	if err != nil {
+		p.fail(ierrors.Wrapf(ErrInvalidArgument, "invalid hex value in %s: %s", name, err))
+		return nil
+	}
+	if len(bytes) > maxLength {
+		p.fail(ierrors.Wrapf(ErrInvalidArgument, "hex value in %s too long: %d bytes, max %d", name, len(bytes), maxLength))
+		return nil
+	}
+
+	return bytes
+}
+
+// id decodes a hex encoded identifier that must have exactly the given length.
+func (p *filterParser) id(name string, value string, length int) []byte {
+	bytes := p.hex(name, value, length)
+	if bytes != nil && len(bytes) != length {
+		p.fail(ierrors.Wrapf(ErrInvalidArgument, "invalid length of %s: %d bytes, expected %d", name, len(bytes), length))
+		return nil
+	}
+
+	return bytes
+}
+
+func (p *filterParser) nativeTokenID(name string, value string) iotago.NativeTokenID {
+	var nativeTokenID iotago.NativeTokenID
+	copy(nativeTokenID[:], p.id(name, value, iotago.NativeTokenIDLength))
+
+	return nativeTokenID
+}
+
+func (p *filterParser) amount(name string, value string) iotago.BaseToken {
+	amount, err := strconv.ParseUint(value, 10, 64)
+	if err != nil {
+		p.fail(ierrors.Wrapf(ErrInvalidArgument, "invalid amount in %s: %s", name, err))
+		return 0
+	}
+
+	return iotago.BaseToken(amount)
+}
+
+func (p *filterParser) slot(name string, value int32) iotago.SlotIndex {
+	if value < 0 {
+		p.fail(ierrors.Wrapf(ErrInvalidArgument, "invalid slot in %s: %d", name, value))
+		return 0
+	}
+
+	return iotago.SlotIndex(value)
+}
+
+func (p *filterParser) epoch(name string, value int32) iotago.EpochIndex {
+	if value < 0 {
+		p.fail(ierrors.Wrapf(ErrInvalidArgument, "invalid epoch in %s: %d", name, value))
+		return 0
+	}
+
+	return iotago.EpochIndex(value)
+}
+
+type outputFilter struct {
+	HasNativeToken      *bool
+	NativeToken         *string
+	UnlockableByAddress *string
+	MinAmount           *string
+	MaxAmount           *string
+	CreatedBefore       *int32
+	CreatedAfter        *int32
+	AsOfSlot            *int32
+}
+
+func (f *outputFilter) options(p *filterParser) []options.Option[indexer.CombinedFilterOptions] {
+	var opts []options.Option[indexer.CombinedFilterOptions]
+	if f == nil {
+		return opts
+	}
+
+	if f.HasNativeToken != nil {
+		opts = append(opts, indexer.CombinedHasNativeToken(*f.HasNativeToken))
+	}
+	if f.NativeToken != nil {
+		opts = append(opts, indexer.CombinedNativeToken(p.nativeTokenID("nativeToken", *f.NativeToken)))
+	}
+	if f.UnlockableByAddress != nil {
+		opts = append(opts, indexer.CombinedUnlockableByAddress(p.address("unlockableByAddress", *f.UnlockableByAddress)))
+	}
+	if f.MinAmount != nil {
+		opts = append(opts, indexer.CombinedMinAmount(p.amount("minAmount", *f.MinAmount)))
+	}
+	if f.MaxAmount != nil {
+		opts = append(opts, indexer.CombinedMaxAmount(p.amount("maxAmount", *f.MaxAmount)))
+	}
+	if f.CreatedBefore != nil {
+		opts = append(opts, indexer.CombinedCreatedBefore(p.slot("createdBefore", *f.CreatedBefore)))
+	}
+	if f.CreatedAfter != nil {
+		opts = append(opts, indexer.CombinedCreatedAfter(p.slot("createdAfter", *f.CreatedAfter)))
+	}
+	if f.AsOfSlot != nil {
+		opts = append(opts, indexer.CombinedAsOfSlot(p.slot("asOfSlot", *f.AsOfSlot)))
+	}
+
+	return opts
+}
+
+type basicOutputFilter struct {
+	HasNativeToken              *bool
+	NativeToken                 *string
+	UnlockableByAddress         *string
+	Address                     *string
+	HasStorageDepositReturn     *bool
+	StorageDepositReturnAddress *string
+	HasExpiration               *bool
+	ExpiresBefore               *int32
+	ExpiresAfter                *int32
+	ExpirationReturnAddress     *string
+	HasTimelock                 *bool
+	TimelockedBefore            *int32
+	TimelockedAfter             *int32
+	Sender                      *string
+	Tag                         *string
+	MinAmount                   *string
+	MaxAmount                   *string
+	CreatedBefore               *int32
+	CreatedAfter                *int32
+	AsOfSlot                    *int32
+}
+
+func (f *basicOutputFilter) options(p *filterParser) []options.Option[indexer.BasicFilterOptions] {
+	var opts []options.Option[indexer.BasicFilterOptions]
+	if f == nil {
+		return opts
+	}
+
+	if f.HasNativeToken != nil {
+		opts = append(opts, indexer.BasicHasNativeToken(*f.HasNativeToken))
+	}
+	if f.NativeToken != nil {
+		opts = append(opts, indexer.BasicNativeToken(p.nativeTokenID("nativeToken", *f.NativeToken)))
+	}
+	if f.UnlockableByAddress != nil {
+		opts = append(opts, indexer.BasicUnlockableByAddress(p.address("unlockableByAddress", *f.UnlockableByAddress)))
+	}
+	if f.Address != nil {
+		opts = append(opts, indexer.BasicUnlockAddress(p.address("address", *f.Address)))
+	}
+	if f.HasStorageDepositReturn != nil {
+		opts = append(opts, indexer.BasicHasStorageDepositReturnCondition(*f.HasStorageDepositReturn))
+	}
+	if f.StorageDepositReturnAddress != nil {
+		opts = append(opts, indexer.BasicStorageDepositReturnAddress(p.address("storageDepositReturnAddress", *f.StorageDepositReturnAddress)))
+	}
+	if f.HasExpiration != nil {
+		opts = append(opts, indexer.BasicHasExpirationCondition(*f.HasExpiration))
+	}
+	if f.ExpiresBefore != nil {
+		opts = append(opts, indexer.BasicExpiresBefore(p.slot("expiresBefore", *f.ExpiresBefore)))
+	}
+	if f.ExpiresAfter != nil {
+		opts = append(opts, indexer.BasicExpiresAfter(p.slot("expiresAfter", *f.ExpiresAfter)))
+	}
+	if f.ExpirationReturnAddress != nil {
+		opts = append(opts, indexer.BasicExpirationReturnAddress(p.address("expirationReturnAddress", *f.ExpirationReturnAddress)))
+	}
+	if f.HasTimelock != nil {
+		opts = append(opts, indexer.BasicHasTimelockCondition(*f.HasTimelock))
+	}
+	if f.TimelockedBefore != nil {
+		opts = append(opts, indexer.BasicTimelockedBefore(p.slot("timelockedBefore", *f.TimelockedBefore)))
+	}
+	if f.TimelockedAfter != nil {
+		opts = append(opts, indexer.BasicTimelockedAfter(p.slot("timelockedAfter", *f.TimelockedAfter)))
+	}
+	if f.Sender != nil {
+		opts = append(opts, indexer.BasicSender(p.address("sender", *f.Sender)))
+	}
+	if f.Tag != nil {
+		opts = append(opts, indexer.BasicTag(p.hex("tag", *f.Tag, apitypes.MaxTagLength)))
+	}
+	if f.MinAmount != nil {
+		opts = append(opts, indexer.BasicMinAmount(p.amount("minAmount", *f.MinAmount)))
+	}
+	if f.MaxAmount != nil {
+		opts = append(opts, indexer.BasicMaxAmount(p.amount("maxAmount", *f.MaxAmount)))
+	}
+	if f.CreatedBefore != nil {
+		opts = append(opts, indexer.BasicCreatedBefore(p.slot("createdBefore", *f.CreatedBefore)))
+	}
+	if f.CreatedAfter != nil {
+		opts = append(opts, indexer.BasicCreatedAfter(p.slot("createdAfter", *f.CreatedAfter)))
+	}
+	if f.AsOfSlot != nil {
+		opts = append(opts, indexer.BasicAsOfSlot(p.slot("asOfSlot", *f.AsOfSlot)))
+	}
+
+	return opts
+}
+
+type accountOutputFilter struct {
+	Address                  *string
+	Sender                   *string
+	Issuer                   *string
+	IsBlockIssuer            *bool
+	BlockIssuerExpiresBefore *int32
+	BlockIssuerExpiresAfter  *int32
+	MinAmount                *string
+	MaxAmount                *string
+	CreatedBefore            *int32
+	CreatedAfter             *int32
+	AsOfSlot                 *int32
+}
+
+func (f *accountOutputFilter) options(p *filterParser) []options.Option[indexer.AccountFilterOptions] {
+	var opts []options.Option[indexer.AccountFilterOptions]
+	if f == nil {
+		return opts
+	}
+
+	if f.Address != nil {
+		opts = append(opts, indexer.AccountUnlockAddress(p.address("address", *f.Address)))
+	}
+	if f.Sender != nil {
+		opts = append(opts, indexer.AccountSender(p.address("sender", *f.Sender)))
+	}
+	if f.Issuer != nil {
+		opts = append(opts, indexer.AccountIssuer(p.address("issuer", *f.Issuer)))
+	}
+	if f.IsBlockIssuer != nil {
+		opts = append(opts, indexer.AccountIsBlockIssuer(*f.IsBlockIssuer))
+	}
+	if f.BlockIssuerExpiresBefore != nil {
+		opts = append(opts, indexer.AccountBlockIssuerExpiresBefore(p.slot("blockIssuerExpiresBefore", *f.BlockIssuerExpiresBefore)))
+	}
+	if f.BlockIssuerExpiresAfter != nil {
+		opts = append(opts, indexer.AccountBlockIssuerExpiresAfter(p.slot("blockIssuerExpiresAfter", *f.BlockIssuerExpiresAfter)))
+	}
+	if f.MinAmount != nil {
+		opts = append(opts, indexer.AccountMinAmount(p.amount("minAmount", *f.MinAmount)))
+	}
+	if f.MaxAmount != nil {
+		opts = append(opts, indexer.AccountMaxAmount(p.amount("maxAmount", *f.MaxAmount)))
+	}
+	if f.CreatedBefore != nil {
+		opts = append(opts, indexer.AccountCreatedBefore(p.slot("createdBefore", *f.CreatedBefore)))
+	}
+	if f.CreatedAfter != nil {
+		opts = append(opts, indexer.AccountCreatedAfter(p.slot("createdAfter", *f.CreatedAfter)))
+	}
+	if f.AsOfSlot != nil {
+		opts = append(opts, indexer.AccountAsOfSlot(p.slot("asOfSlot", *f.AsOfSlot)))
+	}
+
+	return opts
+}
+
+type anchorOutputFilter struct {
+	UnlockableByAddress *string
+	StateController     *string
+	Governor            *string
+	Sender              *string
+	Issuer              *string
+	MinAmount           *string
+	MaxAmount           *string
+	CreatedBefore       *int32
+	CreatedAfter        *int32
+	AsOfSlot            *int32
+}
+
+func (f *anchorOutputFilter) options(p *filterParser) []options.Option[indexer.AnchorFilterOptions] {
+	var opts []options.Option[indexer.AnchorFilterOptions]
+	if f == nil {
+		return opts
+	}
+
+	if f.UnlockableByAddress != nil {
+		opts = append(opts, indexer.AnchorUnlockableByAddress(p.address("unlockableByAddress", *f.UnlockableByAddress)))
+	}
+	if f.StateController != nil {
+		opts = append(opts, indexer.AnchorStateController(p.address("stateController", *f.StateController)))
+	}
+	if f.Governor != nil {
+		opts = append(opts, indexer.AnchorGovernor(p.address("governor", *f.Governor)))
+	}
+	if f.Sender != nil {
+		opts = append(opts, indexer.AnchorSender(p.address("sender", *f.Sender)))
+	}
+	if f.Issuer != nil {
+		opts = append(opts, indexer.AnchorIssuer(p.address("issuer", *f.Issuer)))
+	}
+	if f.MinAmount != nil {
+		opts = append(opts, indexer.AnchorMinAmount(p.amount("minAmount", *f.MinAmount)))
+	}
+	if f.MaxAmount != nil {
+		opts = append(opts, indexer.AnchorMaxAmount(p.amount("maxAmount", *f.MaxAmount)))
+	}
+	if f.CreatedBefore != nil {
+		opts = append(opts, indexer.AnchorCreatedBefore(p.slot("createdBefore", *f.CreatedBefore)))
+	}
+	if f.CreatedAfter != nil {
+		opts = append(opts, indexer.AnchorCreatedAfter(p.slot("createdAfter", *f.CreatedAfter)))
+	}
+	if f.AsOfSlot != nil {
+		opts = append(opts, indexer.AnchorAsOfSlot(p.slot("asOfSlot", *f.AsOfSlot)))
+	}
+
+	return opts
+}
+
+type nftOutputFilter struct {
+	UnlockableByAddress         *string
+	Address                     *string
+	HasStorageDepositReturn     *bool
+	StorageDepositReturnAddress *string
+	HasExpiration               *bool
+	ExpiresBefore               *int32
+	ExpiresAfter                *int32
+	ExpirationReturnAddress     *string
+	HasTimelock                 *bool
+	TimelockedBefore            *int32
+	TimelockedAfter             *int32
+	Issuer                      *string
+	Collection                  *string
+	CollectionName              *string
+	MediaType                   *string
+	NamePrefix                  *string
+	Sender                      *string
+	Tag                         *string
+	MinAmount                   *string
+	MaxAmount                   *string
+	CreatedBefore               *int32
+	CreatedAfter                *int32
+	AsOfSlot                    *int32
+}
+
+func (f *nftOutputFilter) options(p *filterParser) []options.Option[indexer.NFTFilterOptions] {
+	var opts []options.Option[indexer.NFTFilterOptions]
+	if f == nil {
+		return opts
+	}
+
+	if f.UnlockableByAddress != nil {
+		opts = append(opts, indexer.NFTUnlockableByAddress(p.address("unlockableByAddress", *f.UnlockableByAddress)))
+	}
+	if f.Address != nil {
+		opts = append(opts, indexer.NFTUnlockAddress(p.address("address", *f.Address)))
+	}
+	if f.HasStorageDepositReturn != nil {
+		opts = append(opts, indexer.NFTHasStorageDepositReturnCondition(*f.HasStorageDepositReturn))
+	}
+	if f.StorageDepositReturnAddress != nil {
+		opts = append(opts, indexer.NFTStorageDepositReturnAddress(p.address("storageDepositReturnAddress", *f.StorageDepositReturnAddress)))
+	}
+	if f.HasExpiration != nil {
+		opts = append(opts, indexer.NFTHasExpirationCondition(*f.HasExpiration))
+	}
+	if f.ExpiresBefore != nil {
+		opts = append(opts, indexer.NFTExpiresBefore(p.slot("expiresBefore", *f.ExpiresBefore)))
+	}
+	if f.ExpiresAfter != nil {
+		opts = append(opts, indexer.NFTExpiresAfter(p.slot("expiresAfter", *f.ExpiresAfter)))
+	}
+	if f.ExpirationReturnAddress != nil {
+		opts = append(opts, indexer.NFTExpirationReturnAddress(p.address("expirationReturnAddress", *f.ExpirationReturnAddress)))
+	}
+	if f.HasTimelock != nil {
+		opts = append(opts, indexer.NFTHasTimelockCondition(*f.HasTimelock))
+	}
+	if f.TimelockedBefore != nil {
+		opts = append(opts, indexer.NFTTimelockedBefore(p.slot("timelockedBefore", *f.TimelockedBefore)))
+	}
+	if f.TimelockedAfter != nil {
+		opts = append(opts, indexer.NFTTimelockedAfter(p.slot("timelockedAfter", *f.TimelockedAfter)))
+	}
+	if f.Issuer != nil {
+		opts = append(opts, indexer.NFTIssuer(p.address("issuer", *f.Issuer)))
+	}
+	if f.Collection != nil {
+		var collection iotago.NFTID
+		copy(collection[:], p.id("collection", *f.Collection, iotago.NFTIDLength))
+		opts = append(opts, indexer.NFTCollection(collection))
+	}
+	if f.CollectionName != nil {
+		opts = append(opts, indexer.NFTCollectionName(*f.CollectionName))
+	}
+	if f.MediaType != nil {
+		opts = append(opts, indexer.NFTMediaType(*f.MediaType))
+	}
+	if f.NamePrefix != nil {
+		opts = append(opts, indexer.NFTNamePrefix(*f.NamePrefix))
+	}
+	if f.Sender != nil {
+		opts = append(opts, indexer.NFTSender(p.address("sender", *f.Sender)))
+	}
+	if f.Tag != nil {
+		opts = append(opts, indexer.NFTTag(p.hex("tag", *f.Tag, apitypes.MaxTagLength)))
+	}
+	if f.MinAmount != nil {
+		opts = append(opts, indexer.NFTMinAmount(p.amount("minAmount", *f.MinAmount)))
+	}
+	if f.MaxAmount != nil {
+		opts = append(opts, indexer.NFTMaxAmount(p.amount("maxAmount", *f.MaxAmount)))
+	}
+	if f.CreatedBefore != nil {
+		opts = append(opts, indexer.NFTCreatedBefore(p.slot("createdBefore", *f.CreatedBefore)))
+	}
+	if f.CreatedAfter != nil {
+		opts = append(opts, indexer.NFTCreatedAfter(p.slot("createdAfter", *f.CreatedAfter)))
+	}
+	if f.AsOfSlot != nil {
+		opts = append(opts, indexer.NFTAsOfSlot(p.slot("asOfSlot", *f.AsOfSlot)))
+	}
+
+	return opts
+}
+
+type foundryOutputFilter struct {
+	HasNativeToken  *bool
+	NativeToken     *string
+	Account         *string
+	SerialNumber    *int32
+	HasMintCapacity *bool
+	MinAmount       *string
+	MaxAmount       *string
+	CreatedBefore   *int32
+	CreatedAfter    *int32
+	AsOfSlot        *int32
+}
+
+func (f *foundryOutputFilter) options(p *filterParser) []options.Option[indexer.FoundryFilterOptions] {
+	var opts []options.Option[indexer.FoundryFilterOptions]
+	if f == nil {
+		return opts
+	}
+
+	if f.HasNativeToken != nil {
+		opts = append(opts, indexer.FoundryHasNativeToken(*f.HasNativeToken))
+	}
+	if f.NativeToken != nil {
+		opts = append(opts, indexer.FoundryNativeToken(p.nativeTokenID("nativeToken", *f.NativeToken)))
+	}
+	if f.Account != nil {
+		opts = append(opts, indexer.FoundryWithAccountAddress(p.accountAddress("account", *f.Account)))
+	}
+	if f.SerialNumber != nil {
+		if *f.SerialNumber < 0 {
+			p.fail(ierrors.Wrapf(ErrInvalidArgument, "invalid serialNumber: %d", *f.SerialNumber))
+		}
+		opts = append(opts, indexer.FoundrySerialNumber(uint32(*f.SerialNumber)))
+	}
+	if f.HasMintCapacity != nil {
+		opts = append(opts, indexer.FoundryHasMintCapacity(*f.HasMintCapacity))
+	}
+	if f.MinAmount != nil {
+		opts = append(opts, indexer.FoundryMinAmount(p.amount("minAmount", *f.MinAmount)))
+	}
+	if f.MaxAmount != nil {
+		opts = append(opts, indexer.FoundryMaxAmount(p.amount("maxAmount", *f.MaxAmount)))
+	}
+	if f.CreatedBefore != nil {
+		opts = append(opts, indexer.FoundryCreatedBefore(p.slot("createdBefore", *f.CreatedBefore)))
+	}
+	if f.CreatedAfter != nil {
+		opts = append(opts, indexer.FoundryCreatedAfter(p.slot("createdAfter", *f.CreatedAfter)))
+	}
+	if f.AsOfSlot != nil {
+		opts = append(opts, indexer.FoundryAsOfSlot(p.slot("asOfSlot", *f.AsOfSlot)))
+	}
+
+	return opts
+}
+
+type delegationOutputFilter struct {
+	Address            *string
+	Validator          *string
+	MinDelegatedAmount *string
+	MaxDelegatedAmount *string
+	StartEpochBefore   *int32
+	StartEpochAfter    *int32
+	HasEndEpoch        *bool
+	MinAmount          *string
+	MaxAmount          *string
+	CreatedBefore      *int32
+	CreatedAfter       *int32
+	AsOfSlot           *int32
+}
+
+func (f *delegationOutputFilter) options(p *filterParser) []options.Option[indexer.DelegationFilterOptions] {
+	var opts []options.Option[indexer.DelegationFilterOptions]
+	if f == nil {
+		return opts
+	}
+
+	if f.Address != nil {
+		opts = append(opts, indexer.DelegationAddress(p.address("address", *f.Address)))
+	}
+	if f.Validator != nil {
+		opts = append(opts, indexer.DelegationValidator(p.accountAddress("validator", *f.Validator)))
+	}
+	if f.MinDelegatedAmount != nil {
+		opts = append(opts, indexer.DelegationMinDelegatedAmount(p.amount("minDelegatedAmount", *f.MinDelegatedAmount)))
+	}
+	if f.MaxDelegatedAmount != nil {
+		opts = append(opts, indexer.DelegationMaxDelegatedAmount(p.amount("maxDelegatedAmount", *f.MaxDelegatedAmount)))
+	}
+	if f.StartEpochBefore != nil {
+		opts = append(opts, indexer.DelegationStartEpochBefore(p.epoch("startEpochBefore", *f.StartEpochBefore)))
+	}
+	if f.StartEpochAfter != nil {
+		opts = append(opts, indexer.DelegationStartEpochAfter(p.epoch("startEpochAfter", *f.StartEpochAfter)))
+	}
+	if f.HasEndEpoch != nil {
+		opts = append(opts, indexer.DelegationHasEndEpoch(*f.HasEndEpoch))
+	}
+	if f.MinAmount != nil {
+		opts = append(opts, indexer.DelegationMinAmount(p.amount("minAmount", *f.MinAmount)))
+	}
+	if f.MaxAmount != nil {
+		opts = append(opts, indexer.DelegationMaxAmount(p.amount("maxAmount", *f.MaxAmount)))
+	}
+	if f.CreatedBefore != nil {
+		opts = append(opts, indexer.DelegationCreatedBefore(p.slot("createdBefore", *f.CreatedBefore)))
+	}
+	if f.CreatedAfter != nil {
+		opts = append(opts, indexer.DelegationCreatedAfter(p.slot("createdAfter", *f.CreatedAfter)))
+	}
+	if f.AsOfSlot != nil {
+		opts = append(opts, indexer.DelegationAsOfSlot(p.slot("asOfSlot", *f.AsOfSlot)))
+	}
+
+	return opts
+}
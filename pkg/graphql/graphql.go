@@ -0,0 +1,143 @@
+package graphql
+
+import (
+	_ "embed"
+	"net/http"
+
+	graphqlgo "github.com/graph-gophers/graphql-go"
+	"github.com/labstack/echo/v4"
+
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/hive.go/runtime/options"
+	"github.com/iotaledger/inx-app/pkg/httpserver"
+	"github.com/iotaledger/inx-indexer/pkg/auth"
+	"github.com/iotaledger/inx-indexer/pkg/indexer"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+const (
+	// Endpoint is the endpoint of the GraphQL API, it is registered next to the REST API routes.
+	// POST takes a GraphQL request ({"query", "operationName", "variables"}) as JSON and returns the GraphQL response.
+	Endpoint = "/graphql"
+)
+
+//go:embed schema.graphql
+var schema string
+
+// Server resolves GraphQL queries with the same indexer methods that back the REST API.
+type Server struct {
+	indexer     *indexer.Indexer
+	apiProvider iotago.APIProvider
+	bech32HRP   iotago.NetworkPrefix
+	schema      *graphqlgo.Schema
+
+	// optsMaxPageSize defines the maximum number of outputs returned per connection.
+	optsMaxPageSize uint32
+	// optsDefaultPageSize defines the number of outputs returned per connection if "first" is not given.
+	optsDefaultPageSize uint32
+	// optsMaxDepth defines the maximum nesting depth of a query.
+	optsMaxDepth int
+	// optsMaxNodes defines the maximum number of nodes a query may resolve.
+	optsMaxNodes int
+	// optsMaxParallelism defines the maximum number of resolvers of a query that run concurrently.
+	optsMaxParallelism int
+}
+
+// request is the body of a GraphQL request.
+type request struct {
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName"`
+	Variables     map[string]interface{} `json:"variables"`
+}
+
+// NewServer creates a new GraphQL server and parses the schema against the resolvers.
+func NewServer(idx *indexer.Indexer, apiProvider iotago.APIProvider, opts ...options.Option[Server]) (*Server, error) {
+	s := options.Apply(&Server{
+		indexer:             idx,
+		apiProvider:         apiProvider,
+		bech32HRP:           apiProvider.CommittedAPI().ProtocolParameters().Bech32HRP(),
+		optsMaxPageSize:     indexer.DefaultPageSize,
+		optsDefaultPageSize: 20,
+		optsMaxDepth:        10,
+		optsMaxNodes:        5000,
+		optsMaxParallelism:  4,
+	}, opts)
+
+	parsedSchema, err := graphqlgo.ParseSchema(schema, &queryResolver{s: s},
+		graphqlgo.MaxDepth(s.optsMaxDepth),
+		graphqlgo.MaxParallelism(s.optsMaxParallelism),
+	)
+	if err != nil {
+		return nil, ierrors.Wrap(err, "failed to parse GraphQL schema")
+	}
+	s.schema = parsedSchema
+
+	return s, nil
+}
+
+// WithMaxPageSize sets the maximum number of outputs returned per connection.
+func WithMaxPageSize(maxPageSize uint32) options.Option[Server] {
+	return func(s *Server) {
+		s.optsMaxPageSize = maxPageSize
+	}
+}
+
+// WithDefaultPageSize sets the number of outputs returned per connection if "first" is not given.
+func WithDefaultPageSize(defaultPageSize uint32) options.Option[Server] {
+	return func(s *Server) {
+		s.optsDefaultPageSize = defaultPageSize
+	}
+}
+
+// WithMaxDepth sets the maximum nesting depth of a query.
+func WithMaxDepth(maxDepth int) options.Option[Server] {
+	return func(s *Server) {
+		s.optsMaxDepth = maxDepth
+	}
+}
+
+// WithMaxNodes sets the maximum number of nodes a query may resolve.
+// Every connection counts with its page size and every lookup of a single output counts as one node.
+func WithMaxNodes(maxNodes int) options.Option[Server] {
+	return func(s *Server) {
+		s.optsMaxNodes = maxNodes
+	}
+}
+
+// WithMaxParallelism sets the maximum number of resolvers of a query that run concurrently.
+func WithMaxParallelism(maxParallelism int) options.Option[Server] {
+	return func(s *Server) {
+		s.optsMaxParallelism = maxParallelism
+	}
+}
+
+// ConfigureRoutes registers the GraphQL endpoint in the given route group.
+func (s *Server) ConfigureRoutes(routeGroup *echo.Group) {
+	routeGroup.POST(Endpoint, s.handleRequest)
+}
+
+// maxPageSize returns the maximum page size of the client of the request, which defaults to the configured limit.
+func (s *Server) maxPageSize(c echo.Context) uint32 {
+	if maxPageSize := auth.MaxPageSize(c); maxPageSize > 0 {
+		return uint32(maxPageSize)
+	}
+
+	return s.optsMaxPageSize
+}
+
+func (s *Server) handleRequest(c echo.Context) error {
+	req := &request{}
+	if err := c.Bind(req); err != nil {
+		return ierrors.WithMessagef(httpserver.ErrInvalidParameter, "invalid request: %s", err)
+	}
+
+	if req.Query == "" {
+		return ierrors.WithMessage(httpserver.ErrInvalidParameter, "invalid request: query is missing")
+	}
+
+	ctx := withNodeBudget(c.Request().Context(), s.optsMaxNodes)
+	ctx = withMaxPageSize(ctx, s.maxPageSize(c))
+
+	// Errors of the query itself are part of the GraphQL response
+	return c.JSON(http.StatusOK, s.schema.Exec(ctx, req.Query, req.OperationName, req.Variables))
+}
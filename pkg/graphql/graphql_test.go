@@ -0,0 +1,365 @@
+package graphql_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/hive.go/db"
+	"github.com/iotaledger/hive.go/log"
+	"github.com/iotaledger/hive.go/runtime/options"
+	"github.com/iotaledger/hive.go/sql"
+	"github.com/iotaledger/inx-indexer/pkg/apitypes"
+	"github.com/iotaledger/inx-indexer/pkg/auth"
+	"github.com/iotaledger/inx-indexer/pkg/graphql"
+	"github.com/iotaledger/inx-indexer/pkg/indexer"
+	iotago "github.com/iotaledger/iota.go/v4"
+	iotago_tpkg "github.com/iotaledger/iota.go/v4/tpkg"
+)
+
+type response struct {
+	Data   json.RawMessage `json:"data"`
+	Errors []struct {
+		Message string `json:"message"`
+	} `json:"errors"`
+}
+
+type testServer struct {
+	t       *testing.T
+	indexer *indexer.Indexer
+	echo    *echo.Echo
+	// apiKey is sent in the X-API-Key header of the queries if it is set.
+	apiKey string
+}
+
+func newTestServer(t *testing.T, opts ...options.Option[graphql.Server]) *testServer {
+	dbParams := sql.DatabaseParameters{
+		Engine:   db.EngineSQLite,
+		Path:     t.TempDir(),
+		Filename: "indexer_test.db",
+	}
+
+	apiProvider := iotago.SingleVersionProvider(iotago_tpkg.ZeroCostTestAPI)
+
+	idx, err := indexer.NewIndexer(dbParams, apiProvider, log.NewLogger().NewChildLogger(t.Name()))
+	require.NoError(t, err)
+	require.NoError(t, idx.CreateTables())
+	require.NoError(t, idx.ImportTransaction(context.Background()).Finalize(0, t.Name(), 1))
+	require.NoError(t, idx.AutoMigrate())
+
+	graphQLServer, err := graphql.NewServer(idx, apiProvider, append([]options.Option[graphql.Server]{graphql.WithMaxPageSize(10)}, opts...)...)
+	require.NoError(t, err)
+
+	e := echo.New()
+	graphQLServer.ConfigureRoutes(e.Group("/api"))
+
+	return &testServer{
+		t:       t,
+		indexer: idx,
+		echo:    e,
+	}
+}
+
+func (s *testServer) commit(slot iotago.SlotIndex, outputs map[iotago.OutputID]iotago.Output) {
+	update := &indexer.LedgerUpdate{Slot: slot}
+	for outputID, output := range outputs {
+		update.Created = append(update.Created, &indexer.LedgerOutput{OutputID: outputID, Output: output, BookedAt: slot})
+	}
+	require.NoError(s.t, s.indexer.CommitLedgerUpdate(update))
+}
+
+// query executes the query and unmarshals the data of the response into result.
+func (s *testServer) query(query string, variables map[string]interface{}, result interface{}) []string {
+	body, err := json.Marshal(map[string]interface{}{
+		"query":     query,
+		"variables": variables,
+	})
+	require.NoError(s.t, err)
+
+	req := httptest.NewRequest(http.MethodPost, "/api"+graphql.Endpoint, bytes.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	if s.apiKey != "" {
+		req.Header.Set(apitypes.HeaderAPIKey, s.apiKey)
+	}
+	rec := httptest.NewRecorder()
+	s.echo.ServeHTTP(rec, req)
+	require.Equal(s.t, http.StatusOK, rec.Code, rec.Body.String())
+
+	resp := &response{}
+	require.NoError(s.t, json.Unmarshal(rec.Body.Bytes(), resp))
+
+	var messages []string
+	for _, e := range resp.Errors {
+		messages = append(messages, e.Message)
+	}
+	if len(messages) == 0 && result != nil {
+		require.NoError(s.t, json.Unmarshal(resp.Data, result))
+	}
+
+	return messages
+}
+
+func TestServer_NFTOutputsOfAccount(t *testing.T) {
+	ts := newTestServer(t)
+	hrp := iotago_tpkg.ZeroCostTestAPI.ProtocolParameters().Bech32HRP()
+
+	ownerAddress := iotago_tpkg.RandEd25519Address()
+	accountID := iotago_tpkg.RandAccountID()
+	accountAddress := accountID.ToAddress().(*iotago.AccountAddress)
+
+	accountOutputID := iotago_tpkg.RandOutputID(0)
+	nftOutputID := iotago_tpkg.RandOutputID(0)
+	otherNFTOutputID := iotago_tpkg.RandOutputID(0)
+
+	ts.commit(1, map[iotago.OutputID]iotago.Output{
+		accountOutputID: &iotago.AccountOutput{
+			Amount:    100000,
+			AccountID: accountID,
+			UnlockConditions: iotago.AccountOutputUnlockConditions{
+				&iotago.AddressUnlockCondition{Address: ownerAddress},
+			},
+		},
+		// NFT issued by the account and owned by the account
+		nftOutputID: &iotago.NFTOutput{
+			Amount: 200000,
+			UnlockConditions: iotago.NFTOutputUnlockConditions{
+				&iotago.AddressUnlockCondition{Address: accountAddress},
+			},
+			Features: iotago.NFTOutputFeatures{
+				&iotago.TagFeature{Tag: []byte("tag")},
+			},
+			ImmutableFeatures: iotago.NFTOutputImmFeatures{
+				&iotago.IssuerFeature{Address: accountAddress},
+			},
+		},
+		// NFT issued by the account but owned by someone else
+		otherNFTOutputID: &iotago.NFTOutput{
+			Amount: 300000,
+			UnlockConditions: iotago.NFTOutputUnlockConditions{
+				&iotago.AddressUnlockCondition{Address: ownerAddress},
+			},
+			ImmutableFeatures: iotago.NFTOutputImmFeatures{
+				&iotago.IssuerFeature{Address: accountAddress},
+			},
+		},
+	})
+
+	var result struct {
+		NFTOutputs struct {
+			CommittedSlot int
+			Items         []struct {
+				OutputID string
+				Amount   string
+				NFTID    string
+				Tag      *string
+				Address  struct {
+					Type          string
+					AccountOutput struct {
+						OutputID  string
+						AccountID string
+						Address   struct {
+							Bech32 string
+						}
+					}
+				}
+			}
+		}
+	}
+	errs := ts.query(`query($issuer: String!, $address: String!) {
+		nftOutputs(filter: {issuer: $issuer, address: $address}) {
+			committedSlot
+			items {
+				outputId
+				amount
+				... on NFTOutput {
+					nftId
+					tag
+					address {
+						type
+						accountOutput { outputId accountId address { bech32 } }
+					}
+				}
+			}
+		}
+	}`, map[string]interface{}{
+		"issuer":  accountAddress.Bech32(hrp),
+		"address": accountAddress.Bech32(hrp),
+	}, &result)
+	require.Empty(t, errs)
+
+	require.Equal(t, 1, result.NFTOutputs.CommittedSlot)
+	require.Len(t, result.NFTOutputs.Items, 1)
+
+	item := result.NFTOutputs.Items[0]
+	require.Equal(t, nftOutputID.ToHex(), item.OutputID)
+	require.Equal(t, "200000", item.Amount)
+	require.Equal(t, iotago.NFTIDFromOutputID(nftOutputID).ToHex(), item.NFTID)
+	require.NotNil(t, item.Tag)
+	require.Equal(t, "0x746167", *item.Tag)
+	require.Equal(t, iotago.AddressAccount.String(), item.Address.Type)
+	require.Equal(t, accountOutputID.ToHex(), item.Address.AccountOutput.OutputID)
+	require.Equal(t, accountID.ToHex(), item.Address.AccountOutput.AccountID)
+	require.Equal(t, ownerAddress.Bech32(hrp), item.Address.AccountOutput.Address.Bech32)
+
+	// The outputs of an address contain all output types that can be unlocked by it
+	var addressResult struct {
+		Address struct {
+			Outputs struct {
+				Items []struct {
+					OutputID string
+					JSON     string
+				}
+			}
+		}
+	}
+	errs = ts.query(`query($address: String!) {
+		address(bech32: $address) { outputs(sort: AMOUNT_ASC) { items { outputId json } } }
+	}`, map[string]interface{}{"address": ownerAddress.Bech32(hrp)}, &addressResult)
+	require.Empty(t, errs)
+	require.Len(t, addressResult.Address.Outputs.Items, 2)
+	require.Equal(t, accountOutputID.ToHex(), addressResult.Address.Outputs.Items[0].OutputID)
+	require.Equal(t, otherNFTOutputID.ToHex(), addressResult.Address.Outputs.Items[1].OutputID)
+	require.Contains(t, addressResult.Address.Outputs.Items[1].JSON, `"amount":"300000"`)
+}
+
+func TestServer_Pagination(t *testing.T) {
+	ts := newTestServer(t)
+	hrp := iotago_tpkg.ZeroCostTestAPI.ProtocolParameters().Bech32HRP()
+
+	address := iotago_tpkg.RandEd25519Address()
+	outputs := make(map[iotago.OutputID]iotago.Output)
+	for i := 0; i < 5; i++ {
+		outputs[iotago_tpkg.RandOutputID(0)] = &iotago.BasicOutput{
+			Amount: iotago.BaseToken(100000 + i),
+			UnlockConditions: iotago.BasicOutputUnlockConditions{
+				&iotago.AddressUnlockCondition{Address: address},
+			},
+		}
+	}
+	ts.commit(1, outputs)
+
+	type page struct {
+		BasicOutputs struct {
+			PageSize int
+			Cursor   *string
+			Items    []struct {
+				OutputID string
+			}
+		}
+	}
+
+	query := `query($address: String!, $first: Int, $after: String) {
+		basicOutputs(filter: {address: $address}, first: $first, after: $after) { pageSize cursor items { outputId } }
+	}`
+
+	seen := make(map[string]struct{})
+	variables := map[string]interface{}{"address": address.Bech32(hrp), "first": 2}
+	for {
+		var result page
+		require.Empty(t, ts.query(query, variables, &result))
+		require.Equal(t, 2, result.BasicOutputs.PageSize)
+
+		for _, item := range result.BasicOutputs.Items {
+			seen[item.OutputID] = struct{}{}
+		}
+
+		if result.BasicOutputs.Cursor == nil {
+			break
+		}
+		variables["after"] = *result.BasicOutputs.Cursor
+	}
+	require.Len(t, seen, len(outputs))
+
+	// The page size is limited by the configured maximum
+	var result page
+	variables = map[string]interface{}{"address": address.Bech32(hrp), "first": 100}
+	require.Empty(t, ts.query(query, variables, &result))
+	require.Equal(t, 10, result.BasicOutputs.PageSize)
+}
+
+func TestServer_ClientMaxPageSize(t *testing.T) {
+	ts := newTestServer(t)
+	ts.echo.Use(auth.New(auth.WithAPIKeys(map[string]*auth.Client{
+		"key": {ID: "client", MaxPageSize: 3},
+	})).Middleware())
+
+	query := `query($first: Int) { basicOutputs(first: $first) { pageSize } }`
+
+	type page struct {
+		BasicOutputs struct {
+			PageSize int
+		}
+	}
+
+	// The page size is limited by the maximum page size of the client
+	ts.apiKey = "key"
+	var result page
+	require.Empty(t, ts.query(query, map[string]interface{}{"first": 100}, &result))
+	require.Equal(t, 3, result.BasicOutputs.PageSize)
+}
+
+func TestServer_InvalidArguments(t *testing.T) {
+	ts := newTestServer(t)
+
+	for name, query := range map[string]string{
+		"invalid address":    `{ basicOutputs(filter: {address: "invalid"}) { pageSize } }`,
+		"wrong network":      `{ basicOutputs(filter: {address: "` + iotago_tpkg.RandEd25519Address().Bech32(iotago.PrefixMainnet) + `"}) { pageSize } }`,
+		"invalid amount":     `{ outputs(filter: {minAmount: "-1"}) { pageSize } }`,
+		"invalid first":      `{ outputs(first: 0) { pageSize } }`,
+		"invalid cursor":     `{ outputs(after: "invalid") { pageSize } }`,
+		"invalid account ID": `{ account(accountId: "0x1234") { outputId } }`,
+		"unknown field":      `{ outputs { unknown } }`,
+	} {
+		require.NotEmpty(t, ts.query(query, nil, nil), name)
+	}
+
+	// Lookups of unknown IDs return null instead of an error
+	var result struct {
+		NFT *struct{ OutputID string }
+	}
+	require.Empty(t, ts.query(`query($nftId: String!) { nft(nftId: $nftId) { outputId } }`, map[string]interface{}{
+		"nftId": iotago_tpkg.RandNFTAddress().NFTID().ToHex(),
+	}, &result))
+	require.Nil(t, result.NFT)
+}
+
+func TestServer_NodeBudget(t *testing.T) {
+	ts := newTestServer(t, graphql.WithDefaultPageSize(5), graphql.WithMaxNodes(25))
+	address := iotago_tpkg.RandEd25519Address()
+
+	outputs := make(map[iotago.OutputID]iotago.Output)
+	for range 3 {
+		outputs[iotago_tpkg.RandOutputID(0)] = &iotago.BasicOutput{
+			Amount: 100000,
+			UnlockConditions: iotago.BasicOutputUnlockConditions{
+				&iotago.AddressUnlockCondition{Address: address},
+			},
+		}
+	}
+	ts.commit(1, outputs)
+
+	// Connections without "first" return the default page size
+	var result struct {
+		Outputs struct{ PageSize int }
+	}
+	require.Empty(t, ts.query(`{ outputs { pageSize } }`, nil, &result))
+	require.Equal(t, 5, result.Outputs.PageSize)
+
+	// Every connection is charged with its page size
+	require.Empty(t, ts.query(`{ a: outputs(first: 10) { pageSize } b: outputs(first: 10) { pageSize } }`, nil, nil))
+
+	messages := ts.query(`{ a: outputs(first: 10) { pageSize } b: outputs(first: 10) { pageSize } c: outputs(first: 10) { pageSize } }`, nil, nil)
+	require.NotEmpty(t, messages)
+	require.Contains(t, messages[0], "budget")
+
+	// Nested connections are charged for every parent node
+	messages = ts.query(`{ outputs(first: 10) { items { ... on BasicOutput { address { outputs(first: 10) { pageSize } } } } } }`, nil, nil)
+	require.NotEmpty(t, messages)
+	require.Contains(t, messages[0], "budget")
+}
@@ -0,0 +1,629 @@
+package graphql
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/inx-indexer/pkg/indexer"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+// outputConnection is a page of outputs.
+type outputConnection struct {
+	committedSlot iotago.SlotIndex
+	pageSize      uint32
+	cursor        *string
+	items         []*outputResolver
+}
+
+func (c *outputConnection) CommittedSlot() int32 {
+	return int32(c.committedSlot)
+}
+
+func (c *outputConnection) PageSize() int32 {
+	return int32(c.pageSize)
+}
+
+func (c *outputConnection) Cursor() *string {
+	return c.cursor
+}
+
+func (c *outputConnection) Items() []*outputResolver {
+	return c.items
+}
+
+// errorFromResult converts the error of an indexer result into an error of the GraphQL response.
+func errorFromResult(err error) error {
+	if ierrors.Is(err, indexer.ErrSlotNotRetained) {
+		return ierrors.Wrapf(ErrInvalidArgument, "invalid asOfSlot: %s", err)
+	}
+
+	if ierrors.Is(err, indexer.ErrInvalidCursor) {
+		return ierrors.Wrapf(ErrInvalidArgument, "invalid after: %s", err)
+	}
+
+	return ierrors.Wrap(err, "reading outputIDs failed")
+}
+
+// outputConnection loads the stored outputs of the result.
+func (s *Server) outputConnection(result *indexer.IndexerResult) (*outputConnection, error) {
+	if result.Error != nil {
+		return nil, errorFromResult(result.Error)
+	}
+
+	storedOutputs, err := s.indexer.StoredOutputs(result.OutputIDs)
+	if err != nil {
+		return nil, ierrors.Wrap(err, "reading outputs failed")
+	}
+
+	items := make([]*outputResolver, 0, len(storedOutputs))
+	for _, storedOutput := range storedOutputs {
+		if storedOutput.Missing {
+			// the output was spent after its outputID was queried, its fields can't be resolved anymore
+			continue
+		}
+		items = append(items, &outputResolver{s: s, stored: storedOutput})
+	}
+
+	return &outputConnection{
+		committedSlot: result.CommittedSlot,
+		pageSize:      result.PageSize,
+		cursor:        result.Cursor,
+		items:         items,
+	}, nil
+}
+
+// singleOutput loads the stored output of a lookup by ID, it returns nil if there is no such output.
+func (s *Server) singleOutput(result *indexer.IndexerResult) (*outputResolver, error) {
+	connection, err := s.outputConnection(result)
+	if err != nil {
+		return nil, err
+	}
+	if len(connection.items) == 0 {
+		return nil, nil
+	}
+
+	return connection.items[0], nil
+}
+
+// outputResolver resolves the fields of the Output interface.
+type outputResolver struct {
+	s      *Server
+	stored *indexer.StoredOutput
+}
+
+func (r *outputResolver) OutputID() string {
+	return r.stored.OutputID.ToHex()
+}
+
+func (r *outputResolver) Amount() string {
+	return strconv.FormatUint(uint64(r.stored.Output.BaseTokenAmount()), 10)
+}
+
+func (r *outputResolver) Mana() string {
+	return strconv.FormatUint(uint64(r.stored.Output.StoredMana()), 10)
+}
+
+func (r *outputResolver) CreatedAtSlot() int32 {
+	return int32(r.stored.CreatedAtSlot)
+}
+
+func (r *outputResolver) Committed() bool {
+	return r.stored.Committed
+}
+
+func (r *outputResolver) JSON() (string, error) {
+	jsonBytes, err := r.s.apiProvider.APIForSlot(r.stored.CreatedAtSlot).JSONEncode(r.stored.Output)
+	if err != nil {
+		return "", ierrors.Wrapf(err, "failed to encode output %s", r.stored.OutputID.ToHex())
+	}
+
+	return string(jsonBytes), nil
+}
+
+func (r *outputResolver) ToBasicOutput() (*basicOutputResolver, bool) {
+	output, ok := r.stored.Output.(*iotago.BasicOutput)
+	if !ok {
+		return nil, false
+	}
+
+	return &basicOutputResolver{outputResolver: r, output: output}, true
+}
+
+func (r *outputResolver) ToAccountOutput() (*accountOutputResolver, bool) {
+	output, ok := r.stored.Output.(*iotago.AccountOutput)
+	if !ok {
+		return nil, false
+	}
+
+	return &accountOutputResolver{outputResolver: r, output: output}, true
+}
+
+func (r *outputResolver) ToAnchorOutput() (*anchorOutputResolver, bool) {
+	output, ok := r.stored.Output.(*iotago.AnchorOutput)
+	if !ok {
+		return nil, false
+	}
+
+	return &anchorOutputResolver{outputResolver: r, output: output}, true
+}
+
+func (r *outputResolver) ToNFTOutput() (*nftOutputResolver, bool) {
+	output, ok := r.stored.Output.(*iotago.NFTOutput)
+	if !ok {
+		return nil, false
+	}
+
+	return &nftOutputResolver{outputResolver: r, output: output}, true
+}
+
+func (r *outputResolver) ToFoundryOutput() (*foundryOutputResolver, bool) {
+	output, ok := r.stored.Output.(*iotago.FoundryOutput)
+	if !ok {
+		return nil, false
+	}
+
+	return &foundryOutputResolver{outputResolver: r, output: output}, true
+}
+
+func (r *outputResolver) ToDelegationOutput() (*delegationOutputResolver, bool) {
+	output, ok := r.stored.Output.(*iotago.DelegationOutput)
+	if !ok {
+		return nil, false
+	}
+
+	return &delegationOutputResolver{outputResolver: r, output: output}, true
+}
+
+// senderAddress returns the address of the sender feature, if there is one.
+func (r *outputResolver) senderAddress(features iotago.FeatureSet) *addressResolver {
+	if sender := features.SenderFeature(); sender != nil {
+		return r.s.addressResolver(sender.Address)
+	}
+
+	return nil
+}
+
+// issuerAddress returns the address of the issuer feature, if there is one.
+func (r *outputResolver) issuerAddress(immutableFeatures iotago.FeatureSet) *addressResolver {
+	if issuer := immutableFeatures.Issuer(); issuer != nil {
+		return r.s.addressResolver(issuer.Address)
+	}
+
+	return nil
+}
+
+type nativeTokenResolver struct {
+	nativeToken *iotago.NativeTokenFeature
+}
+
+func (r *nativeTokenResolver) ID() string {
+	return r.nativeToken.ID.ToHex()
+}
+
+func (r *nativeTokenResolver) Amount() string {
+	return hexutil.EncodeBig(r.nativeToken.Amount)
+}
+
+func nativeToken(features iotago.FeatureSet) *nativeTokenResolver {
+	if nativeToken := features.NativeToken(); nativeToken != nil {
+		return &nativeTokenResolver{nativeToken: nativeToken}
+	}
+
+	return nil
+}
+
+func tag(features iotago.FeatureSet) *string {
+	if tag := features.Tag(); tag != nil {
+		encoded := hexutil.Encode(tag.Tag)
+		return &encoded
+	}
+
+	return nil
+}
+
+func optionalSlot(slot iotago.SlotIndex) *int32 {
+	value := int32(slot)
+	return &value
+}
+
+func baseTokenString(amount iotago.BaseToken) string {
+	return strconv.FormatUint(uint64(amount), 10)
+}
+
+type basicOutputResolver struct {
+	*outputResolver
+	output *iotago.BasicOutput
+}
+
+func (r *basicOutputResolver) Address() *addressResolver {
+	return r.s.addressResolver(r.output.UnlockConditionSet().Address().Address)
+}
+
+func (r *basicOutputResolver) Sender() *addressResolver {
+	return r.senderAddress(r.output.FeatureSet())
+}
+
+func (r *basicOutputResolver) Tag() *string {
+	return tag(r.output.FeatureSet())
+}
+
+func (r *basicOutputResolver) NativeToken() *nativeTokenResolver {
+	return nativeToken(r.output.FeatureSet())
+}
+
+func (r *basicOutputResolver) StorageDepositReturnAddress() *addressResolver {
+	if storageDepositReturn := r.output.UnlockConditionSet().StorageDepositReturn(); storageDepositReturn != nil {
+		return r.s.addressResolver(storageDepositReturn.ReturnAddress)
+	}
+
+	return nil
+}
+
+func (r *basicOutputResolver) StorageDepositReturnAmount() *string {
+	if storageDepositReturn := r.output.UnlockConditionSet().StorageDepositReturn(); storageDepositReturn != nil {
+		amount := baseTokenString(storageDepositReturn.Amount)
+		return &amount
+	}
+
+	return nil
+}
+
+func (r *basicOutputResolver) ExpirationReturnAddress() *addressResolver {
+	if expiration := r.output.UnlockConditionSet().Expiration(); expiration != nil {
+		return r.s.addressResolver(expiration.ReturnAddress)
+	}
+
+	return nil
+}
+
+func (r *basicOutputResolver) ExpirationSlot() *int32 {
+	if expiration := r.output.UnlockConditionSet().Expiration(); expiration != nil {
+		return optionalSlot(expiration.Slot)
+	}
+
+	return nil
+}
+
+func (r *basicOutputResolver) TimelockSlot() *int32 {
+	if timelock := r.output.UnlockConditionSet().Timelock(); timelock != nil {
+		return optionalSlot(timelock.Slot)
+	}
+
+	return nil
+}
+
+type accountOutputResolver struct {
+	*outputResolver
+	output *iotago.AccountOutput
+}
+
+func (r *accountOutputResolver) AccountID() string {
+	accountID := r.output.AccountID
+	if accountID.Empty() {
+		// Use implicit AccountID
+		accountID = iotago.AccountIDFromOutputID(r.stored.OutputID)
+	}
+
+	return accountID.ToHex()
+}
+
+func (r *accountOutputResolver) Address() *addressResolver {
+	return r.s.addressResolver(r.output.UnlockConditionSet().Address().Address)
+}
+
+func (r *accountOutputResolver) Sender() *addressResolver {
+	return r.senderAddress(r.output.FeatureSet())
+}
+
+func (r *accountOutputResolver) Issuer() *addressResolver {
+	return r.issuerAddress(r.output.ImmutableFeatureSet())
+}
+
+func (r *accountOutputResolver) FoundryCounter() int32 {
+	return int32(r.output.FoundryCounter)
+}
+
+func (r *accountOutputResolver) BlockIssuerExpirySlot() *int32 {
+	if blockIssuer := r.output.FeatureSet().BlockIssuer(); blockIssuer != nil {
+		return optionalSlot(blockIssuer.ExpirySlot)
+	}
+
+	return nil
+}
+
+func (r *accountOutputResolver) StakedAmount() *string {
+	if staking := r.output.FeatureSet().Staking(); staking != nil {
+		amount := baseTokenString(staking.StakedAmount)
+		return &amount
+	}
+
+	return nil
+}
+
+type anchorOutputResolver struct {
+	*outputResolver
+	output *iotago.AnchorOutput
+}
+
+func (r *anchorOutputResolver) AnchorID() string {
+	anchorID := r.output.AnchorID
+	if anchorID.Empty() {
+		// Use implicit AnchorID
+		anchorID = iotago.AnchorIDFromOutputID(r.stored.OutputID)
+	}
+
+	return anchorID.ToHex()
+}
+
+func (r *anchorOutputResolver) StateIndex() int32 {
+	return int32(r.output.StateIndex)
+}
+
+func (r *anchorOutputResolver) StateController() *addressResolver {
+	return r.s.addressResolver(r.output.UnlockConditionSet().StateControllerAddress().Address)
+}
+
+func (r *anchorOutputResolver) Governor() *addressResolver {
+	return r.s.addressResolver(r.output.UnlockConditionSet().GovernorAddress().Address)
+}
+
+func (r *anchorOutputResolver) Sender() *addressResolver {
+	return r.senderAddress(r.output.FeatureSet())
+}
+
+func (r *anchorOutputResolver) Issuer() *addressResolver {
+	return r.issuerAddress(r.output.ImmutableFeatureSet())
+}
+
+type nftOutputResolver struct {
+	*outputResolver
+	output *iotago.NFTOutput
+}
+
+func (r *nftOutputResolver) NFTID() string {
+	nftID := r.output.NFTID
+	if nftID.Empty() {
+		// Use implicit NFTID
+		nftID = iotago.NFTIDFromOutputID(r.stored.OutputID)
+	}
+
+	return nftID.ToHex()
+}
+
+func (r *nftOutputResolver) Address() *addressResolver {
+	return r.s.addressResolver(r.output.UnlockConditionSet().Address().Address)
+}
+
+func (r *nftOutputResolver) Sender() *addressResolver {
+	return r.senderAddress(r.output.FeatureSet())
+}
+
+func (r *nftOutputResolver) Issuer() *addressResolver {
+	return r.issuerAddress(r.output.ImmutableFeatureSet())
+}
+
+func (r *nftOutputResolver) Tag() *string {
+	return tag(r.output.FeatureSet())
+}
+
+func (r *nftOutputResolver) StorageDepositReturnAddress() *addressResolver {
+	if storageDepositReturn := r.output.UnlockConditionSet().StorageDepositReturn(); storageDepositReturn != nil {
+		return r.s.addressResolver(storageDepositReturn.ReturnAddress)
+	}
+
+	return nil
+}
+
+func (r *nftOutputResolver) StorageDepositReturnAmount() *string {
+	if storageDepositReturn := r.output.UnlockConditionSet().StorageDepositReturn(); storageDepositReturn != nil {
+		amount := baseTokenString(storageDepositReturn.Amount)
+		return &amount
+	}
+
+	return nil
+}
+
+func (r *nftOutputResolver) ExpirationReturnAddress() *addressResolver {
+	if expiration := r.output.UnlockConditionSet().Expiration(); expiration != nil {
+		return r.s.addressResolver(expiration.ReturnAddress)
+	}
+
+	return nil
+}
+
+func (r *nftOutputResolver) ExpirationSlot() *int32 {
+	if expiration := r.output.UnlockConditionSet().Expiration(); expiration != nil {
+		return optionalSlot(expiration.Slot)
+	}
+
+	return nil
+}
+
+func (r *nftOutputResolver) TimelockSlot() *int32 {
+	if timelock := r.output.UnlockConditionSet().Timelock(); timelock != nil {
+		return optionalSlot(timelock.Slot)
+	}
+
+	return nil
+}
+
+type foundryOutputResolver struct {
+	*outputResolver
+	output *iotago.FoundryOutput
+}
+
+func (r *foundryOutputResolver) FoundryID() (string, error) {
+	foundryID, err := r.output.FoundryID()
+	if err != nil {
+		return "", err
+	}
+
+	return foundryID.ToHex(), nil
+}
+
+func (r *foundryOutputResolver) SerialNumber() int32 {
+	return int32(r.output.SerialNumber)
+}
+
+func (r *foundryOutputResolver) Account() *addressResolver {
+	return r.s.addressResolver(r.output.UnlockConditionSet().ImmutableAccount().Address)
+}
+
+func (r *foundryOutputResolver) NativeToken() *nativeTokenResolver {
+	return nativeToken(r.output.FeatureSet())
+}
+
+func (r *foundryOutputResolver) simpleTokenScheme() (*iotago.SimpleTokenScheme, error) {
+	simpleTokenScheme, ok := r.output.TokenScheme.(*iotago.SimpleTokenScheme)
+	if !ok {
+		return nil, ierrors.Errorf("unsupported token scheme: %T", r.output.TokenScheme)
+	}
+
+	return simpleTokenScheme, nil
+}
+
+func (r *foundryOutputResolver) MintedTokens() (string, error) {
+	simpleTokenScheme, err := r.simpleTokenScheme()
+	if err != nil {
+		return "", err
+	}
+
+	return hexutil.EncodeBig(simpleTokenScheme.MintedTokens), nil
+}
+
+func (r *foundryOutputResolver) MeltedTokens() (string, error) {
+	simpleTokenScheme, err := r.simpleTokenScheme()
+	if err != nil {
+		return "", err
+	}
+
+	return hexutil.EncodeBig(simpleTokenScheme.MeltedTokens), nil
+}
+
+func (r *foundryOutputResolver) MaximumSupply() (string, error) {
+	simpleTokenScheme, err := r.simpleTokenScheme()
+	if err != nil {
+		return "", err
+	}
+
+	return hexutil.EncodeBig(simpleTokenScheme.MaximumSupply), nil
+}
+
+type delegationOutputResolver struct {
+	*outputResolver
+	output *iotago.DelegationOutput
+}
+
+func (r *delegationOutputResolver) DelegationID() string {
+	delegationID := r.output.DelegationID
+	if delegationID.Empty() {
+		// Use implicit DelegationID
+		delegationID = iotago.DelegationIDFromOutputID(r.stored.OutputID)
+	}
+
+	return delegationID.ToHex()
+}
+
+func (r *delegationOutputResolver) Address() *addressResolver {
+	return r.s.addressResolver(r.output.UnlockConditionSet().Address().Address)
+}
+
+func (r *delegationOutputResolver) Validator() *addressResolver {
+	return r.s.addressResolver(r.output.ValidatorAddress)
+}
+
+func (r *delegationOutputResolver) DelegatedAmount() string {
+	return baseTokenString(r.output.DelegatedAmount)
+}
+
+func (r *delegationOutputResolver) StartEpoch() int32 {
+	return int32(r.output.StartEpoch)
+}
+
+func (r *delegationOutputResolver) EndEpoch() int32 {
+	return int32(r.output.EndEpoch)
+}
+
+// addressResolver resolves the fields of the Address type.
+type addressResolver struct {
+	s       *Server
+	address iotago.Address
+}
+
+// addressResolver returns the resolver of the given address.
+func (s *Server) addressResolver(address iotago.Address) *addressResolver {
+	return &addressResolver{s: s, address: address}
+}
+
+func (r *addressResolver) Bech32() string {
+	return r.address.Bech32(r.s.bech32HRP)
+}
+
+func (r *addressResolver) Type() string {
+	return r.address.Type().String()
+}
+
+func (r *addressResolver) AccountOutput(ctx context.Context) (*accountOutputResolver, error) {
+	accountAddress, ok := r.address.(*iotago.AccountAddress)
+	if !ok {
+		return nil, nil
+	}
+
+	if err := chargeNodes(ctx, 1); err != nil {
+		return nil, err
+	}
+
+	output, err := r.s.singleOutput(r.s.indexer.AccountByID(accountAddress.AccountID()))
+	if err != nil || output == nil {
+		return nil, err
+	}
+	accountOutput, _ := output.ToAccountOutput()
+
+	return accountOutput, nil
+}
+
+func (r *addressResolver) AnchorOutput(ctx context.Context) (*anchorOutputResolver, error) {
+	anchorAddress, ok := r.address.(*iotago.AnchorAddress)
+	if !ok {
+		return nil, nil
+	}
+
+	if err := chargeNodes(ctx, 1); err != nil {
+		return nil, err
+	}
+
+	output, err := r.s.singleOutput(r.s.indexer.AnchorByID(anchorAddress.AnchorID()))
+	if err != nil || output == nil {
+		return nil, err
+	}
+	anchorOutput, _ := output.ToAnchorOutput()
+
+	return anchorOutput, nil
+}
+
+func (r *addressResolver) NFTOutput(ctx context.Context) (*nftOutputResolver, error) {
+	nftAddress, ok := r.address.(*iotago.NFTAddress)
+	if !ok {
+		return nil, nil
+	}
+
+	if err := chargeNodes(ctx, 1); err != nil {
+		return nil, err
+	}
+
+	output, err := r.s.singleOutput(r.s.indexer.NFTByID(nftAddress.NFTID()))
+	if err != nil || output == nil {
+		return nil, err
+	}
+	nftOutput, _ := output.ToNFTOutput()
+
+	return nftOutput, nil
+}
+
+func (r *addressResolver) Outputs(ctx context.Context, args outputsArgs) (*outputConnection, error) {
+	return r.s.combinedOutputs(ctx, args, r.address)
+}
@@ -0,0 +1,363 @@
+package graphql
+
+import (
+	"context"
+
+	"github.com/iotaledger/hive.go/runtime/options"
+	"github.com/iotaledger/inx-indexer/pkg/indexer"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+// queryResolver resolves the fields of the Query type.
+type queryResolver struct {
+	s *Server
+}
+
+type outputsArgs struct {
+	Filter *outputFilter
+	First  *int32
+	After  *string
+	Sort   *string
+}
+
+func (r *queryResolver) Outputs(ctx context.Context, args outputsArgs) (*outputConnection, error) {
+	return r.s.combinedOutputs(ctx, args, nil)
+}
+
+// combinedOutputs returns the outputs of all types matching the filter.
+// If unlockableBy is given, only outputs that can be unlocked by this address are returned.
+func (s *Server) combinedOutputs(ctx context.Context, args outputsArgs, unlockableBy iotago.Address) (*outputConnection, error) {
+	pageSize, cursor, sortOrder, err := s.page(ctx, pageArgs{First: args.First, After: args.After, Sort: args.Sort})
+	if err != nil {
+		return nil, err
+	}
+
+	p := s.filterParser()
+	filters := append(args.Filter.options(p), indexer.CombinedPageSize(pageSize), indexer.CombinedSortOrder(sortOrder))
+	if cursor != nil {
+		filters = append(filters, indexer.CombinedCursor(*cursor))
+	}
+	if unlockableBy != nil {
+		filters = append(filters, indexer.CombinedUnlockableByAddress(unlockableBy))
+	}
+	if p.err != nil {
+		return nil, p.err
+	}
+
+	return s.outputConnection(s.indexer.Combined(filters...))
+}
+
+type basicOutputsArgs struct {
+	Filter *basicOutputFilter
+	First  *int32
+	After  *string
+	Sort   *string
+}
+
+func (r *queryResolver) BasicOutputs(ctx context.Context, args basicOutputsArgs) (*outputConnection, error) {
+	pageSize, cursor, sortOrder, err := r.s.page(ctx, pageArgs{First: args.First, After: args.After, Sort: args.Sort})
+	if err != nil {
+		return nil, err
+	}
+
+	p := r.s.filterParser()
+	filters := append(args.Filter.options(p), indexer.BasicPageSize(pageSize), indexer.BasicSortOrder(sortOrder))
+	if cursor != nil {
+		filters = append(filters, indexer.BasicCursor(*cursor))
+	}
+	if p.err != nil {
+		return nil, p.err
+	}
+
+	return r.s.outputConnection(r.s.indexer.Basic(filters...))
+}
+
+type accountOutputsArgs struct {
+	Filter *accountOutputFilter
+	First  *int32
+	After  *string
+	Sort   *string
+}
+
+func (r *queryResolver) AccountOutputs(ctx context.Context, args accountOutputsArgs) (*outputConnection, error) {
+	pageSize, cursor, sortOrder, err := r.s.page(ctx, pageArgs{First: args.First, After: args.After, Sort: args.Sort})
+	if err != nil {
+		return nil, err
+	}
+
+	p := r.s.filterParser()
+	filters := append(args.Filter.options(p), indexer.AccountPageSize(pageSize), indexer.AccountSortOrder(sortOrder))
+	if cursor != nil {
+		filters = append(filters, indexer.AccountCursor(*cursor))
+	}
+	if p.err != nil {
+		return nil, p.err
+	}
+
+	return r.s.outputConnection(r.s.indexer.Account(filters...))
+}
+
+type anchorOutputsArgs struct {
+	Filter *anchorOutputFilter
+	First  *int32
+	After  *string
+	Sort   *string
+}
+
+func (r *queryResolver) AnchorOutputs(ctx context.Context, args anchorOutputsArgs) (*outputConnection, error) {
+	pageSize, cursor, sortOrder, err := r.s.page(ctx, pageArgs{First: args.First, After: args.After, Sort: args.Sort})
+	if err != nil {
+		return nil, err
+	}
+
+	p := r.s.filterParser()
+	filters := append(args.Filter.options(p), indexer.AnchorPageSize(pageSize), indexer.AnchorSortOrder(sortOrder))
+	if cursor != nil {
+		filters = append(filters, indexer.AnchorCursor(*cursor))
+	}
+	if p.err != nil {
+		return nil, p.err
+	}
+
+	return r.s.outputConnection(r.s.indexer.Anchor(filters...))
+}
+
+type nftOutputsArgs struct {
+	Filter *nftOutputFilter
+	First  *int32
+	After  *string
+	Sort   *string
+}
+
+func (r *queryResolver) NFTOutputs(ctx context.Context, args nftOutputsArgs) (*outputConnection, error) {
+	pageSize, cursor, sortOrder, err := r.s.page(ctx, pageArgs{First: args.First, After: args.After, Sort: args.Sort})
+	if err != nil {
+		return nil, err
+	}
+
+	p := r.s.filterParser()
+	filters := append(args.Filter.options(p), indexer.NFTPageSize(pageSize), indexer.NFTSortOrder(sortOrder))
+	if cursor != nil {
+		filters = append(filters, indexer.NFTCursor(*cursor))
+	}
+	if p.err != nil {
+		return nil, p.err
+	}
+
+	return r.s.outputConnection(r.s.indexer.NFT(filters...))
+}
+
+type foundryOutputsArgs struct {
+	Filter *foundryOutputFilter
+	First  *int32
+	After  *string
+	Sort   *string
+}
+
+func (r *queryResolver) FoundryOutputs(ctx context.Context, args foundryOutputsArgs) (*outputConnection, error) {
+	pageSize, cursor, sortOrder, err := r.s.page(ctx, pageArgs{First: args.First, After: args.After, Sort: args.Sort})
+	if err != nil {
+		return nil, err
+	}
+
+	p := r.s.filterParser()
+	filters := append(args.Filter.options(p), indexer.FoundryPageSize(pageSize), indexer.FoundrySortOrder(sortOrder))
+	if cursor != nil {
+		filters = append(filters, indexer.FoundryCursor(*cursor))
+	}
+	if p.err != nil {
+		return nil, p.err
+	}
+
+	return r.s.outputConnection(r.s.indexer.Foundry(filters...))
+}
+
+type delegationOutputsArgs struct {
+	Filter *delegationOutputFilter
+	First  *int32
+	After  *string
+	Sort   *string
+}
+
+func (r *queryResolver) DelegationOutputs(ctx context.Context, args delegationOutputsArgs) (*outputConnection, error) {
+	pageSize, cursor, sortOrder, err := r.s.page(ctx, pageArgs{First: args.First, After: args.After, Sort: args.Sort})
+	if err != nil {
+		return nil, err
+	}
+
+	p := r.s.filterParser()
+	filters := append(args.Filter.options(p), indexer.DelegationPageSize(pageSize), indexer.DelegationSortOrder(sortOrder))
+	if cursor != nil {
+		filters = append(filters, indexer.DelegationCursor(*cursor))
+	}
+	if p.err != nil {
+		return nil, p.err
+	}
+
+	return r.s.outputConnection(r.s.indexer.Delegation(filters...))
+}
+
+type accountArgs struct {
+	AccountID string
+	AsOfSlot  *int32
+}
+
+func (r *queryResolver) Account(ctx context.Context, args accountArgs) (*accountOutputResolver, error) {
+	if err := chargeNodes(ctx, 1); err != nil {
+		return nil, err
+	}
+
+	p := r.s.filterParser()
+	var accountID iotago.AccountID
+	copy(accountID[:], p.id("accountId", args.AccountID, iotago.AccountIDLength))
+
+	var filters []options.Option[indexer.AccountFilterOptions]
+	if args.AsOfSlot != nil {
+		filters = append(filters, indexer.AccountAsOfSlot(p.slot("asOfSlot", *args.AsOfSlot)))
+	}
+	if p.err != nil {
+		return nil, p.err
+	}
+
+	output, err := r.s.singleOutput(r.s.indexer.AccountByID(accountID, filters...))
+	if err != nil || output == nil {
+		return nil, err
+	}
+	accountOutput, _ := output.ToAccountOutput()
+
+	return accountOutput, nil
+}
+
+type anchorArgs struct {
+	AnchorID string
+	AsOfSlot *int32
+}
+
+func (r *queryResolver) Anchor(ctx context.Context, args anchorArgs) (*anchorOutputResolver, error) {
+	if err := chargeNodes(ctx, 1); err != nil {
+		return nil, err
+	}
+
+	p := r.s.filterParser()
+	var anchorID iotago.AnchorID
+	copy(anchorID[:], p.id("anchorId", args.AnchorID, iotago.AnchorIDLength))
+
+	var filters []options.Option[indexer.AnchorFilterOptions]
+	if args.AsOfSlot != nil {
+		filters = append(filters, indexer.AnchorAsOfSlot(p.slot("asOfSlot", *args.AsOfSlot)))
+	}
+	if p.err != nil {
+		return nil, p.err
+	}
+
+	output, err := r.s.singleOutput(r.s.indexer.AnchorByID(anchorID, filters...))
+	if err != nil || output == nil {
+		return nil, err
+	}
+	anchorOutput, _ := output.ToAnchorOutput()
+
+	return anchorOutput, nil
+}
+
+type nftArgs struct {
+	NFTID    string
+	AsOfSlot *int32
+}
+
+func (r *queryResolver) NFT(ctx context.Context, args nftArgs) (*nftOutputResolver, error) {
+	if err := chargeNodes(ctx, 1); err != nil {
+		return nil, err
+	}
+
+	p := r.s.filterParser()
+	var nftID iotago.NFTID
+	copy(nftID[:], p.id("nftId", args.NFTID, iotago.NFTIDLength))
+
+	var filters []options.Option[indexer.NFTFilterOptions]
+	if args.AsOfSlot != nil {
+		filters = append(filters, indexer.NFTAsOfSlot(p.slot("asOfSlot", *args.AsOfSlot)))
+	}
+	if p.err != nil {
+		return nil, p.err
+	}
+
+	output, err := r.s.singleOutput(r.s.indexer.NFTByID(nftID, filters...))
+	if err != nil || output == nil {
+		return nil, err
+	}
+	nftOutput, _ := output.ToNFTOutput()
+
+	return nftOutput, nil
+}
+
+type foundryArgs struct {
+	FoundryID string
+	AsOfSlot  *int32
+}
+
+func (r *queryResolver) Foundry(ctx context.Context, args foundryArgs) (*foundryOutputResolver, error) {
+	if err := chargeNodes(ctx, 1); err != nil {
+		return nil, err
+	}
+
+	p := r.s.filterParser()
+	var foundryID iotago.FoundryID
+	copy(foundryID[:], p.id("foundryId", args.FoundryID, iotago.FoundryIDLength))
+
+	var filters []options.Option[indexer.FoundryFilterOptions]
+	if args.AsOfSlot != nil {
+		filters = append(filters, indexer.FoundryAsOfSlot(p.slot("asOfSlot", *args.AsOfSlot)))
+	}
+	if p.err != nil {
+		return nil, p.err
+	}
+
+	output, err := r.s.singleOutput(r.s.indexer.FoundryByID(foundryID, filters...))
+	if err != nil || output == nil {
+		return nil, err
+	}
+	foundryOutput, _ := output.ToFoundryOutput()
+
+	return foundryOutput, nil
+}
+
+type delegationArgs struct {
+	DelegationID string
+	AsOfSlot     *int32
+}
+
+func (r *queryResolver) Delegation(ctx context.Context, args delegationArgs) (*delegationOutputResolver, error) {
+	if err := chargeNodes(ctx, 1); err != nil {
+		return nil, err
+	}
+
+	p := r.s.filterParser()
+	var delegationID iotago.DelegationID
+	copy(delegationID[:], p.id("delegationId", args.DelegationID, iotago.DelegationIDLength))
+
+	var filters []options.Option[indexer.DelegationFilterOptions]
+	if args.AsOfSlot != nil {
+		filters = append(filters, indexer.DelegationAsOfSlot(p.slot("asOfSlot", *args.AsOfSlot)))
+	}
+	if p.err != nil {
+		return nil, p.err
+	}
+
+	output, err := r.s.singleOutput(r.s.indexer.DelegationByID(delegationID, filters...))
+	if err != nil || output == nil {
+		return nil, err
+	}
+	delegationOutput, _ := output.ToDelegationOutput()
+
+	return delegationOutput, nil
+}
+
+func (r *queryResolver) Address(args struct{ Bech32 string }) (*addressResolver, error) {
+	p := r.s.filterParser()
+	address := p.address("bech32", args.Bech32)
+	if p.err != nil {
+		return nil, p.err
+	}
+
+	return r.s.addressResolver(address), nil
+}
@@ -0,0 +1,495 @@
+package grpc
+
+import (
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/iotaledger/hive.go/runtime/options"
+	"github.com/iotaledger/inx-indexer/pkg/apitypes"
+	"github.com/iotaledger/inx-indexer/pkg/grpc/indexerpb"
+	"github.com/iotaledger/inx-indexer/pkg/indexer"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+var sortOrders = map[indexerpb.SortOrder]indexer.SortOrder{
+	indexerpb.SortOrder_SORT_ORDER_CREATED_ASC:  indexer.SortCreatedAscending,
+	indexerpb.SortOrder_SORT_ORDER_CREATED_DESC: indexer.SortCreatedDescending,
+	indexerpb.SortOrder_SORT_ORDER_AMOUNT_ASC:   indexer.SortAmountAscending,
+	indexerpb.SortOrder_SORT_ORDER_AMOUNT_DESC:  indexer.SortAmountDescending,
+}
+
+// filterParser collects the first error of the parsed filter values, so that the filters can be converted field by field.
+type filterParser struct {
+	s   *Server
+	err error
+}
+
+func (p *filterParser) fail(format string, args ...interface{}) {
+	if p.err == nil {
+		p.err = status.Errorf(codes.InvalidArgument, format, args...)
+	}
+}
+
+// pagination returns the page size, cursor and sort order of the pagination.
+func (p *filterParser) pagination(pagination *indexerpb.Pagination) (uint32, *string, indexer.SortOrder) {
+	pageSize := p.s.optsMaxPageSize
+	if pagination.GetPageSize() > 0 && pagination.GetPageSize() < pageSize {
+		pageSize = pagination.GetPageSize()
+	}
+
+	sortOrder, exists := sortOrders[pagination.GetSortOrder()]
+	if !exists {
+		p.fail("unknown sort order: %s", pagination.GetSortOrder())
+	}
+
+	var cursor *string
+	if pagination != nil {
+		cursor = pagination.Cursor
+	}
+
+	return pageSize, cursor, sortOrder
+}
+
+func (p *filterParser) address(name string, value string) iotago.Address {
+	hrp, address, err := iotago.ParseBech32(value)
+	if err != nil {
+		p.fail("invalid address in %s: %s", name, err)
+		return nil
+	}
+	if hrp != p.s.bech32HRP {
+		p.fail("invalid bech32 address prefix in %s: %s, expected %s", name, hrp, p.s.bech32HRP)
+		return nil
+	}
+
+	return address
+}
+
+func (p *filterParser) accountAddress(name string, value string) *iotago.AccountAddress {
+	address := p.address(name, value)
+	if address == nil {
+		return nil
+	}
+
+	accountAddress, ok := address.(*iotago.AccountAddress)
+	if !ok {
+		p.fail("invalid address in %s: %s, not an account address", name, value)
+		return nil
+	}
+
+	return accountAddress
+}
+
+// id checks that the identifier has exactly the given length.
+func (p *filterParser) id(name string, value []byte, length int) []byte {
+	if len(value) != length {
+		p.fail("invalid length of %s: %d bytes, expected %d", name, len(value), length)
+		return nil
+	}
+
+	return value
+}
+
+func (p *filterParser) nativeTokenID(name string, value []byte) iotago.NativeTokenID {
+	var nativeTokenID iotago.NativeTokenID
+	copy(nativeTokenID[:], p.id(name, value, iotago.NativeTokenIDLength))
+
+	return nativeTokenID
+}
+
+func (p *filterParser) tag(name string, value []byte) []byte {
+	if len(value) > apitypes.MaxTagLength {
+		p.fail("%s too long: %d bytes, max %d", name, len(value), apitypes.MaxTagLength)
+		return nil
+	}
+
+	return value
+}
+
+func (s *Server) basicFilterOptions(filter *indexerpb.BasicFilter) ([]options.Option[indexer.BasicFilterOptions], error) {
+	p := &filterParser{s: s}
+
+	pageSize, cursor, sortOrder := p.pagination(filter.GetPagination())
+	opts := []options.Option[indexer.BasicFilterOptions]{indexer.BasicPageSize(pageSize), indexer.BasicSortOrder(sortOrder)}
+	if cursor != nil {
+		opts = append(opts, indexer.BasicCursor(*cursor))
+	}
+
+	if filter.HasNativeToken != nil {
+		opts = append(opts, indexer.BasicHasNativeToken(filter.GetHasNativeToken()))
+	}
+	if filter.NativeToken != nil {
+		opts = append(opts, indexer.BasicNativeToken(p.nativeTokenID("nativeToken", filter.GetNativeToken())))
+	}
+	if filter.UnlockableByAddress != nil {
+		opts = append(opts, indexer.BasicUnlockableByAddress(p.address("unlockableByAddress", filter.GetUnlockableByAddress())))
+	}
+	if filter.Address != nil {
+		opts = append(opts, indexer.BasicUnlockAddress(p.address("address", filter.GetAddress())))
+	}
+	if filter.HasStorageDepositReturn != nil {
+		opts = append(opts, indexer.BasicHasStorageDepositReturnCondition(filter.GetHasStorageDepositReturn()))
+	}
+	if filter.StorageDepositReturnAddress != nil {
+		opts = append(opts, indexer.BasicStorageDepositReturnAddress(p.address("storageDepositReturnAddress", filter.GetStorageDepositReturnAddress())))
+	}
+	if filter.HasExpiration != nil {
+		opts = append(opts, indexer.BasicHasExpirationCondition(filter.GetHasExpiration()))
+	}
+	if filter.ExpiresBefore != nil {
+		opts = append(opts, indexer.BasicExpiresBefore(iotago.SlotIndex(filter.GetExpiresBefore())))
+	}
+	if filter.ExpiresAfter != nil {
+		opts = append(opts, indexer.BasicExpiresAfter(iotago.SlotIndex(filter.GetExpiresAfter())))
+	}
+	if filter.ExpirationReturnAddress != nil {
+		opts = append(opts, indexer.BasicExpirationReturnAddress(p.address("expirationReturnAddress", filter.GetExpirationReturnAddress())))
+	}
+	if filter.HasTimelock != nil {
+		opts = append(opts, indexer.BasicHasTimelockCondition(filter.GetHasTimelock()))
+	}
+	if filter.TimelockedBefore != nil {
+		opts = append(opts, indexer.BasicTimelockedBefore(iotago.SlotIndex(filter.GetTimelockedBefore())))
+	}
+	if filter.TimelockedAfter != nil {
+		opts = append(opts, indexer.BasicTimelockedAfter(iotago.SlotIndex(filter.GetTimelockedAfter())))
+	}
+	if filter.Sender != nil {
+		opts = append(opts, indexer.BasicSender(p.address("sender", filter.GetSender())))
+	}
+	if filter.Tag != nil {
+		opts = append(opts, indexer.BasicTag(p.tag("tag", filter.GetTag())))
+	}
+	if filter.MinAmount != nil {
+		opts = append(opts, indexer.BasicMinAmount(iotago.BaseToken(filter.GetMinAmount())))
+	}
+	if filter.MaxAmount != nil {
+		opts = append(opts, indexer.BasicMaxAmount(iotago.BaseToken(filter.GetMaxAmount())))
+	}
+	if filter.CreatedBefore != nil {
+		opts = append(opts, indexer.BasicCreatedBefore(iotago.SlotIndex(filter.GetCreatedBefore())))
+	}
+	if filter.CreatedAfter != nil {
+		opts = append(opts, indexer.BasicCreatedAfter(iotago.SlotIndex(filter.GetCreatedAfter())))
+	}
+	if filter.AsOfSlot != nil {
+		opts = append(opts, indexer.BasicAsOfSlot(iotago.SlotIndex(filter.GetAsOfSlot())))
+	}
+
+	return opts, p.err
+}
+
+func (s *Server) accountFilterOptions(filter *indexerpb.AccountFilter) ([]options.Option[indexer.AccountFilterOptions], error) {
+	p := &filterParser{s: s}
+
+	pageSize, cursor, sortOrder := p.pagination(filter.GetPagination())
+	opts := []options.Option[indexer.AccountFilterOptions]{indexer.AccountPageSize(pageSize), indexer.AccountSortOrder(sortOrder)}
+	if cursor != nil {
+		opts = append(opts, indexer.AccountCursor(*cursor))
+	}
+
+	if filter.Address != nil {
+		opts = append(opts, indexer.AccountUnlockAddress(p.address("address", filter.GetAddress())))
+	}
+	if filter.Sender != nil {
+		opts = append(opts, indexer.AccountSender(p.address("sender", filter.GetSender())))
+	}
+	if filter.Issuer != nil {
+		opts = append(opts, indexer.AccountIssuer(p.address("issuer", filter.GetIssuer())))
+	}
+	if filter.IsBlockIssuer != nil {
+		opts = append(opts, indexer.AccountIsBlockIssuer(filter.GetIsBlockIssuer()))
+	}
+	if filter.BlockIssuerExpiresBefore != nil {
+		opts = append(opts, indexer.AccountBlockIssuerExpiresBefore(iotago.SlotIndex(filter.GetBlockIssuerExpiresBefore())))
+	}
+	if filter.BlockIssuerExpiresAfter != nil {
+		opts = append(opts, indexer.AccountBlockIssuerExpiresAfter(iotago.SlotIndex(filter.GetBlockIssuerExpiresAfter())))
+	}
+	if filter.BlockIssuerKey != nil {
+		opts = append(opts, indexer.AccountBlockIssuerKey(filter.GetBlockIssuerKey()))
+	}
+	if filter.MinAmount != nil {
+		opts = append(opts, indexer.AccountMinAmount(iotago.BaseToken(filter.GetMinAmount())))
+	}
+	if filter.MaxAmount != nil {
+		opts = append(opts, indexer.AccountMaxAmount(iotago.BaseToken(filter.GetMaxAmount())))
+	}
+	if filter.CreatedBefore != nil {
+		opts = append(opts, indexer.AccountCreatedBefore(iotago.SlotIndex(filter.GetCreatedBefore())))
+	}
+	if filter.CreatedAfter != nil {
+		opts = append(opts, indexer.AccountCreatedAfter(iotago.SlotIndex(filter.GetCreatedAfter())))
+	}
+	if filter.AsOfSlot != nil {
+		opts = append(opts, indexer.AccountAsOfSlot(iotago.SlotIndex(filter.GetAsOfSlot())))
+	}
+
+	return opts, p.err
+}
+
+func (s *Server) anchorFilterOptions(filter *indexerpb.AnchorFilter) ([]options.Option[indexer.AnchorFilterOptions], error) {
+	p := &filterParser{s: s}
+
+	pageSize, cursor, sortOrder := p.pagination(filter.GetPagination())
+	opts := []options.Option[indexer.AnchorFilterOptions]{indexer.AnchorPageSize(pageSize), indexer.AnchorSortOrder(sortOrder)}
+	if cursor != nil {
+		opts = append(opts, indexer.AnchorCursor(*cursor))
+	}
+
+	if filter.UnlockableByAddress != nil {
+		opts = append(opts, indexer.AnchorUnlockableByAddress(p.address("unlockableByAddress", filter.GetUnlockableByAddress())))
+	}
+	if filter.StateController != nil {
+		opts = append(opts, indexer.AnchorStateController(p.address("stateController", filter.GetStateController())))
+	}
+	if filter.Governor != nil {
+		opts = append(opts, indexer.AnchorGovernor(p.address("governor", filter.GetGovernor())))
+	}
+	if filter.Sender != nil {
+		opts = append(opts, indexer.AnchorSender(p.address("sender", filter.GetSender())))
+	}
+	if filter.Issuer != nil {
+		opts = append(opts, indexer.AnchorIssuer(p.address("issuer", filter.GetIssuer())))
+	}
+	if filter.MinAmount != nil {
+		opts = append(opts, indexer.AnchorMinAmount(iotago.BaseToken(filter.GetMinAmount())))
+	}
+	if filter.MaxAmount != nil {
+		opts = append(opts, indexer.AnchorMaxAmount(iotago.BaseToken(filter.GetMaxAmount())))
+	}
+	if filter.CreatedBefore != nil {
+		opts = append(opts, indexer.AnchorCreatedBefore(iotago.SlotIndex(filter.GetCreatedBefore())))
+	}
+	if filter.CreatedAfter != nil {
+		opts = append(opts, indexer.AnchorCreatedAfter(iotago.SlotIndex(filter.GetCreatedAfter())))
+	}
+	if filter.AsOfSlot != nil {
+		opts = append(opts, indexer.AnchorAsOfSlot(iotago.SlotIndex(filter.GetAsOfSlot())))
+	}
+
+	return opts, p.err
+}
+
+func (s *Server) nftFilterOptions(filter *indexerpb.NFTFilter) ([]options.Option[indexer.NFTFilterOptions], error) {
+	p := &filterParser{s: s}
+
+	pageSize, cursor, sortOrder := p.pagination(filter.GetPagination())
+	opts := []options.Option[indexer.NFTFilterOptions]{indexer.NFTPageSize(pageSize), indexer.NFTSortOrder(sortOrder)}
+	if cursor != nil {
+		opts = append(opts, indexer.NFTCursor(*cursor))
+	}
+
+	if filter.UnlockableByAddress != nil {
+		opts = append(opts, indexer.NFTUnlockableByAddress(p.address("unlockableByAddress", filter.GetUnlockableByAddress())))
+	}
+	if filter.Address != nil {
+		opts = append(opts, indexer.NFTUnlockAddress(p.address("address", filter.GetAddress())))
+	}
+	if filter.HasStorageDepositReturn != nil {
+		opts = append(opts, indexer.NFTHasStorageDepositReturnCondition(filter.GetHasStorageDepositReturn()))
+	}
+	if filter.StorageDepositReturnAddress != nil {
+		opts = append(opts, indexer.NFTStorageDepositReturnAddress(p.address("storageDepositReturnAddress", filter.GetStorageDepositReturnAddress())))
+	}
+	if filter.HasExpiration != nil {
+		opts = append(opts, indexer.NFTHasExpirationCondition(filter.GetHasExpiration()))
+	}
+	if filter.ExpiresBefore != nil {
+		opts = append(opts, indexer.NFTExpiresBefore(iotago.SlotIndex(filter.GetExpiresBefore())))
+	}
+	if filter.ExpiresAfter != nil {
+		opts = append(opts, indexer.NFTExpiresAfter(iotago.SlotIndex(filter.GetExpiresAfter())))
+	}
+	if filter.ExpirationReturnAddress != nil {
+		opts = append(opts, indexer.NFTExpirationReturnAddress(p.address("expirationReturnAddress", filter.GetExpirationReturnAddress())))
+	}
+	if filter.HasTimelock != nil {
+		opts = append(opts, indexer.NFTHasTimelockCondition(filter.GetHasTimelock()))
+	}
+	if filter.TimelockedBefore != nil {
+		opts = append(opts, indexer.NFTTimelockedBefore(iotago.SlotIndex(filter.GetTimelockedBefore())))
+	}
+	if filter.TimelockedAfter != nil {
+		opts = append(opts, indexer.NFTTimelockedAfter(iotago.SlotIndex(filter.GetTimelockedAfter())))
+	}
+	if filter.Issuer != nil {
+		opts = append(opts, indexer.NFTIssuer(p.address("issuer", filter.GetIssuer())))
+	}
+	if filter.Collection != nil {
+		var collection iotago.NFTID
+		copy(collection[:], p.id("collection", filter.GetCollection(), iotago.NFTIDLength))
+		opts = append(opts, indexer.NFTCollection(collection))
+	}
+	if filter.CollectionName != nil {
+		opts = append(opts, indexer.NFTCollectionName(filter.GetCollectionName()))
+	}
+	if filter.MediaType != nil {
+		opts = append(opts, indexer.NFTMediaType(filter.GetMediaType()))
+	}
+	if filter.NamePrefix != nil {
+		opts = append(opts, indexer.NFTNamePrefix(filter.GetNamePrefix()))
+	}
+	if filter.Sender != nil {
+		opts = append(opts, indexer.NFTSender(p.address("sender", filter.GetSender())))
+	}
+	if filter.Tag != nil {
+		opts = append(opts, indexer.NFTTag(p.tag("tag", filter.GetTag())))
+	}
+	if filter.MinAmount != nil {
+		opts = append(opts, indexer.NFTMinAmount(iotago.BaseToken(filter.GetMinAmount())))
+	}
+	if filter.MaxAmount != nil {
+		opts = append(opts, indexer.NFTMaxAmount(iotago.BaseToken(filter.GetMaxAmount())))
+	}
+	if filter.CreatedBefore != nil {
+		opts = append(opts, indexer.NFTCreatedBefore(iotago.SlotIndex(filter.GetCreatedBefore())))
+	}
+	if filter.CreatedAfter != nil {
+		opts = append(opts, indexer.NFTCreatedAfter(iotago.SlotIndex(filter.GetCreatedAfter())))
+	}
+	if filter.AsOfSlot != nil {
+		opts = append(opts, indexer.NFTAsOfSlot(iotago.SlotIndex(filter.GetAsOfSlot())))
+	}
+
+	return opts, p.err
+}
+
+func (s *Server) foundryFilterOptions(filter *indexerpb.FoundryFilter) ([]options.Option[indexer.FoundryFilterOptions], error) {
+	p := &filterParser{s: s}
+
+	pageSize, cursor, sortOrder := p.pagination(filter.GetPagination())
+	opts := []options.Option[indexer.FoundryFilterOptions]{indexer.FoundryPageSize(pageSize), indexer.FoundrySortOrder(sortOrder)}
+	if cursor != nil {
+		opts = append(opts, indexer.FoundryCursor(*cursor))
+	}
+
+	if filter.HasNativeToken != nil {
+		opts = append(opts, indexer.FoundryHasNativeToken(filter.GetHasNativeToken()))
+	}
+	if filter.NativeToken != nil {
+		opts = append(opts, indexer.FoundryNativeToken(p.nativeTokenID("nativeToken", filter.GetNativeToken())))
+	}
+	if filter.Account != nil {
+		opts = append(opts, indexer.FoundryWithAccountAddress(p.accountAddress("account", filter.GetAccount())))
+	}
+	if filter.SerialNumber != nil {
+		opts = append(opts, indexer.FoundrySerialNumber(filter.GetSerialNumber()))
+	}
+	if filter.HasMintCapacity != nil {
+		opts = append(opts, indexer.FoundryHasMintCapacity(filter.GetHasMintCapacity()))
+	}
+	if filter.MinAmount != nil {
+		opts = append(opts, indexer.FoundryMinAmount(iotago.BaseToken(filter.GetMinAmount())))
+	}
+	if filter.MaxAmount != nil {
+		opts = append(opts, indexer.FoundryMaxAmount(iotago.BaseToken(filter.GetMaxAmount())))
+	}
+	if filter.CreatedBefore != nil {
+		opts = append(opts, indexer.FoundryCreatedBefore(iotago.SlotIndex(filter.GetCreatedBefore())))
+	}
+	if filter.CreatedAfter != nil {
+		opts = append(opts, indexer.FoundryCreatedAfter(iotago.SlotIndex(filter.GetCreatedAfter())))
+	}
+	if filter.AsOfSlot != nil {
+		opts = append(opts, indexer.FoundryAsOfSlot(iotago.SlotIndex(filter.GetAsOfSlot())))
+	}
+
+	return opts, p.err
+}
+
+func (s *Server) delegationFilterOptions(filter *indexerpb.DelegationFilter) ([]options.Option[indexer.DelegationFilterOptions], error) {
+	p := &filterParser{s: s}
+
+	pageSize, cursor, sortOrder := p.pagination(filter.GetPagination())
+	opts := []options.Option[indexer.DelegationFilterOptions]{indexer.DelegationPageSize(pageSize), indexer.DelegationSortOrder(sortOrder)}
+	if cursor != nil {
+		opts = append(opts, indexer.DelegationCursor(*cursor))
+	}
+
+	if filter.Address != nil {
+		opts = append(opts, indexer.DelegationAddress(p.address("address", filter.GetAddress())))
+	}
+	if filter.Validator != nil {
+		opts = append(opts, indexer.DelegationValidator(p.accountAddress("validator", filter.GetValidator())))
+	}
+	if filter.MinDelegatedAmount != nil {
+		opts = append(opts, indexer.DelegationMinDelegatedAmount(iotago.BaseToken(filter.GetMinDelegatedAmount())))
+	}
+	if filter.MaxDelegatedAmount != nil {
+		opts = append(opts, indexer.DelegationMaxDelegatedAmount(iotago.BaseToken(filter.GetMaxDelegatedAmount())))
+	}
+	if filter.StartEpochBefore != nil {
+		opts = append(opts, indexer.DelegationStartEpochBefore(iotago.EpochIndex(filter.GetStartEpochBefore())))
+	}
+	if filter.StartEpochAfter != nil {
+		opts = append(opts, indexer.DelegationStartEpochAfter(iotago.EpochIndex(filter.GetStartEpochAfter())))
+	}
+	if filter.HasEndEpoch != nil {
+		opts = append(opts, indexer.DelegationHasEndEpoch(filter.GetHasEndEpoch()))
+	}
+	if filter.MinAmount != nil {
+		opts = append(opts, indexer.DelegationMinAmount(iotago.BaseToken(filter.GetMinAmount())))
+	}
+	if filter.MaxAmount != nil {
+		opts = append(opts, indexer.DelegationMaxAmount(iotago.BaseToken(filter.GetMaxAmount())))
+	}
+	if filter.CreatedBefore != nil {
+		opts = append(opts, indexer.DelegationCreatedBefore(iotago.SlotIndex(filter.GetCreatedBefore())))
+	}
+	if filter.CreatedAfter != nil {
+		opts = append(opts, indexer.DelegationCreatedAfter(iotago.SlotIndex(filter.GetCreatedAfter())))
+	}
+	if filter.AsOfSlot != nil {
+		opts = append(opts, indexer.DelegationAsOfSlot(iotago.SlotIndex(filter.GetAsOfSlot())))
+	}
+
+	return opts, p.err
+}
+
+func (s *Server) combinedFilterOptions(filter *indexerpb.CombinedFilter) ([]options.Option[indexer.CombinedFilterOptions], error) {
+	p := &filterParser{s: s}
+
+	pageSize, cursor, sortOrder := p.pagination(filter.GetPagination())
+	opts := []options.Option[indexer.CombinedFilterOptions]{indexer.CombinedPageSize(pageSize), indexer.CombinedSortOrder(sortOrder)}
+	if cursor != nil {
+		opts = append(opts, indexer.CombinedCursor(*cursor))
+	}
+
+	if filter.HasNativeToken != nil {
+		opts = append(opts, indexer.CombinedHasNativeToken(filter.GetHasNativeToken()))
+	}
+	if filter.NativeToken != nil {
+		opts = append(opts, indexer.CombinedNativeToken(p.nativeTokenID("nativeToken", filter.GetNativeToken())))
+	}
+	if filter.UnlockableByAddress != nil {
+		opts = append(opts, indexer.CombinedUnlockableByAddress(p.address("unlockableByAddress", filter.GetUnlockableByAddress())))
+	}
+	if len(filter.GetUnlockableByAnyAddress()) > 0 {
+		if len(filter.GetUnlockableByAnyAddress()) > s.optsMaxAddresses {
+			p.fail("too many addresses in unlockableByAnyAddress: %d, max %d", len(filter.GetUnlockableByAnyAddress()), s.optsMaxAddresses)
+		}
+
+		addresses := make([]iotago.Address, 0, len(filter.GetUnlockableByAnyAddress()))
+		for _, address := range filter.GetUnlockableByAnyAddress() {
+			addresses = append(addresses, p.address("unlockableByAnyAddress", address))
+		}
+		opts = append(opts, indexer.CombinedUnlockableByAnyAddress(addresses...))
+	}
+	if filter.MinAmount != nil {
+		opts = append(opts, indexer.CombinedMinAmount(iotago.BaseToken(filter.GetMinAmount())))
+	}
+	if filter.MaxAmount != nil {
+		opts = append(opts, indexer.CombinedMaxAmount(iotago.BaseToken(filter.GetMaxAmount())))
+	}
+	if filter.CreatedBefore != nil {
+		opts = append(opts, indexer.CombinedCreatedBefore(iotago.SlotIndex(filter.GetCreatedBefore())))
+	}
+	if filter.CreatedAfter != nil {
+		opts = append(opts, indexer.CombinedCreatedAfter(iotago.SlotIndex(filter.GetCreatedAfter())))
+	}
+	if filter.AsOfSlot != nil {
+		opts = append(opts, indexer.CombinedAsOfSlot(iotago.SlotIndex(filter.GetAsOfSlot())))
+	}
+
+	return opts, p.err
+}
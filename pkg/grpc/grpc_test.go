@@ -0,0 +1,196 @@
+package grpc_test
+
+import (
+	"context"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	grpcgo "google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/iotaledger/hive.go/db"
+	"github.com/iotaledger/hive.go/log"
+	"github.com/iotaledger/hive.go/sql"
+	"github.com/iotaledger/inx-indexer/pkg/grpc"
+	"github.com/iotaledger/inx-indexer/pkg/grpc/indexerpb"
+	"github.com/iotaledger/inx-indexer/pkg/indexer"
+	iotago "github.com/iotaledger/iota.go/v4"
+	iotago_tpkg "github.com/iotaledger/iota.go/v4/tpkg"
+)
+
+func newTestClient(t *testing.T) (*indexer.Indexer, indexerpb.IndexerClient) {
+	dbParams := sql.DatabaseParameters{
+		Engine:   db.EngineSQLite,
+		Path:     t.TempDir(),
+		Filename: "indexer_test.db",
+	}
+
+	apiProvider := iotago.SingleVersionProvider(iotago_tpkg.ZeroCostTestAPI)
+
+	idx, err := indexer.NewIndexer(dbParams, apiProvider, log.NewLogger().NewChildLogger(t.Name()))
+	require.NoError(t, err)
+	require.NoError(t, idx.CreateTables())
+	require.NoError(t, idx.ImportTransaction(context.Background()).Finalize(0, t.Name(), 1))
+	require.NoError(t, idx.AutoMigrate())
+
+	listener := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer(idx, apiProvider, grpc.WithMaxPageSize(10), grpc.WithMaxAddresses(2))
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	t.Cleanup(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+
+		server.Shutdown(ctx)
+	})
+
+	conn, err := grpcgo.DialContext(context.Background(), "bufnet",
+		grpcgo.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpcgo.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	return idx, indexerpb.NewIndexerClient(conn)
+}
+
+func basicOutputWithAddress(address iotago.Address, amount iotago.BaseToken) iotago.Output {
+	return &iotago.BasicOutput{
+		Amount: amount,
+		UnlockConditions: iotago.BasicOutputUnlockConditions{
+			&iotago.AddressUnlockCondition{Address: address},
+		},
+	}
+}
+
+func TestServer_Basic(t *testing.T) {
+	idx, client := newTestClient(t)
+	ctx := context.Background()
+	hrp := iotago_tpkg.ZeroCostTestAPI.ProtocolParameters().Bech32HRP()
+
+	address := iotago_tpkg.RandEd25519Address()
+	update := &indexer.LedgerUpdate{Slot: 1}
+	outputIDs := make(map[iotago.OutputID]struct{})
+	for i := 0; i < 25; i++ {
+		outputID := iotago_tpkg.RandOutputID(0)
+		outputIDs[outputID] = struct{}{}
+		update.Created = append(update.Created, &indexer.LedgerOutput{OutputID: outputID, Output: basicOutputWithAddress(address, iotago.BaseToken(100000+i)), BookedAt: 1})
+	}
+	// an output of another address that does not match the filter
+	update.Created = append(update.Created, &indexer.LedgerOutput{OutputID: iotago_tpkg.RandOutputID(0), Output: basicOutputWithAddress(iotago_tpkg.RandEd25519Address(), 100000), BookedAt: 1})
+	require.NoError(t, idx.CommitLedgerUpdate(update))
+
+	bech32Address := address.Bech32(hrp)
+	minAmount := uint64(100010)
+
+	// A single page is limited by the configured maximum
+	result, err := client.Basic(ctx, &indexerpb.BasicFilter{
+		Pagination: &indexerpb.Pagination{PageSize: 100, SortOrder: indexerpb.SortOrder_SORT_ORDER_AMOUNT_ASC},
+		Address:    &bech32Address,
+		MinAmount:  &minAmount,
+	})
+	require.NoError(t, err)
+	require.EqualValues(t, 1, result.GetCommittedSlot())
+	require.EqualValues(t, 10, result.GetPageSize())
+	require.Len(t, result.GetOutputIds(), 10)
+	require.NotNil(t, result.Cursor)
+
+	// The stream returns all matching outputs without handling the cursor
+	stream, err := client.StreamBasic(ctx, &indexerpb.BasicFilter{
+		Pagination: &indexerpb.Pagination{PageSize: 4},
+		Address:    &bech32Address,
+	})
+	require.NoError(t, err)
+
+	streamed := make(map[iotago.OutputID]struct{})
+	var pages int
+	for {
+		page, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		pages++
+
+		for _, outputIDBytes := range page.GetOutputIds() {
+			outputID, _, err := iotago.OutputIDFromBytes(outputIDBytes)
+			require.NoError(t, err)
+			streamed[outputID] = struct{}{}
+		}
+	}
+	require.Equal(t, outputIDs, streamed)
+	require.GreaterOrEqual(t, pages, 7)
+}
+
+func TestServer_ByID(t *testing.T) {
+	idx, client := newTestClient(t)
+	ctx := context.Background()
+
+	accountID := iotago_tpkg.RandAccountID()
+	accountOutputID := iotago_tpkg.RandOutputID(0)
+	require.NoError(t, idx.CommitLedgerUpdate(&indexer.LedgerUpdate{
+		Slot: 1,
+		Created: []*indexer.LedgerOutput{{
+			OutputID: accountOutputID,
+			Output: &iotago.AccountOutput{
+				Amount:    100000,
+				AccountID: accountID,
+				UnlockConditions: iotago.AccountOutputUnlockConditions{
+					&iotago.AddressUnlockCondition{Address: iotago_tpkg.RandEd25519Address()},
+				},
+			},
+			BookedAt: 1,
+		}},
+	}))
+
+	result, err := client.AccountByID(ctx, &indexerpb.AccountByIDRequest{AccountId: accountID[:]})
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{accountOutputID[:]}, result.GetOutputIds())
+
+	unknownNFTID := iotago_tpkg.RandNFTAddress().NFTID()
+	_, err = client.NFTByID(ctx, &indexerpb.NFTByIDRequest{NftId: unknownNFTID[:]})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = client.AccountByID(ctx, &indexerpb.AccountByIDRequest{AccountId: []byte{1, 2, 3}})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestServer_InvalidArguments(t *testing.T) {
+	_, client := newTestClient(t)
+	ctx := context.Background()
+
+	invalidAddress := "invalid"
+	otherNetworkAddress := iotago_tpkg.RandEd25519Address().Bech32(iotago.PrefixMainnet)
+	invalidCursor := "invalid"
+	hrp := iotago_tpkg.ZeroCostTestAPI.ProtocolParameters().Bech32HRP()
+
+	_, err := client.Basic(ctx, &indexerpb.BasicFilter{Address: &invalidAddress})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = client.NFT(ctx, &indexerpb.NFTFilter{Issuer: &otherNetworkAddress})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = client.Combined(ctx, &indexerpb.CombinedFilter{Pagination: &indexerpb.Pagination{Cursor: &invalidCursor}})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = client.Combined(ctx, &indexerpb.CombinedFilter{UnlockableByAnyAddress: []string{
+		iotago_tpkg.RandEd25519Address().Bech32(hrp),
+		iotago_tpkg.RandEd25519Address().Bech32(hrp),
+		iotago_tpkg.RandEd25519Address().Bech32(hrp),
+	}})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	stream, err := client.StreamDelegation(ctx, &indexerpb.DelegationFilter{Validator: &invalidAddress})
+	require.NoError(t, err)
+	_, err = stream.Recv()
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
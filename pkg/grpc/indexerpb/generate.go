@@ -0,0 +1,3 @@
+package indexerpb
+
+//go:generate protoc --go_out=. --go_opt=paths=source_relative --go-grpc_out=. --go-grpc_opt=paths=source_relative indexer.proto
@@ -0,0 +1,2418 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.33.0
+// 	protoc        (unknown)
+// source: indexer.proto
+
+package indexerpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type SortOrder int32
+
+const (
+	SortOrder_SORT_ORDER_CREATED_ASC  SortOrder = 0
+	SortOrder_SORT_ORDER_CREATED_DESC SortOrder = 1
+	SortOrder_SORT_ORDER_AMOUNT_ASC   SortOrder = 2
+	SortOrder_SORT_ORDER_AMOUNT_DESC  SortOrder = 3
+)
+
+// Enum value maps for SortOrder.
+var (
+	SortOrder_name = map[int32]string{
+		0: "SORT_ORDER_CREATED_ASC",
+		1: "SORT_ORDER_CREATED_DESC",
+		2: "SORT_ORDER_AMOUNT_ASC",
+		3: "SORT_ORDER_AMOUNT_DESC",
+	}
+	SortOrder_value = map[string]int32{
+		"SORT_ORDER_CREATED_ASC":  0,
+		"SORT_ORDER_CREATED_DESC": 1,
+		"SORT_ORDER_AMOUNT_ASC":   2,
+		"SORT_ORDER_AMOUNT_DESC":  3,
+	}
+)
+
+func (x SortOrder) Enum() *SortOrder {
+	p := new(SortOrder)
+	*p = x
+	return p
+}
+
+func (x SortOrder) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SortOrder) Descriptor() protoreflect.EnumDescriptor {
+	return file_indexer_proto_enumTypes[0].Descriptor()
+}
+
+func (SortOrder) Type() protoreflect.EnumType {
+	return &file_indexer_proto_enumTypes[0]
+}
+
+func (x SortOrder) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SortOrder.Descriptor instead.
+func (SortOrder) EnumDescriptor() ([]byte, []int) {
+	return file_indexer_proto_rawDescGZIP(), []int{0}
+}
+
+type Pagination struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The maximum number of outputIDs per page, the configured maximum is used if it is not set or larger.
+	PageSize uint32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// The cursor of the previous result to get the next page.
+	Cursor    *string   `protobuf:"bytes,2,opt,name=cursor,proto3,oneof" json:"cursor,omitempty"`
+	SortOrder SortOrder `protobuf:"varint,3,opt,name=sort_order,json=sortOrder,proto3,enum=indexer.SortOrder" json:"sort_order,omitempty"`
+}
+
+func (x *Pagination) Reset() {
+	*x = Pagination{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pagination) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pagination) ProtoMessage() {}
+
+func (x *Pagination) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pagination.ProtoReflect.Descriptor instead.
+func (*Pagination) Descriptor() ([]byte, []int) {
+	return file_indexer_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Pagination) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *Pagination) GetCursor() string {
+	if x != nil && x.Cursor != nil {
+		return *x.Cursor
+	}
+	return ""
+}
+
+func (x *Pagination) GetSortOrder() SortOrder {
+	if x != nil {
+		return x.SortOrder
+	}
+	return SortOrder_SORT_ORDER_CREATED_ASC
+}
+
+type IndexerResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The committed slot at which the outputs were queried.
+	CommittedSlot uint32   `protobuf:"varint,1,opt,name=committed_slot,json=committedSlot,proto3" json:"committed_slot,omitempty"`
+	PageSize      uint32   `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	OutputIds     [][]byte `protobuf:"bytes,3,rep,name=output_ids,json=outputIds,proto3" json:"output_ids,omitempty"`
+	// The cursor to pass in the pagination to get the next page, not set if there are no further outputs.
+	Cursor *string `protobuf:"bytes,4,opt,name=cursor,proto3,oneof" json:"cursor,omitempty"`
+}
+
+func (x *IndexerResult) Reset() {
+	*x = IndexerResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IndexerResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IndexerResult) ProtoMessage() {}
+
+func (x *IndexerResult) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IndexerResult.ProtoReflect.Descriptor instead.
+func (*IndexerResult) Descriptor() ([]byte, []int) {
+	return file_indexer_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *IndexerResult) GetCommittedSlot() uint32 {
+	if x != nil {
+		return x.CommittedSlot
+	}
+	return 0
+}
+
+func (x *IndexerResult) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *IndexerResult) GetOutputIds() [][]byte {
+	if x != nil {
+		return x.OutputIds
+	}
+	return nil
+}
+
+func (x *IndexerResult) GetCursor() string {
+	if x != nil && x.Cursor != nil {
+		return *x.Cursor
+	}
+	return ""
+}
+
+type BasicFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pagination                  *Pagination `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	HasNativeToken              *bool       `protobuf:"varint,2,opt,name=has_native_token,json=hasNativeToken,proto3,oneof" json:"has_native_token,omitempty"`
+	NativeToken                 []byte      `protobuf:"bytes,3,opt,name=native_token,json=nativeToken,proto3,oneof" json:"native_token,omitempty"`
+	UnlockableByAddress         *string     `protobuf:"bytes,4,opt,name=unlockable_by_address,json=unlockableByAddress,proto3,oneof" json:"unlockable_by_address,omitempty"`
+	Address                     *string     `protobuf:"bytes,5,opt,name=address,proto3,oneof" json:"address,omitempty"`
+	HasStorageDepositReturn     *bool       `protobuf:"varint,6,opt,name=has_storage_deposit_return,json=hasStorageDepositReturn,proto3,oneof" json:"has_storage_deposit_return,omitempty"`
+	StorageDepositReturnAddress *string     `protobuf:"bytes,7,opt,name=storage_deposit_return_address,json=storageDepositReturnAddress,proto3,oneof" json:"storage_deposit_return_address,omitempty"`
+	HasExpiration               *bool       `protobuf:"varint,8,opt,name=has_expiration,json=hasExpiration,proto3,oneof" json:"has_expiration,omitempty"`
+	ExpiresBefore               *uint32     `protobuf:"varint,9,opt,name=expires_before,json=expiresBefore,proto3,oneof" json:"expires_before,omitempty"`
+	ExpiresAfter                *uint32     `protobuf:"varint,10,opt,name=expires_after,json=expiresAfter,proto3,oneof" json:"expires_after,omitempty"`
+	ExpirationReturnAddress     *string     `protobuf:"bytes,11,opt,name=expiration_return_address,json=expirationReturnAddress,proto3,oneof" json:"expiration_return_address,omitempty"`
+	HasTimelock                 *bool       `protobuf:"varint,12,opt,name=has_timelock,json=hasTimelock,proto3,oneof" json:"has_timelock,omitempty"`
+	TimelockedBefore            *uint32     `protobuf:"varint,13,opt,name=timelocked_before,json=timelockedBefore,proto3,oneof" json:"timelocked_before,omitempty"`
+	TimelockedAfter             *uint32     `protobuf:"varint,14,opt,name=timelocked_after,json=timelockedAfter,proto3,oneof" json:"timelocked_after,omitempty"`
+	Sender                      *string     `protobuf:"bytes,15,opt,name=sender,proto3,oneof" json:"sender,omitempty"`
+	Tag                         []byte      `protobuf:"bytes,16,opt,name=tag,proto3,oneof" json:"tag,omitempty"`
+	MinAmount                   *uint64     `protobuf:"varint,17,opt,name=min_amount,json=minAmount,proto3,oneof" json:"min_amount,omitempty"`
+	MaxAmount                   *uint64     `protobuf:"varint,18,opt,name=max_amount,json=maxAmount,proto3,oneof" json:"max_amount,omitempty"`
+	CreatedBefore               *uint32     `protobuf:"varint,19,opt,name=created_before,json=createdBefore,proto3,oneof" json:"created_before,omitempty"`
+	CreatedAfter                *uint32     `protobuf:"varint,20,opt,name=created_after,json=createdAfter,proto3,oneof" json:"created_after,omitempty"`
+	AsOfSlot                    *uint32     `protobuf:"varint,21,opt,name=as_of_slot,json=asOfSlot,proto3,oneof" json:"as_of_slot,omitempty"`
+}
+
+func (x *BasicFilter) Reset() {
+	*x = BasicFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BasicFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BasicFilter) ProtoMessage() {}
+
+func (x *BasicFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BasicFilter.ProtoReflect.Descriptor instead.
+func (*BasicFilter) Descriptor() ([]byte, []int) {
+	return file_indexer_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *BasicFilter) GetPagination() *Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *BasicFilter) GetHasNativeToken() bool {
+	if x != nil && x.HasNativeToken != nil {
+		return *x.HasNativeToken
+	}
+	return false
+}
+
+func (x *BasicFilter) GetNativeToken() []byte {
+	if x != nil {
+		return x.NativeToken
+	}
+	return nil
+}
+
+func (x *BasicFilter) GetUnlockableByAddress() string {
+	if x != nil && x.UnlockableByAddress != nil {
+		return *x.UnlockableByAddress
+	}
+	return ""
+}
+
+func (x *BasicFilter) GetAddress() string {
+	if x != nil && x.Address != nil {
+		return *x.Address
+	}
+	return ""
+}
+
+func (x *BasicFilter) GetHasStorageDepositReturn() bool {
+	if x != nil && x.HasStorageDepositReturn != nil {
+		return *x.HasStorageDepositReturn
+	}
+	return false
+}
+
+func (x *BasicFilter) GetStorageDepositReturnAddress() string {
+	if x != nil && x.StorageDepositReturnAddress != nil {
+		return *x.StorageDepositReturnAddress
+	}
+	return ""
+}
+
+func (x *BasicFilter) GetHasExpiration() bool {
+	if x != nil && x.HasExpiration != nil {
+		return *x.HasExpiration
+	}
+	return false
+}
+
+func (x *BasicFilter) GetExpiresBefore() uint32 {
+	if x != nil && x.ExpiresBefore != nil {
+		return *x.ExpiresBefore
+	}
+	return 0
+}
+
+func (x *BasicFilter) GetExpiresAfter() uint32 {
+	if x != nil && x.ExpiresAfter != nil {
+		return *x.ExpiresAfter
+	}
+	return 0
+}
+
+func (x *BasicFilter) GetExpirationReturnAddress() string {
+	if x != nil && x.ExpirationReturnAddress != nil {
+		return *x.ExpirationReturnAddress
+	}
+	return ""
+}
+
+func (x *BasicFilter) GetHasTimelock() bool {
+	if x != nil && x.HasTimelock != nil {
+		return *x.HasTimelock
+	}
+	return false
+}
+
+func (x *BasicFilter) GetTimelockedBefore() uint32 {
+	if x != nil && x.TimelockedBefore != nil {
+		return *x.TimelockedBefore
+	}
+	return 0
+}
+
+func (x *BasicFilter) GetTimelockedAfter() uint32 {
+	if x != nil && x.TimelockedAfter != nil {
+		return *x.TimelockedAfter
+	}
+	return 0
+}
+
+func (x *BasicFilter) GetSender() string {
+	if x != nil && x.Sender != nil {
+		return *x.Sender
+	}
+	return ""
+}
+
+func (x *BasicFilter) GetTag() []byte {
+	if x != nil {
+		return x.Tag
+	}
+	return nil
+}
+
+func (x *BasicFilter) GetMinAmount() uint64 {
+	if x != nil && x.MinAmount != nil {
+		return *x.MinAmount
+	}
+	return 0
+}
+
+func (x *BasicFilter) GetMaxAmount() uint64 {
+	if x != nil && x.MaxAmount != nil {
+		return *x.MaxAmount
+	}
+	return 0
+}
+
+func (x *BasicFilter) GetCreatedBefore() uint32 {
+	if x != nil && x.CreatedBefore != nil {
+		return *x.CreatedBefore
+	}
+	return 0
+}
+
+func (x *BasicFilter) GetCreatedAfter() uint32 {
+	if x != nil && x.CreatedAfter != nil {
+		return *x.CreatedAfter
+	}
+	return 0
+}
+
+func (x *BasicFilter) GetAsOfSlot() uint32 {
+	if x != nil && x.AsOfSlot != nil {
+		return *x.AsOfSlot
+	}
+	return 0
+}
+
+type AccountFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pagination               *Pagination `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Address                  *string     `protobuf:"bytes,2,opt,name=address,proto3,oneof" json:"address,omitempty"`
+	Sender                   *string     `protobuf:"bytes,3,opt,name=sender,proto3,oneof" json:"sender,omitempty"`
+	Issuer                   *string     `protobuf:"bytes,4,opt,name=issuer,proto3,oneof" json:"issuer,omitempty"`
+	IsBlockIssuer            *bool       `protobuf:"varint,5,opt,name=is_block_issuer,json=isBlockIssuer,proto3,oneof" json:"is_block_issuer,omitempty"`
+	BlockIssuerExpiresBefore *uint32     `protobuf:"varint,6,opt,name=block_issuer_expires_before,json=blockIssuerExpiresBefore,proto3,oneof" json:"block_issuer_expires_before,omitempty"`
+	BlockIssuerExpiresAfter  *uint32     `protobuf:"varint,7,opt,name=block_issuer_expires_after,json=blockIssuerExpiresAfter,proto3,oneof" json:"block_issuer_expires_after,omitempty"`
+	BlockIssuerKey           []byte      `protobuf:"bytes,8,opt,name=block_issuer_key,json=blockIssuerKey,proto3,oneof" json:"block_issuer_key,omitempty"`
+	MinAmount                *uint64     `protobuf:"varint,9,opt,name=min_amount,json=minAmount,proto3,oneof" json:"min_amount,omitempty"`
+	MaxAmount                *uint64     `protobuf:"varint,10,opt,name=max_amount,json=maxAmount,proto3,oneof" json:"max_amount,omitempty"`
+	CreatedBefore            *uint32     `protobuf:"varint,11,opt,name=created_before,json=createdBefore,proto3,oneof" json:"created_before,omitempty"`
+	CreatedAfter             *uint32     `protobuf:"varint,12,opt,name=created_after,json=createdAfter,proto3,oneof" json:"created_after,omitempty"`
+	AsOfSlot                 *uint32     `protobuf:"varint,13,opt,name=as_of_slot,json=asOfSlot,proto3,oneof" json:"as_of_slot,omitempty"`
+}
+
+func (x *AccountFilter) Reset() {
+	*x = AccountFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccountFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountFilter) ProtoMessage() {}
+
+func (x *AccountFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountFilter.ProtoReflect.Descriptor instead.
+func (*AccountFilter) Descriptor() ([]byte, []int) {
+	return file_indexer_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *AccountFilter) GetPagination() *Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *AccountFilter) GetAddress() string {
+	if x != nil && x.Address != nil {
+		return *x.Address
+	}
+	return ""
+}
+
+func (x *AccountFilter) GetSender() string {
+	if x != nil && x.Sender != nil {
+		return *x.Sender
+	}
+	return ""
+}
+
+func (x *AccountFilter) GetIssuer() string {
+	if x != nil && x.Issuer != nil {
+		return *x.Issuer
+	}
+	return ""
+}
+
+func (x *AccountFilter) GetIsBlockIssuer() bool {
+	if x != nil && x.IsBlockIssuer != nil {
+		return *x.IsBlockIssuer
+	}
+	return false
+}
+
+func (x *AccountFilter) GetBlockIssuerExpiresBefore() uint32 {
+	if x != nil && x.BlockIssuerExpiresBefore != nil {
+		return *x.BlockIssuerExpiresBefore
+	}
+	return 0
+}
+
+func (x *AccountFilter) GetBlockIssuerExpiresAfter() uint32 {
+	if x != nil && x.BlockIssuerExpiresAfter != nil {
+		return *x.BlockIssuerExpiresAfter
+	}
+	return 0
+}
+
+func (x *AccountFilter) GetBlockIssuerKey() []byte {
+	if x != nil {
+		return x.BlockIssuerKey
+	}
+	return nil
+}
+
+func (x *AccountFilter) GetMinAmount() uint64 {
+	if x != nil && x.MinAmount != nil {
+		return *x.MinAmount
+	}
+	return 0
+}
+
+func (x *AccountFilter) GetMaxAmount() uint64 {
+	if x != nil && x.MaxAmount != nil {
+		return *x.MaxAmount
+	}
+	return 0
+}
+
+func (x *AccountFilter) GetCreatedBefore() uint32 {
+	if x != nil && x.CreatedBefore != nil {
+		return *x.CreatedBefore
+	}
+	return 0
+}
+
+func (x *AccountFilter) GetCreatedAfter() uint32 {
+	if x != nil && x.CreatedAfter != nil {
+		return *x.CreatedAfter
+	}
+	return 0
+}
+
+func (x *AccountFilter) GetAsOfSlot() uint32 {
+	if x != nil && x.AsOfSlot != nil {
+		return *x.AsOfSlot
+	}
+	return 0
+}
+
+type AnchorFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pagination          *Pagination `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	UnlockableByAddress *string     `protobuf:"bytes,2,opt,name=unlockable_by_address,json=unlockableByAddress,proto3,oneof" json:"unlockable_by_address,omitempty"`
+	StateController     *string     `protobuf:"bytes,3,opt,name=state_controller,json=stateController,proto3,oneof" json:"state_controller,omitempty"`
+	Governor            *string     `protobuf:"bytes,4,opt,name=governor,proto3,oneof" json:"governor,omitempty"`
+	Sender              *string     `protobuf:"bytes,5,opt,name=sender,proto3,oneof" json:"sender,omitempty"`
+	Issuer              *string     `protobuf:"bytes,6,opt,name=issuer,proto3,oneof" json:"issuer,omitempty"`
+	MinAmount           *uint64     `protobuf:"varint,7,opt,name=min_amount,json=minAmount,proto3,oneof" json:"min_amount,omitempty"`
+	MaxAmount           *uint64     `protobuf:"varint,8,opt,name=max_amount,json=maxAmount,proto3,oneof" json:"max_amount,omitempty"`
+	CreatedBefore       *uint32     `protobuf:"varint,9,opt,name=created_before,json=createdBefore,proto3,oneof" json:"created_before,omitempty"`
+	CreatedAfter        *uint32     `protobuf:"varint,10,opt,name=created_after,json=createdAfter,proto3,oneof" json:"created_after,omitempty"`
+	AsOfSlot            *uint32     `protobuf:"varint,11,opt,name=as_of_slot,json=asOfSlot,proto3,oneof" json:"as_of_slot,omitempty"`
+}
+
+func (x *AnchorFilter) Reset() {
+	*x = AnchorFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AnchorFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnchorFilter) ProtoMessage() {}
+
+func (x *AnchorFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnchorFilter.ProtoReflect.Descriptor instead.
+func (*AnchorFilter) Descriptor() ([]byte, []int) {
+	return file_indexer_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *AnchorFilter) GetPagination() *Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *AnchorFilter) GetUnlockableByAddress() string {
+	if x != nil && x.UnlockableByAddress != nil {
+		return *x.UnlockableByAddress
+	}
+	return ""
+}
+
+func (x *AnchorFilter) GetStateController() string {
+	if x != nil && x.StateController != nil {
+		return *x.StateController
+	}
+	return ""
+}
+
+func (x *AnchorFilter) GetGovernor() string {
+	if x != nil && x.Governor != nil {
+		return *x.Governor
+	}
+	return ""
+}
+
+func (x *AnchorFilter) GetSender() string {
+	if x != nil && x.Sender != nil {
+		return *x.Sender
+	}
+	return ""
+}
+
+func (x *AnchorFilter) GetIssuer() string {
+	if x != nil && x.Issuer != nil {
+		return *x.Issuer
+	}
+	return ""
+}
+
+func (x *AnchorFilter) GetMinAmount() uint64 {
+	if x != nil && x.MinAmount != nil {
+		return *x.MinAmount
+	}
+	return 0
+}
+
+func (x *AnchorFilter) GetMaxAmount() uint64 {
+	if x != nil && x.MaxAmount != nil {
+		return *x.MaxAmount
+	}
+	return 0
+}
+
+func (x *AnchorFilter) GetCreatedBefore() uint32 {
+	if x != nil && x.CreatedBefore != nil {
+		return *x.CreatedBefore
+	}
+	return 0
+}
+
+func (x *AnchorFilter) GetCreatedAfter() uint32 {
+	if x != nil && x.CreatedAfter != nil {
+		return *x.CreatedAfter
+	}
+	return 0
+}
+
+func (x *AnchorFilter) GetAsOfSlot() uint32 {
+	if x != nil && x.AsOfSlot != nil {
+		return *x.AsOfSlot
+	}
+	return 0
+}
+
+type NFTFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pagination                  *Pagination `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	UnlockableByAddress         *string     `protobuf:"bytes,2,opt,name=unlockable_by_address,json=unlockableByAddress,proto3,oneof" json:"unlockable_by_address,omitempty"`
+	Address                     *string     `protobuf:"bytes,3,opt,name=address,proto3,oneof" json:"address,omitempty"`
+	HasStorageDepositReturn     *bool       `protobuf:"varint,4,opt,name=has_storage_deposit_return,json=hasStorageDepositReturn,proto3,oneof" json:"has_storage_deposit_return,omitempty"`
+	StorageDepositReturnAddress *string     `protobuf:"bytes,5,opt,name=storage_deposit_return_address,json=storageDepositReturnAddress,proto3,oneof" json:"storage_deposit_return_address,omitempty"`
+	HasExpiration               *bool       `protobuf:"varint,6,opt,name=has_expiration,json=hasExpiration,proto3,oneof" json:"has_expiration,omitempty"`
+	ExpiresBefore               *uint32     `protobuf:"varint,7,opt,name=expires_before,json=expiresBefore,proto3,oneof" json:"expires_before,omitempty"`
+	ExpiresAfter                *uint32     `protobuf:"varint,8,opt,name=expires_after,json=expiresAfter,proto3,oneof" json:"expires_after,omitempty"`
+	ExpirationReturnAddress     *string     `protobuf:"bytes,9,opt,name=expiration_return_address,json=expirationReturnAddress,proto3,oneof" json:"expiration_return_address,omitempty"`
+	HasTimelock                 *bool       `protobuf:"varint,10,opt,name=has_timelock,json=hasTimelock,proto3,oneof" json:"has_timelock,omitempty"`
+	TimelockedBefore            *uint32     `protobuf:"varint,11,opt,name=timelocked_before,json=timelockedBefore,proto3,oneof" json:"timelocked_before,omitempty"`
+	TimelockedAfter             *uint32     `protobuf:"varint,12,opt,name=timelocked_after,json=timelockedAfter,proto3,oneof" json:"timelocked_after,omitempty"`
+	Issuer                      *string     `protobuf:"bytes,13,opt,name=issuer,proto3,oneof" json:"issuer,omitempty"`
+	Collection                  []byte      `protobuf:"bytes,14,opt,name=collection,proto3,oneof" json:"collection,omitempty"`
+	CollectionName              *string     `protobuf:"bytes,15,opt,name=collection_name,json=collectionName,proto3,oneof" json:"collection_name,omitempty"`
+	MediaType                   *string     `protobuf:"bytes,16,opt,name=media_type,json=mediaType,proto3,oneof" json:"media_type,omitempty"`
+	NamePrefix                  *string     `protobuf:"bytes,17,opt,name=name_prefix,json=namePrefix,proto3,oneof" json:"name_prefix,omitempty"`
+	Sender                      *string     `protobuf:"bytes,18,opt,name=sender,proto3,oneof" json:"sender,omitempty"`
+	Tag                         []byte      `protobuf:"bytes,19,opt,name=tag,proto3,oneof" json:"tag,omitempty"`
+	MinAmount                   *uint64     `protobuf:"varint,20,opt,name=min_amount,json=minAmount,proto3,oneof" json:"min_amount,omitempty"`
+	MaxAmount                   *uint64     `protobuf:"varint,21,opt,name=max_amount,json=maxAmount,proto3,oneof" json:"max_amount,omitempty"`
+	CreatedBefore               *uint32     `protobuf:"varint,22,opt,name=created_before,json=createdBefore,proto3,oneof" json:"created_before,omitempty"`
+	CreatedAfter                *uint32     `protobuf:"varint,23,opt,name=created_after,json=createdAfter,proto3,oneof" json:"created_after,omitempty"`
+	AsOfSlot                    *uint32     `protobuf:"varint,24,opt,name=as_of_slot,json=asOfSlot,proto3,oneof" json:"as_of_slot,omitempty"`
+}
+
+func (x *NFTFilter) Reset() {
+	*x = NFTFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NFTFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NFTFilter) ProtoMessage() {}
+
+func (x *NFTFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NFTFilter.ProtoReflect.Descriptor instead.
+func (*NFTFilter) Descriptor() ([]byte, []int) {
+	return file_indexer_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *NFTFilter) GetPagination() *Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *NFTFilter) GetUnlockableByAddress() string {
+	if x != nil && x.UnlockableByAddress != nil {
+		return *x.UnlockableByAddress
+	}
+	return ""
+}
+
+func (x *NFTFilter) GetAddress() string {
+	if x != nil && x.Address != nil {
+		return *x.Address
+	}
+	return ""
+}
+
+func (x *NFTFilter) GetHasStorageDepositReturn() bool {
+	if x != nil && x.HasStorageDepositReturn != nil {
+		return *x.HasStorageDepositReturn
+	}
+	return false
+}
+
+func (x *NFTFilter) GetStorageDepositReturnAddress() string {
+	if x != nil && x.StorageDepositReturnAddress != nil {
+		return *x.StorageDepositReturnAddress
+	}
+	return ""
+}
+
+func (x *NFTFilter) GetHasExpiration() bool {
+	if x != nil && x.HasExpiration != nil {
+		return *x.HasExpiration
+	}
+	return false
+}
+
+func (x *NFTFilter) GetExpiresBefore() uint32 {
+	if x != nil && x.ExpiresBefore != nil {
+		return *x.ExpiresBefore
+	}
+	return 0
+}
+
+func (x *NFTFilter) GetExpiresAfter() uint32 {
+	if x != nil && x.ExpiresAfter != nil {
+		return *x.ExpiresAfter
+	}
+	return 0
+}
+
+func (x *NFTFilter) GetExpirationReturnAddress() string {
+	if x != nil && x.ExpirationReturnAddress != nil {
+		return *x.ExpirationReturnAddress
+	}
+	return ""
+}
+
+func (x *NFTFilter) GetHasTimelock() bool {
+	if x != nil && x.HasTimelock != nil {
+		return *x.HasTimelock
+	}
+	return false
+}
+
+func (x *NFTFilter) GetTimelockedBefore() uint32 {
+	if x != nil && x.TimelockedBefore != nil {
+		return *x.TimelockedBefore
+	}
+	return 0
+}
+
+func (x *NFTFilter) GetTimelockedAfter() uint32 {
+	if x != nil && x.TimelockedAfter != nil {
+		return *x.TimelockedAfter
+	}
+	return 0
+}
+
+func (x *NFTFilter) GetIssuer() string {
+	if x != nil && x.Issuer != nil {
+		return *x.Issuer
+	}
+	return ""
+}
+
+func (x *NFTFilter) GetCollection() []byte {
+	if x != nil {
+		return x.Collection
+	}
+	return nil
+}
+
+func (x *NFTFilter) GetCollectionName() string {
+	if x != nil && x.CollectionName != nil {
+		return *x.CollectionName
+	}
+	return ""
+}
+
+func (x *NFTFilter) GetMediaType() string {
+	if x != nil && x.MediaType != nil {
+		return *x.MediaType
+	}
+	return ""
+}
+
+func (x *NFTFilter) GetNamePrefix() string {
+	if x != nil && x.NamePrefix != nil {
+		return *x.NamePrefix
+	}
+	return ""
+}
+
+func (x *NFTFilter) GetSender() string {
+	if x != nil && x.Sender != nil {
+		return *x.Sender
+	}
+	return ""
+}
+
+func (x *NFTFilter) GetTag() []byte {
+	if x != nil {
+		return x.Tag
+	}
+	return nil
+}
+
+func (x *NFTFilter) GetMinAmount() uint64 {
+	if x != nil && x.MinAmount != nil {
+		return *x.MinAmount
+	}
+	return 0
+}
+
+func (x *NFTFilter) GetMaxAmount() uint64 {
+	if x != nil && x.MaxAmount != nil {
+		return *x.MaxAmount
+	}
+	return 0
+}
+
+func (x *NFTFilter) GetCreatedBefore() uint32 {
+	if x != nil && x.CreatedBefore != nil {
+		return *x.CreatedBefore
+	}
+	return 0
+}
+
+func (x *NFTFilter) GetCreatedAfter() uint32 {
+	if x != nil && x.CreatedAfter != nil {
+		return *x.CreatedAfter
+	}
+	return 0
+}
+
+func (x *NFTFilter) GetAsOfSlot() uint32 {
+	if x != nil && x.AsOfSlot != nil {
+		return *x.AsOfSlot
+	}
+	return 0
+}
+
+type FoundryFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pagination      *Pagination `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	HasNativeToken  *bool       `protobuf:"varint,2,opt,name=has_native_token,json=hasNativeToken,proto3,oneof" json:"has_native_token,omitempty"`
+	NativeToken     []byte      `protobuf:"bytes,3,opt,name=native_token,json=nativeToken,proto3,oneof" json:"native_token,omitempty"`
+	Account         *string     `protobuf:"bytes,4,opt,name=account,proto3,oneof" json:"account,omitempty"`
+	SerialNumber    *uint32     `protobuf:"varint,5,opt,name=serial_number,json=serialNumber,proto3,oneof" json:"serial_number,omitempty"`
+	HasMintCapacity *bool       `protobuf:"varint,6,opt,name=has_mint_capacity,json=hasMintCapacity,proto3,oneof" json:"has_mint_capacity,omitempty"`
+	MinAmount       *uint64     `protobuf:"varint,7,opt,name=min_amount,json=minAmount,proto3,oneof" json:"min_amount,omitempty"`
+	MaxAmount       *uint64     `protobuf:"varint,8,opt,name=max_amount,json=maxAmount,proto3,oneof" json:"max_amount,omitempty"`
+	CreatedBefore   *uint32     `protobuf:"varint,9,opt,name=created_before,json=createdBefore,proto3,oneof" json:"created_before,omitempty"`
+	CreatedAfter    *uint32     `protobuf:"varint,10,opt,name=created_after,json=createdAfter,proto3,oneof" json:"created_after,omitempty"`
+	AsOfSlot        *uint32     `protobuf:"varint,11,opt,name=as_of_slot,json=asOfSlot,proto3,oneof" json:"as_of_slot,omitempty"`
+}
+
+func (x *FoundryFilter) Reset() {
+	*x = FoundryFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FoundryFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FoundryFilter) ProtoMessage() {}
+
+func (x *FoundryFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FoundryFilter.ProtoReflect.Descriptor instead.
+func (*FoundryFilter) Descriptor() ([]byte, []int) {
+	return file_indexer_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *FoundryFilter) GetPagination() *Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *FoundryFilter) GetHasNativeToken() bool {
+	if x != nil && x.HasNativeToken != nil {
+		return *x.HasNativeToken
+	}
+	return false
+}
+
+func (x *FoundryFilter) GetNativeToken() []byte {
+	if x != nil {
+		return x.NativeToken
+	}
+	return nil
+}
+
+func (x *FoundryFilter) GetAccount() string {
+	if x != nil && x.Account != nil {
+		return *x.Account
+	}
+	return ""
+}
+
+func (x *FoundryFilter) GetSerialNumber() uint32 {
+	if x != nil && x.SerialNumber != nil {
+		return *x.SerialNumber
+	}
+	return 0
+}
+
+func (x *FoundryFilter) GetHasMintCapacity() bool {
+	if x != nil && x.HasMintCapacity != nil {
+		return *x.HasMintCapacity
+	}
+	return false
+}
+
+func (x *FoundryFilter) GetMinAmount() uint64 {
+	if x != nil && x.MinAmount != nil {
+		return *x.MinAmount
+	}
+	return 0
+}
+
+func (x *FoundryFilter) GetMaxAmount() uint64 {
+	if x != nil && x.MaxAmount != nil {
+		return *x.MaxAmount
+	}
+	return 0
+}
+
+func (x *FoundryFilter) GetCreatedBefore() uint32 {
+	if x != nil && x.CreatedBefore != nil {
+		return *x.CreatedBefore
+	}
+	return 0
+}
+
+func (x *FoundryFilter) GetCreatedAfter() uint32 {
+	if x != nil && x.CreatedAfter != nil {
+		return *x.CreatedAfter
+	}
+	return 0
+}
+
+func (x *FoundryFilter) GetAsOfSlot() uint32 {
+	if x != nil && x.AsOfSlot != nil {
+		return *x.AsOfSlot
+	}
+	return 0
+}
+
+type DelegationFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pagination         *Pagination `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Address            *string     `protobuf:"bytes,2,opt,name=address,proto3,oneof" json:"address,omitempty"`
+	Validator          *string     `protobuf:"bytes,3,opt,name=validator,proto3,oneof" json:"validator,omitempty"`
+	MinDelegatedAmount *uint64     `protobuf:"varint,4,opt,name=min_delegated_amount,json=minDelegatedAmount,proto3,oneof" json:"min_delegated_amount,omitempty"`
+	MaxDelegatedAmount *uint64     `protobuf:"varint,5,opt,name=max_delegated_amount,json=maxDelegatedAmount,proto3,oneof" json:"max_delegated_amount,omitempty"`
+	StartEpochBefore   *uint32     `protobuf:"varint,6,opt,name=start_epoch_before,json=startEpochBefore,proto3,oneof" json:"start_epoch_before,omitempty"`
+	StartEpochAfter    *uint32     `protobuf:"varint,7,opt,name=start_epoch_after,json=startEpochAfter,proto3,oneof" json:"start_epoch_after,omitempty"`
+	HasEndEpoch        *bool       `protobuf:"varint,8,opt,name=has_end_epoch,json=hasEndEpoch,proto3,oneof" json:"has_end_epoch,omitempty"`
+	MinAmount          *uint64     `protobuf:"varint,9,opt,name=min_amount,json=minAmount,proto3,oneof" json:"min_amount,omitempty"`
+	MaxAmount          *uint64     `protobuf:"varint,10,opt,name=max_amount,json=maxAmount,proto3,oneof" json:"max_amount,omitempty"`
+	CreatedBefore      *uint32     `protobuf:"varint,11,opt,name=created_before,json=createdBefore,proto3,oneof" json:"created_before,omitempty"`
+	CreatedAfter       *uint32     `protobuf:"varint,12,opt,name=created_after,json=createdAfter,proto3,oneof" json:"created_after,omitempty"`
+	AsOfSlot           *uint32     `protobuf:"varint,13,opt,name=as_of_slot,json=asOfSlot,proto3,oneof" json:"as_of_slot,omitempty"`
+}
+
+func (x *DelegationFilter) Reset() {
+	*x = DelegationFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DelegationFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DelegationFilter) ProtoMessage() {}
+
+func (x *DelegationFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DelegationFilter.ProtoReflect.Descriptor instead.
+func (*DelegationFilter) Descriptor() ([]byte, []int) {
+	return file_indexer_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DelegationFilter) GetPagination() *Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *DelegationFilter) GetAddress() string {
+	if x != nil && x.Address != nil {
+		return *x.Address
+	}
+	return ""
+}
+
+func (x *DelegationFilter) GetValidator() string {
+	if x != nil && x.Validator != nil {
+		return *x.Validator
+	}
+	return ""
+}
+
+func (x *DelegationFilter) GetMinDelegatedAmount() uint64 {
+	if x != nil && x.MinDelegatedAmount != nil {
+		return *x.MinDelegatedAmount
+	}
+	return 0
+}
+
+func (x *DelegationFilter) GetMaxDelegatedAmount() uint64 {
+	if x != nil && x.MaxDelegatedAmount != nil {
+		return *x.MaxDelegatedAmount
+	}
+	return 0
+}
+
+func (x *DelegationFilter) GetStartEpochBefore() uint32 {
+	if x != nil && x.StartEpochBefore != nil {
+		return *x.StartEpochBefore
+	}
+	return 0
+}
+
+func (x *DelegationFilter) GetStartEpochAfter() uint32 {
+	if x != nil && x.StartEpochAfter != nil {
+		return *x.StartEpochAfter
+	}
+	return 0
+}
+
+func (x *DelegationFilter) GetHasEndEpoch() bool {
+	if x != nil && x.HasEndEpoch != nil {
+		return *x.HasEndEpoch
+	}
+	return false
+}
+
+func (x *DelegationFilter) GetMinAmount() uint64 {
+	if x != nil && x.MinAmount != nil {
+		return *x.MinAmount
+	}
+	return 0
+}
+
+func (x *DelegationFilter) GetMaxAmount() uint64 {
+	if x != nil && x.MaxAmount != nil {
+		return *x.MaxAmount
+	}
+	return 0
+}
+
+func (x *DelegationFilter) GetCreatedBefore() uint32 {
+	if x != nil && x.CreatedBefore != nil {
+		return *x.CreatedBefore
+	}
+	return 0
+}
+
+func (x *DelegationFilter) GetCreatedAfter() uint32 {
+	if x != nil && x.CreatedAfter != nil {
+		return *x.CreatedAfter
+	}
+	return 0
+}
+
+func (x *DelegationFilter) GetAsOfSlot() uint32 {
+	if x != nil && x.AsOfSlot != nil {
+		return *x.AsOfSlot
+	}
+	return 0
+}
+
+type CombinedFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pagination             *Pagination `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	HasNativeToken         *bool       `protobuf:"varint,2,opt,name=has_native_token,json=hasNativeToken,proto3,oneof" json:"has_native_token,omitempty"`
+	NativeToken            []byte      `protobuf:"bytes,3,opt,name=native_token,json=nativeToken,proto3,oneof" json:"native_token,omitempty"`
+	UnlockableByAddress    *string     `protobuf:"bytes,4,opt,name=unlockable_by_address,json=unlockableByAddress,proto3,oneof" json:"unlockable_by_address,omitempty"`
+	UnlockableByAnyAddress []string    `protobuf:"bytes,5,rep,name=unlockable_by_any_address,json=unlockableByAnyAddress,proto3" json:"unlockable_by_any_address,omitempty"`
+	MinAmount              *uint64     `protobuf:"varint,6,opt,name=min_amount,json=minAmount,proto3,oneof" json:"min_amount,omitempty"`
+	MaxAmount              *uint64     `protobuf:"varint,7,opt,name=max_amount,json=maxAmount,proto3,oneof" json:"max_amount,omitempty"`
+	CreatedBefore          *uint32     `protobuf:"varint,8,opt,name=created_before,json=createdBefore,proto3,oneof" json:"created_before,omitempty"`
+	CreatedAfter           *uint32     `protobuf:"varint,9,opt,name=created_after,json=createdAfter,proto3,oneof" json:"created_after,omitempty"`
+	AsOfSlot               *uint32     `protobuf:"varint,10,opt,name=as_of_slot,json=asOfSlot,proto3,oneof" json:"as_of_slot,omitempty"`
+}
+
+func (x *CombinedFilter) Reset() {
+	*x = CombinedFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CombinedFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CombinedFilter) ProtoMessage() {}
+
+func (x *CombinedFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CombinedFilter.ProtoReflect.Descriptor instead.
+func (*CombinedFilter) Descriptor() ([]byte, []int) {
+	return file_indexer_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CombinedFilter) GetPagination() *Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *CombinedFilter) GetHasNativeToken() bool {
+	if x != nil && x.HasNativeToken != nil {
+		return *x.HasNativeToken
+	}
+	return false
+}
+
+func (x *CombinedFilter) GetNativeToken() []byte {
+	if x != nil {
+		return x.NativeToken
+	}
+	return nil
+}
+
+func (x *CombinedFilter) GetUnlockableByAddress() string {
+	if x != nil && x.UnlockableByAddress != nil {
+		return *x.UnlockableByAddress
+	}
+	return ""
+}
+
+func (x *CombinedFilter) GetUnlockableByAnyAddress() []string {
+	if x != nil {
+		return x.UnlockableByAnyAddress
+	}
+	return nil
+}
+
+func (x *CombinedFilter) GetMinAmount() uint64 {
+	if x != nil && x.MinAmount != nil {
+		return *x.MinAmount
+	}
+	return 0
+}
+
+func (x *CombinedFilter) GetMaxAmount() uint64 {
+	if x != nil && x.MaxAmount != nil {
+		return *x.MaxAmount
+	}
+	return 0
+}
+
+func (x *CombinedFilter) GetCreatedBefore() uint32 {
+	if x != nil && x.CreatedBefore != nil {
+		return *x.CreatedBefore
+	}
+	return 0
+}
+
+func (x *CombinedFilter) GetCreatedAfter() uint32 {
+	if x != nil && x.CreatedAfter != nil {
+		return *x.CreatedAfter
+	}
+	return 0
+}
+
+func (x *CombinedFilter) GetAsOfSlot() uint32 {
+	if x != nil && x.AsOfSlot != nil {
+		return *x.AsOfSlot
+	}
+	return 0
+}
+
+type AccountByIDRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccountId []byte  `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	AsOfSlot  *uint32 `protobuf:"varint,2,opt,name=as_of_slot,json=asOfSlot,proto3,oneof" json:"as_of_slot,omitempty"`
+}
+
+func (x *AccountByIDRequest) Reset() {
+	*x = AccountByIDRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccountByIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountByIDRequest) ProtoMessage() {}
+
+func (x *AccountByIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountByIDRequest.ProtoReflect.Descriptor instead.
+func (*AccountByIDRequest) Descriptor() ([]byte, []int) {
+	return file_indexer_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *AccountByIDRequest) GetAccountId() []byte {
+	if x != nil {
+		return x.AccountId
+	}
+	return nil
+}
+
+func (x *AccountByIDRequest) GetAsOfSlot() uint32 {
+	if x != nil && x.AsOfSlot != nil {
+		return *x.AsOfSlot
+	}
+	return 0
+}
+
+type AnchorByIDRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AnchorId []byte  `protobuf:"bytes,1,opt,name=anchor_id,json=anchorId,proto3" json:"anchor_id,omitempty"`
+	AsOfSlot *uint32 `protobuf:"varint,2,opt,name=as_of_slot,json=asOfSlot,proto3,oneof" json:"as_of_slot,omitempty"`
+}
+
+func (x *AnchorByIDRequest) Reset() {
+	*x = AnchorByIDRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AnchorByIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnchorByIDRequest) ProtoMessage() {}
+
+func (x *AnchorByIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnchorByIDRequest.ProtoReflect.Descriptor instead.
+func (*AnchorByIDRequest) Descriptor() ([]byte, []int) {
+	return file_indexer_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *AnchorByIDRequest) GetAnchorId() []byte {
+	if x != nil {
+		return x.AnchorId
+	}
+	return nil
+}
+
+func (x *AnchorByIDRequest) GetAsOfSlot() uint32 {
+	if x != nil && x.AsOfSlot != nil {
+		return *x.AsOfSlot
+	}
+	return 0
+}
+
+type NFTByIDRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NftId    []byte  `protobuf:"bytes,1,opt,name=nft_id,json=nftId,proto3" json:"nft_id,omitempty"`
+	AsOfSlot *uint32 `protobuf:"varint,2,opt,name=as_of_slot,json=asOfSlot,proto3,oneof" json:"as_of_slot,omitempty"`
+}
+
+func (x *NFTByIDRequest) Reset() {
+	*x = NFTByIDRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NFTByIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NFTByIDRequest) ProtoMessage() {}
+
+func (x *NFTByIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NFTByIDRequest.ProtoReflect.Descriptor instead.
+func (*NFTByIDRequest) Descriptor() ([]byte, []int) {
+	return file_indexer_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *NFTByIDRequest) GetNftId() []byte {
+	if x != nil {
+		return x.NftId
+	}
+	return nil
+}
+
+func (x *NFTByIDRequest) GetAsOfSlot() uint32 {
+	if x != nil && x.AsOfSlot != nil {
+		return *x.AsOfSlot
+	}
+	return 0
+}
+
+type FoundryByIDRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FoundryId []byte  `protobuf:"bytes,1,opt,name=foundry_id,json=foundryId,proto3" json:"foundry_id,omitempty"`
+	AsOfSlot  *uint32 `protobuf:"varint,2,opt,name=as_of_slot,json=asOfSlot,proto3,oneof" json:"as_of_slot,omitempty"`
+}
+
+func (x *FoundryByIDRequest) Reset() {
+	*x = FoundryByIDRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FoundryByIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FoundryByIDRequest) ProtoMessage() {}
+
+func (x *FoundryByIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FoundryByIDRequest.ProtoReflect.Descriptor instead.
+func (*FoundryByIDRequest) Descriptor() ([]byte, []int) {
+	return file_indexer_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *FoundryByIDRequest) GetFoundryId() []byte {
+	if x != nil {
+		return x.FoundryId
+	}
+	return nil
+}
+
+func (x *FoundryByIDRequest) GetAsOfSlot() uint32 {
+	if x != nil && x.AsOfSlot != nil {
+		return *x.AsOfSlot
+	}
+	return 0
+}
+
+type DelegationByIDRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DelegationId []byte  `protobuf:"bytes,1,opt,name=delegation_id,json=delegationId,proto3" json:"delegation_id,omitempty"`
+	AsOfSlot     *uint32 `protobuf:"varint,2,opt,name=as_of_slot,json=asOfSlot,proto3,oneof" json:"as_of_slot,omitempty"`
+}
+
+func (x *DelegationByIDRequest) Reset() {
+	*x = DelegationByIDRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_indexer_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DelegationByIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DelegationByIDRequest) ProtoMessage() {}
+
+func (x *DelegationByIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_indexer_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DelegationByIDRequest.ProtoReflect.Descriptor instead.
+func (*DelegationByIDRequest) Descriptor() ([]byte, []int) {
+	return file_indexer_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *DelegationByIDRequest) GetDelegationId() []byte {
+	if x != nil {
+		return x.DelegationId
+	}
+	return nil
+}
+
+func (x *DelegationByIDRequest) GetAsOfSlot() uint32 {
+	if x != nil && x.AsOfSlot != nil {
+		return *x.AsOfSlot
+	}
+	return 0
+}
+
+var File_indexer_proto protoreflect.FileDescriptor
+
+var file_indexer_proto_rawDesc = []byte{
+	0x0a, 0x0d, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x07, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x22, 0x84, 0x01, 0x0a, 0x0a, 0x50, 0x61, 0x67,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f,
+	0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65,
+	0x53, 0x69, 0x7a, 0x65, 0x12, 0x1b, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x88, 0x01,
+	0x01, 0x12, 0x31, 0x0a, 0x0a, 0x73, 0x6f, 0x72, 0x74, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e,
+	0x53, 0x6f, 0x72, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x09, 0x73, 0x6f, 0x72, 0x74, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22,
+	0x9a, 0x01, 0x0a, 0x0d, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x5f, 0x73,
+	0x6c, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x74, 0x65, 0x64, 0x53, 0x6c, 0x6f, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65,
+	0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x70, 0x61, 0x67,
+	0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f,
+	0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x09, 0x6f, 0x75, 0x74, 0x70, 0x75,
+	0x74, 0x49, 0x64, 0x73, 0x12, 0x1b, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x88, 0x01,
+	0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0xc4, 0x0a, 0x0a,
+	0x0b, 0x42, 0x61, 0x73, 0x69, 0x63, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x33, 0x0a, 0x0a,
+	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x2d, 0x0a, 0x10, 0x68, 0x61, 0x73, 0x5f, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x0e, 0x68,
+	0x61, 0x73, 0x4e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x88, 0x01, 0x01,
+	0x12, 0x26, 0x0a, 0x0c, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x01, 0x52, 0x0b, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x37, 0x0a, 0x15, 0x75, 0x6e, 0x6c, 0x6f,
+	0x63, 0x6b, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x62, 0x79, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x13, 0x75, 0x6e, 0x6c, 0x6f, 0x63,
+	0x6b, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x88, 0x01,
+	0x01, 0x12, 0x1d, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x03, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x88, 0x01, 0x01,
+	0x12, 0x40, 0x0a, 0x1a, 0x68, 0x61, 0x73, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f,
+	0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x08, 0x48, 0x04, 0x52, 0x17, 0x68, 0x61, 0x73, 0x53, 0x74, 0x6f, 0x72, 0x61,
+	0x67, 0x65, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x88,
+	0x01, 0x01, 0x12, 0x48, 0x0a, 0x1e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x64, 0x65,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x48, 0x05, 0x52, 0x1b, 0x73, 0x74,
+	0x6f, 0x72, 0x61, 0x67, 0x65, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x74, 0x75,
+	0x72, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x88, 0x01, 0x01, 0x12, 0x2a, 0x0a, 0x0e,
+	0x68, 0x61, 0x73, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x08, 0x48, 0x06, 0x52, 0x0d, 0x68, 0x61, 0x73, 0x45, 0x78, 0x70, 0x69, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x2a, 0x0a, 0x0e, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x73, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d,
+	0x48, 0x07, 0x52, 0x0d, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x42, 0x65, 0x66, 0x6f, 0x72,
+	0x65, 0x88, 0x01, 0x01, 0x12, 0x28, 0x0a, 0x0d, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f,
+	0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x08, 0x52, 0x0c, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x66, 0x74, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x3f,
+	0x0a, 0x19, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x74,
+	0x75, 0x72, 0x6e, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x09, 0x52, 0x17, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x74, 0x75, 0x72, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x88, 0x01, 0x01, 0x12,
+	0x26, 0x0a, 0x0c, 0x68, 0x61, 0x73, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6c, 0x6f, 0x63, 0x6b, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x08, 0x48, 0x0a, 0x52, 0x0b, 0x68, 0x61, 0x73, 0x54, 0x69, 0x6d, 0x65,
+	0x6c, 0x6f, 0x63, 0x6b, 0x88, 0x01, 0x01, 0x12, 0x30, 0x0a, 0x11, 0x74, 0x69, 0x6d, 0x65, 0x6c,
+	0x6f, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x0d, 0x48, 0x0b, 0x52, 0x10, 0x74, 0x69, 0x6d, 0x65, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64,
+	0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x88, 0x01, 0x01, 0x12, 0x2e, 0x0a, 0x10, 0x74, 0x69, 0x6d,
+	0x65, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x0e, 0x20,
+	0x01, 0x28, 0x0d, 0x48, 0x0c, 0x52, 0x0f, 0x74, 0x69, 0x6d, 0x65, 0x6c, 0x6f, 0x63, 0x6b, 0x65,
+	0x64, 0x41, 0x66, 0x74, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x73, 0x65, 0x6e,
+	0x64, 0x65, 0x72, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x48, 0x0d, 0x52, 0x06, 0x73, 0x65, 0x6e,
+	0x64, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x15, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x10, 0x20,
+	0x01, 0x28, 0x0c, 0x48, 0x0e, 0x52, 0x03, 0x74, 0x61, 0x67, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a,
+	0x0a, 0x6d, 0x69, 0x6e, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28,
+	0x04, 0x48, 0x0f, 0x52, 0x09, 0x6d, 0x69, 0x6e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x88, 0x01,
+	0x01, 0x12, 0x22, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x12, 0x20, 0x01, 0x28, 0x04, 0x48, 0x10, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x41, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x2a, 0x0a, 0x0e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x11, 0x52,
+	0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x88, 0x01,
+	0x01, 0x12, 0x28, 0x0a, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x66, 0x74,
+	0x65, 0x72, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x12, 0x52, 0x0c, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x66, 0x74, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x0a, 0x61,
+	0x73, 0x5f, 0x6f, 0x66, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0d, 0x48,
+	0x13, 0x52, 0x08, 0x61, 0x73, 0x4f, 0x66, 0x53, 0x6c, 0x6f, 0x74, 0x88, 0x01, 0x01, 0x42, 0x13,
+	0x0a, 0x11, 0x5f, 0x68, 0x61, 0x73, 0x5f, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x18, 0x0a, 0x16, 0x5f, 0x75, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x61,
+	0x62, 0x6c, 0x65, 0x5f, 0x62, 0x79, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x42, 0x0a,
+	0x0a, 0x08, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x42, 0x1d, 0x0a, 0x1b, 0x5f, 0x68,
+	0x61, 0x73, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x5f, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x42, 0x21, 0x0a, 0x1f, 0x5f, 0x73, 0x74,
+	0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x72, 0x65,
+	0x74, 0x75, 0x72, 0x6e, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x42, 0x11, 0x0a, 0x0f,
+	0x5f, 0x68, 0x61, 0x73, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42,
+	0x11, 0x0a, 0x0f, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x62, 0x65, 0x66, 0x6f,
+	0x72, 0x65, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61,
+	0x66, 0x74, 0x65, 0x72, 0x42, 0x1c, 0x0a, 0x1a, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x68, 0x61, 0x73, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6c,
+	0x6f, 0x63, 0x6b, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6c, 0x6f, 0x63, 0x6b,
+	0x65, 0x64, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x42, 0x09,
+	0x0a, 0x07, 0x5f, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x74, 0x61,
+	0x67, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x42,
+	0x11, 0x0a, 0x0f, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x65, 0x66, 0x6f,
+	0x72, 0x65, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x66, 0x74, 0x65, 0x72, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x61, 0x73, 0x5f, 0x6f, 0x66, 0x5f, 0x73,
+	0x6c, 0x6f, 0x74, 0x22, 0x9c, 0x06, 0x0a, 0x0d, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x33, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a,
+	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x73, 0x65, 0x6e,
+	0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x06, 0x73, 0x65, 0x6e,
+	0x64, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72,
+	0x88, 0x01, 0x01, 0x12, 0x2b, 0x0a, 0x0f, 0x69, 0x73, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f,
+	0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x48, 0x03, 0x52, 0x0d,
+	0x69, 0x73, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x73, 0x73, 0x75, 0x65, 0x72, 0x88, 0x01, 0x01,
+	0x12, 0x42, 0x0a, 0x1b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72,
+	0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x04, 0x52, 0x18, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x73,
+	0x73, 0x75, 0x65, 0x72, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x42, 0x65, 0x66, 0x6f, 0x72,
+	0x65, 0x88, 0x01, 0x01, 0x12, 0x40, 0x0a, 0x1a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x69, 0x73,
+	0x73, 0x75, 0x65, 0x72, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x66, 0x74,
+	0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x05, 0x52, 0x17, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x49, 0x73, 0x73, 0x75, 0x65, 0x72, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x66,
+	0x74, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x2d, 0x0a, 0x10, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f,
+	0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c,
+	0x48, 0x06, 0x52, 0x0e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x73, 0x73, 0x75, 0x65, 0x72, 0x4b,
+	0x65, 0x79, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x6d, 0x69, 0x6e, 0x5f, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x48, 0x07, 0x52, 0x09, 0x6d, 0x69, 0x6e,
+	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x6d, 0x61, 0x78,
+	0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x48, 0x08, 0x52,
+	0x09, 0x6d, 0x61, 0x78, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x2a, 0x0a,
+	0x0e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x09, 0x52, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x88, 0x01, 0x01, 0x12, 0x28, 0x0a, 0x0d, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0d,
+	0x48, 0x0a, 0x52, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x66, 0x74, 0x65, 0x72,
+	0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x0a, 0x61, 0x73, 0x5f, 0x6f, 0x66, 0x5f, 0x73, 0x6c, 0x6f,
+	0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x0b, 0x52, 0x08, 0x61, 0x73, 0x4f, 0x66, 0x53,
+	0x6c, 0x6f, 0x74, 0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x42, 0x09, 0x0a,
+	0x07, 0x5f, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x69, 0x73, 0x5f,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x42, 0x1e, 0x0a, 0x1c,
+	0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x5f, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x42, 0x1d, 0x0a, 0x1b,
+	0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x5f, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x42, 0x13, 0x0a, 0x11, 0x5f,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x5f, 0x6b, 0x65, 0x79,
+	0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x42,
+	0x0d, 0x0a, 0x0b, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x11,
+	0x0a, 0x0f, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72,
+	0x65, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x66,
+	0x74, 0x65, 0x72, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x61, 0x73, 0x5f, 0x6f, 0x66, 0x5f, 0x73, 0x6c,
+	0x6f, 0x74, 0x22, 0xec, 0x04, 0x0a, 0x0c, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x46, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x12, 0x33, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65,
+	0x72, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61,
+	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x37, 0x0a, 0x15, 0x75, 0x6e, 0x6c, 0x6f,
+	0x63, 0x6b, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x62, 0x79, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x13, 0x75, 0x6e, 0x6c, 0x6f, 0x63,
+	0x6b, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x88, 0x01,
+	0x01, 0x12, 0x2e, 0x0a, 0x10, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72,
+	0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0f, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x88, 0x01,
+	0x01, 0x12, 0x1f, 0x0a, 0x08, 0x67, 0x6f, 0x76, 0x65, 0x72, 0x6e, 0x6f, 0x72, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x08, 0x67, 0x6f, 0x76, 0x65, 0x72, 0x6e, 0x6f, 0x72, 0x88,
+	0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x03, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12,
+	0x1b, 0x0a, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x04, 0x52, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a,
+	0x6d, 0x69, 0x6e, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04,
+	0x48, 0x05, 0x52, 0x09, 0x6d, 0x69, 0x6e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x88, 0x01, 0x01,
+	0x12, 0x22, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x04, 0x48, 0x06, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x41, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x88, 0x01, 0x01, 0x12, 0x2a, 0x0a, 0x0e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x07, 0x52, 0x0d,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x88, 0x01, 0x01,
+	0x12, 0x28, 0x0a, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x66, 0x74, 0x65,
+	0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x08, 0x52, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x66, 0x74, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x0a, 0x61, 0x73,
+	0x5f, 0x6f, 0x66, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x09,
+	0x52, 0x08, 0x61, 0x73, 0x4f, 0x66, 0x53, 0x6c, 0x6f, 0x74, 0x88, 0x01, 0x01, 0x42, 0x18, 0x0a,
+	0x16, 0x5f, 0x75, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x62, 0x79, 0x5f,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x42, 0x0b, 0x0a, 0x09,
+	0x5f, 0x67, 0x6f, 0x76, 0x65, 0x72, 0x6e, 0x6f, 0x72, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x73, 0x65,
+	0x6e, 0x64, 0x65, 0x72, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x42,
+	0x0d, 0x0a, 0x0b, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x0d,
+	0x0a, 0x0b, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x11, 0x0a,
+	0x0f, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65,
+	0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x66, 0x74,
+	0x65, 0x72, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x61, 0x73, 0x5f, 0x6f, 0x66, 0x5f, 0x73, 0x6c, 0x6f,
+	0x74, 0x22, 0xcc, 0x0b, 0x0a, 0x09, 0x4e, 0x46, 0x54, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12,
+	0x33, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x50, 0x61,
+	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x37, 0x0a, 0x15, 0x75, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x61, 0x62,
+	0x6c, 0x65, 0x5f, 0x62, 0x79, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x13, 0x75, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x61, 0x62, 0x6c,
+	0x65, 0x42, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01,
+	0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x88, 0x01, 0x01, 0x12, 0x40, 0x0a, 0x1a,
+	0x68, 0x61, 0x73, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x64, 0x65, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x5f, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
+	0x48, 0x02, 0x52, 0x17, 0x68, 0x61, 0x73, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x44, 0x65,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x48,
+	0x0a, 0x1e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x5f, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x1b, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x65, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x88, 0x01, 0x01, 0x12, 0x2a, 0x0a, 0x0e, 0x68, 0x61, 0x73, 0x5f,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08,
+	0x48, 0x04, 0x52, 0x0d, 0x68, 0x61, 0x73, 0x45, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x88, 0x01, 0x01, 0x12, 0x2a, 0x0a, 0x0e, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f,
+	0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x05, 0x52, 0x0d,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x88, 0x01, 0x01,
+	0x12, 0x28, 0x0a, 0x0d, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x66, 0x74, 0x65,
+	0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x06, 0x52, 0x0c, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x65, 0x73, 0x41, 0x66, 0x74, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x3f, 0x0a, 0x19, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x48, 0x07, 0x52,
+	0x17, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x74, 0x75, 0x72,
+	0x6e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x88, 0x01, 0x01, 0x12, 0x26, 0x0a, 0x0c, 0x68,
+	0x61, 0x73, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x08, 0x48, 0x08, 0x52, 0x0b, 0x68, 0x61, 0x73, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x6f, 0x63, 0x6b,
+	0x88, 0x01, 0x01, 0x12, 0x30, 0x0a, 0x11, 0x74, 0x69, 0x6d, 0x65, 0x6c, 0x6f, 0x63, 0x6b, 0x65,
+	0x64, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x09,
+	0x52, 0x10, 0x74, 0x69, 0x6d, 0x65, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x42, 0x65, 0x66, 0x6f,
+	0x72, 0x65, 0x88, 0x01, 0x01, 0x12, 0x2e, 0x0a, 0x10, 0x74, 0x69, 0x6d, 0x65, 0x6c, 0x6f, 0x63,
+	0x6b, 0x65, 0x64, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0d, 0x48,
+	0x0a, 0x52, 0x0f, 0x74, 0x69, 0x6d, 0x65, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x41, 0x66, 0x74,
+	0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x18,
+	0x0d, 0x20, 0x01, 0x28, 0x09, 0x48, 0x0b, 0x52, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x88,
+	0x01, 0x01, 0x12, 0x23, 0x0a, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x0e, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x0c, 0x52, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x2c, 0x0a, 0x0f, 0x63, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x0d, 0x52, 0x0e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61,
+	0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x48, 0x0e, 0x52, 0x09, 0x6d, 0x65, 0x64,
+	0x69, 0x61, 0x54, 0x79, 0x70, 0x65, 0x88, 0x01, 0x01, 0x12, 0x24, 0x0a, 0x0b, 0x6e, 0x61, 0x6d,
+	0x65, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x48, 0x0f,
+	0x52, 0x0a, 0x6e, 0x61, 0x6d, 0x65, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x88, 0x01, 0x01, 0x12,
+	0x1b, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x10, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x15, 0x0a, 0x03,
+	0x74, 0x61, 0x67, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x11, 0x52, 0x03, 0x74, 0x61, 0x67,
+	0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x6d, 0x69, 0x6e, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x14, 0x20, 0x01, 0x28, 0x04, 0x48, 0x12, 0x52, 0x09, 0x6d, 0x69, 0x6e, 0x41, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x15, 0x20, 0x01, 0x28, 0x04, 0x48, 0x13, 0x52, 0x09, 0x6d,
+	0x61, 0x78, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x2a, 0x0a, 0x0e, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x16, 0x20,
+	0x01, 0x28, 0x0d, 0x48, 0x14, 0x52, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x65,
+	0x66, 0x6f, 0x72, 0x65, 0x88, 0x01, 0x01, 0x12, 0x28, 0x0a, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x15,
+	0x52, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x66, 0x74, 0x65, 0x72, 0x88, 0x01,
+	0x01, 0x12, 0x21, 0x0a, 0x0a, 0x61, 0x73, 0x5f, 0x6f, 0x66, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x18,
+	0x18, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x16, 0x52, 0x08, 0x61, 0x73, 0x4f, 0x66, 0x53, 0x6c, 0x6f,
+	0x74, 0x88, 0x01, 0x01, 0x42, 0x18, 0x0a, 0x16, 0x5f, 0x75, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x61,
+	0x62, 0x6c, 0x65, 0x5f, 0x62, 0x79, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x42, 0x0a,
+	0x0a, 0x08, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x42, 0x1d, 0x0a, 0x1b, 0x5f, 0x68,
+	0x61, 0x73, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x5f, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x42, 0x21, 0x0a, 0x1f, 0x5f, 0x73, 0x74,
+	0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x72, 0x65,
+	0x74, 0x75, 0x72, 0x6e, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x42, 0x11, 0x0a, 0x0f,
+	0x5f, 0x68, 0x61, 0x73, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42,
+	0x11, 0x0a, 0x0f, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x62, 0x65, 0x66, 0x6f,
+	0x72, 0x65, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61,
+	0x66, 0x74, 0x65, 0x72, 0x42, 0x1c, 0x0a, 0x1a, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x68, 0x61, 0x73, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6c,
+	0x6f, 0x63, 0x6b, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6c, 0x6f, 0x63, 0x6b,
+	0x65, 0x64, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x42, 0x09,
+	0x0a, 0x07, 0x5f, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x63, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x63, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x0d, 0x0a, 0x0b,
+	0x5f, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x42, 0x0e, 0x0a, 0x0c, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x42, 0x09, 0x0a, 0x07, 0x5f,
+	0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x74, 0x61, 0x67, 0x42, 0x0d,
+	0x0a, 0x0b, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x0d, 0x0a,
+	0x0b, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x11, 0x0a, 0x0f,
+	0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x42,
+	0x10, 0x0a, 0x0e, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x66, 0x74, 0x65,
+	0x72, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x61, 0x73, 0x5f, 0x6f, 0x66, 0x5f, 0x73, 0x6c, 0x6f, 0x74,
+	0x22, 0x82, 0x05, 0x0a, 0x0d, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x72, 0x79, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x12, 0x33, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72,
+	0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x10, 0x68, 0x61, 0x73, 0x5f, 0x6e,
+	0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x48, 0x00, 0x52, 0x0e, 0x68, 0x61, 0x73, 0x4e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x26, 0x0a, 0x0c, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65,
+	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x01, 0x52, 0x0b,
+	0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x1d,
+	0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x02, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x28, 0x0a,
+	0x0d, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0d, 0x48, 0x03, 0x52, 0x0c, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x4e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x2f, 0x0a, 0x11, 0x68, 0x61, 0x73, 0x5f, 0x6d,
+	0x69, 0x6e, 0x74, 0x5f, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x08, 0x48, 0x04, 0x52, 0x0f, 0x68, 0x61, 0x73, 0x4d, 0x69, 0x6e, 0x74, 0x43, 0x61, 0x70,
+	0x61, 0x63, 0x69, 0x74, 0x79, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x6d, 0x69, 0x6e, 0x5f,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x48, 0x05, 0x52, 0x09,
+	0x6d, 0x69, 0x6e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a,
+	0x6d, 0x61, 0x78, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04,
+	0x48, 0x06, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x88, 0x01, 0x01,
+	0x12, 0x2a, 0x0a, 0x0e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x65, 0x66, 0x6f,
+	0x72, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x07, 0x52, 0x0d, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x88, 0x01, 0x01, 0x12, 0x28, 0x0a, 0x0d,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x0d, 0x48, 0x08, 0x52, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x66,
+	0x74, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x0a, 0x61, 0x73, 0x5f, 0x6f, 0x66, 0x5f,
+	0x73, 0x6c, 0x6f, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x09, 0x52, 0x08, 0x61, 0x73,
+	0x4f, 0x66, 0x53, 0x6c, 0x6f, 0x74, 0x88, 0x01, 0x01, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x68, 0x61,
+	0x73, 0x5f, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x0f,
+	0x0a, 0x0d, 0x5f, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x42,
+	0x0a, 0x0a, 0x08, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x10, 0x0a, 0x0e, 0x5f,
+	0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x42, 0x14, 0x0a,
+	0x12, 0x5f, 0x68, 0x61, 0x73, 0x5f, 0x6d, 0x69, 0x6e, 0x74, 0x5f, 0x63, 0x61, 0x70, 0x61, 0x63,
+	0x69, 0x74, 0x79, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x65,
+	0x66, 0x6f, 0x72, 0x65, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x61, 0x73, 0x5f, 0x6f, 0x66,
+	0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x22, 0xa2, 0x06, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x33, 0x0a, 0x0a, 0x70, 0x61,
+	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x1d, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x00, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x88, 0x01, 0x01, 0x12, 0x21,
+	0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x01, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x88, 0x01,
+	0x01, 0x12, 0x35, 0x0a, 0x14, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x48,
+	0x02, 0x52, 0x12, 0x6d, 0x69, 0x6e, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x35, 0x0a, 0x14, 0x6d, 0x61, 0x78, 0x5f,
+	0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x48, 0x03, 0x52, 0x12, 0x6d, 0x61, 0x78, 0x44, 0x65, 0x6c,
+	0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12,
+	0x31, 0x0a, 0x12, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x62,
+	0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x04, 0x52, 0x10, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x88,
+	0x01, 0x01, 0x12, 0x2f, 0x0a, 0x11, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x65, 0x70, 0x6f, 0x63,
+	0x68, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x05, 0x52,
+	0x0f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x41, 0x66, 0x74, 0x65, 0x72,
+	0x88, 0x01, 0x01, 0x12, 0x27, 0x0a, 0x0d, 0x68, 0x61, 0x73, 0x5f, 0x65, 0x6e, 0x64, 0x5f, 0x65,
+	0x70, 0x6f, 0x63, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x48, 0x06, 0x52, 0x0b, 0x68, 0x61,
+	0x73, 0x45, 0x6e, 0x64, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a,
+	0x6d, 0x69, 0x6e, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04,
+	0x48, 0x07, 0x52, 0x09, 0x6d, 0x69, 0x6e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x88, 0x01, 0x01,
+	0x12, 0x22, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x04, 0x48, 0x08, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x41, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x88, 0x01, 0x01, 0x12, 0x2a, 0x0a, 0x0e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x09, 0x52, 0x0d,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x88, 0x01, 0x01,
+	0x12, 0x28, 0x0a, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x66, 0x74, 0x65,
+	0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x0a, 0x52, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x66, 0x74, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x0a, 0x61, 0x73,
+	0x5f, 0x6f, 0x66, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x0b,
+	0x52, 0x08, 0x61, 0x73, 0x4f, 0x66, 0x53, 0x6c, 0x6f, 0x74, 0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a,
+	0x08, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x42, 0x17, 0x0a, 0x15, 0x5f, 0x6d, 0x69, 0x6e, 0x5f,
+	0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x42, 0x17, 0x0a, 0x15, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x15, 0x0a, 0x13, 0x5f, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65,
+	0x42, 0x14, 0x0a, 0x12, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68,
+	0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x68, 0x61, 0x73, 0x5f, 0x65,
+	0x6e, 0x64, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x6d, 0x69, 0x6e,
+	0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x6d, 0x61, 0x78, 0x5f,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x42, 0x0d, 0x0a, 0x0b, 0x5f,
+	0x61, 0x73, 0x5f, 0x6f, 0x66, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x22, 0xe3, 0x04, 0x0a, 0x0e, 0x43,
+	0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x33, 0x0a,
+	0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x67, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x10, 0x68, 0x61, 0x73, 0x5f, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65,
+	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x0e,
+	0x68, 0x61, 0x73, 0x4e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x88, 0x01,
+	0x01, 0x12, 0x26, 0x0a, 0x0c, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x01, 0x52, 0x0b, 0x6e, 0x61, 0x74, 0x69, 0x76,
+	0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x37, 0x0a, 0x15, 0x75, 0x6e, 0x6c,
+	0x6f, 0x63, 0x6b, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x62, 0x79, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x13, 0x75, 0x6e, 0x6c, 0x6f,
+	0x63, 0x6b, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x88,
+	0x01, 0x01, 0x12, 0x39, 0x0a, 0x19, 0x75, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x61, 0x62, 0x6c, 0x65,
+	0x5f, 0x62, 0x79, 0x5f, 0x61, 0x6e, 0x79, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x16, 0x75, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x61, 0x62, 0x6c,
+	0x65, 0x42, 0x79, 0x41, 0x6e, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x22, 0x0a,
+	0x0a, 0x6d, 0x69, 0x6e, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x04, 0x48, 0x03, 0x52, 0x09, 0x6d, 0x69, 0x6e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x88, 0x01,
+	0x01, 0x12, 0x22, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x04, 0x48, 0x04, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x41, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x2a, 0x0a, 0x0e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x05, 0x52,
+	0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x88, 0x01,
+	0x01, 0x12, 0x28, 0x0a, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x66, 0x74,
+	0x65, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x06, 0x52, 0x0c, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x66, 0x74, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x0a, 0x61,
+	0x73, 0x5f, 0x6f, 0x66, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x48,
+	0x07, 0x52, 0x08, 0x61, 0x73, 0x4f, 0x66, 0x53, 0x6c, 0x6f, 0x74, 0x88, 0x01, 0x01, 0x42, 0x13,
+	0x0a, 0x11, 0x5f, 0x68, 0x61, 0x73, 0x5f, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x18, 0x0a, 0x16, 0x5f, 0x75, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x61,
+	0x62, 0x6c, 0x65, 0x5f, 0x62, 0x79, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x42, 0x0d,
+	0x0a, 0x0b, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x0d, 0x0a,
+	0x0b, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x11, 0x0a, 0x0f,
+	0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x42,
+	0x10, 0x0a, 0x0e, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x66, 0x74, 0x65,
+	0x72, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x61, 0x73, 0x5f, 0x6f, 0x66, 0x5f, 0x73, 0x6c, 0x6f, 0x74,
+	0x22, 0x65, 0x0a, 0x12, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x79, 0x49, 0x44, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0a, 0x61, 0x73, 0x5f, 0x6f, 0x66, 0x5f, 0x73,
+	0x6c, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x08, 0x61, 0x73, 0x4f,
+	0x66, 0x53, 0x6c, 0x6f, 0x74, 0x88, 0x01, 0x01, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x61, 0x73, 0x5f,
+	0x6f, 0x66, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x22, 0x62, 0x0a, 0x11, 0x41, 0x6e, 0x63, 0x68, 0x6f,
+	0x72, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09,
+	0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x08, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0a, 0x61, 0x73, 0x5f,
+	0x6f, 0x66, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52,
+	0x08, 0x61, 0x73, 0x4f, 0x66, 0x53, 0x6c, 0x6f, 0x74, 0x88, 0x01, 0x01, 0x42, 0x0d, 0x0a, 0x0b,
+	0x5f, 0x61, 0x73, 0x5f, 0x6f, 0x66, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x22, 0x59, 0x0a, 0x0e, 0x4e,
+	0x46, 0x54, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x15, 0x0a,
+	0x06, 0x6e, 0x66, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x6e,
+	0x66, 0x74, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0a, 0x61, 0x73, 0x5f, 0x6f, 0x66, 0x5f, 0x73, 0x6c,
+	0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x08, 0x61, 0x73, 0x4f, 0x66,
+	0x53, 0x6c, 0x6f, 0x74, 0x88, 0x01, 0x01, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x61, 0x73, 0x5f, 0x6f,
+	0x66, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x22, 0x65, 0x0a, 0x12, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x72,
+	0x79, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
+	0x66, 0x6f, 0x75, 0x6e, 0x64, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x09, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x72, 0x79, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0a, 0x61,
+	0x73, 0x5f, 0x6f, 0x66, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x48,
+	0x00, 0x52, 0x08, 0x61, 0x73, 0x4f, 0x66, 0x53, 0x6c, 0x6f, 0x74, 0x88, 0x01, 0x01, 0x42, 0x0d,
+	0x0a, 0x0b, 0x5f, 0x61, 0x73, 0x5f, 0x6f, 0x66, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x22, 0x6e, 0x0a,
+	0x15, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x79, 0x49, 0x44, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x64,
+	0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0a, 0x61,
+	0x73, 0x5f, 0x6f, 0x66, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x48,
+	0x00, 0x52, 0x08, 0x61, 0x73, 0x4f, 0x66, 0x53, 0x6c, 0x6f, 0x74, 0x88, 0x01, 0x01, 0x42, 0x0d,
+	0x0a, 0x0b, 0x5f, 0x61, 0x73, 0x5f, 0x6f, 0x66, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x2a, 0x7b, 0x0a,
+	0x09, 0x53, 0x6f, 0x72, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x16, 0x53, 0x4f,
+	0x52, 0x54, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x44,
+	0x5f, 0x41, 0x53, 0x43, 0x10, 0x00, 0x12, 0x1b, 0x0a, 0x17, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x4f,
+	0x52, 0x44, 0x45, 0x52, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x44, 0x5f, 0x44, 0x45, 0x53,
+	0x43, 0x10, 0x01, 0x12, 0x19, 0x0a, 0x15, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x4f, 0x52, 0x44, 0x45,
+	0x52, 0x5f, 0x41, 0x4d, 0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x41, 0x53, 0x43, 0x10, 0x02, 0x12, 0x1a,
+	0x0a, 0x16, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x41, 0x4d, 0x4f,
+	0x55, 0x4e, 0x54, 0x5f, 0x44, 0x45, 0x53, 0x43, 0x10, 0x03, 0x32, 0xbf, 0x09, 0x0a, 0x07, 0x49,
+	0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x12, 0x35, 0x0a, 0x05, 0x42, 0x61, 0x73, 0x69, 0x63, 0x12,
+	0x14, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x42, 0x61, 0x73, 0x69, 0x63, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x1a, 0x16, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e,
+	0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x39, 0x0a,
+	0x07, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x65, 0x72, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x1a, 0x16, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x49, 0x6e, 0x64, 0x65, 0x78,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x37, 0x0a, 0x06, 0x41, 0x6e, 0x63, 0x68,
+	0x6f, 0x72, 0x12, 0x15, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x41, 0x6e, 0x63,
+	0x68, 0x6f, 0x72, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x1a, 0x16, 0x2e, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x65, 0x72, 0x2e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x12, 0x31, 0x0a, 0x03, 0x4e, 0x46, 0x54, 0x12, 0x12, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x65, 0x72, 0x2e, 0x4e, 0x46, 0x54, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x1a, 0x16, 0x2e, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x12, 0x39, 0x0a, 0x07, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x72, 0x79, 0x12,
+	0x16, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x72,
+	0x79, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x1a, 0x16, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65,
+	0x72, 0x2e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12,
+	0x3f, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x2e,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x1a, 0x16, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x65, 0x72, 0x2e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x12, 0x3b, 0x0a, 0x08, 0x43, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x65, 0x64, 0x12, 0x17, 0x2e, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x65, 0x64, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x1a, 0x16, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e,
+	0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x42, 0x0a,
+	0x0b, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x79, 0x49, 0x44, 0x12, 0x1b, 0x2e, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x79,
+	0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x65, 0x72, 0x2e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x12, 0x40, 0x0a, 0x0a, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x42, 0x79, 0x49, 0x44, 0x12,
+	0x1a, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72,
+	0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x12, 0x3a, 0x0a, 0x07, 0x4e, 0x46, 0x54, 0x42, 0x79, 0x49, 0x44, 0x12, 0x17,
+	0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x4e, 0x46, 0x54, 0x42, 0x79, 0x49, 0x44,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65,
+	0x72, 0x2e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12,
+	0x42, 0x0a, 0x0b, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x72, 0x79, 0x42, 0x79, 0x49, 0x44, 0x12, 0x1b,
+	0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x72, 0x79,
+	0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x12, 0x48, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x42, 0x79, 0x49, 0x44, 0x12, 0x1e, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e,
+	0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e,
+	0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x3d, 0x0a,
+	0x0b, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x42, 0x61, 0x73, 0x69, 0x63, 0x12, 0x14, 0x2e, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x42, 0x61, 0x73, 0x69, 0x63, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x1a, 0x16, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x49, 0x6e, 0x64,
+	0x65, 0x78, 0x65, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x30, 0x01, 0x12, 0x41, 0x0a, 0x0d,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x2e,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x1a, 0x16, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e,
+	0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x30, 0x01, 0x12,
+	0x3f, 0x0a, 0x0c, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x12,
+	0x15, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72,
+	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x1a, 0x16, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72,
+	0x2e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x30, 0x01,
+	0x12, 0x39, 0x0a, 0x09, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4e, 0x46, 0x54, 0x12, 0x12, 0x2e,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x4e, 0x46, 0x54, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x1a, 0x16, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x49, 0x6e, 0x64, 0x65,
+	0x78, 0x65, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x30, 0x01, 0x12, 0x41, 0x0a, 0x0d, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x72, 0x79, 0x12, 0x16, 0x2e, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x72, 0x79, 0x46, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x1a, 0x16, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x49,
+	0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x30, 0x01, 0x12, 0x47,
+	0x0a, 0x10, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x19, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x1a, 0x16, 0x2e,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x30, 0x01, 0x12, 0x43, 0x0a, 0x0e, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x43, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x65, 0x64, 0x12, 0x17, 0x2e, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x1a, 0x16, 0x2e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x49, 0x6e, 0x64,
+	0x65, 0x78, 0x65, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x30, 0x01, 0x42, 0x36, 0x5a, 0x34,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x69, 0x6f, 0x74, 0x61, 0x6c,
+	0x65, 0x64, 0x67, 0x65, 0x72, 0x2f, 0x69, 0x6e, 0x78, 0x2d, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65,
+	0x72, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x65, 0x72, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_indexer_proto_rawDescOnce sync.Once
+	file_indexer_proto_rawDescData = file_indexer_proto_rawDesc
+)
+
+func file_indexer_proto_rawDescGZIP() []byte {
+	file_indexer_proto_rawDescOnce.Do(func() {
+		file_indexer_proto_rawDescData = protoimpl.X.CompressGZIP(file_indexer_proto_rawDescData)
+	})
+	return file_indexer_proto_rawDescData
+}
+
+var file_indexer_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_indexer_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_indexer_proto_goTypes = []interface{}{
+	(SortOrder)(0),                // 0: indexer.SortOrder
+	(*Pagination)(nil),            // 1: indexer.Pagination
+	(*IndexerResult)(nil),         // 2: indexer.IndexerResult
+	(*BasicFilter)(nil),           // 3: indexer.BasicFilter
+	(*AccountFilter)(nil),         // 4: indexer.AccountFilter
+	(*AnchorFilter)(nil),          // 5: indexer.AnchorFilter
+	(*NFTFilter)(nil),             // 6: indexer.NFTFilter
+	(*FoundryFilter)(nil),         // 7: indexer.FoundryFilter
+	(*DelegationFilter)(nil),      // 8: indexer.DelegationFilter
+	(*CombinedFilter)(nil),        // 9: indexer.CombinedFilter
+	(*AccountByIDRequest)(nil),    // 10: indexer.AccountByIDRequest
+	(*AnchorByIDRequest)(nil),     // 11: indexer.AnchorByIDRequest
+	(*NFTByIDRequest)(nil),        // 12: indexer.NFTByIDRequest
+	(*FoundryByIDRequest)(nil),    // 13: indexer.FoundryByIDRequest
+	(*DelegationByIDRequest)(nil), // 14: indexer.DelegationByIDRequest
+}
+var file_indexer_proto_depIdxs = []int32{
+	0,  // 0: indexer.Pagination.sort_order:type_name -> indexer.SortOrder
+	1,  // 1: indexer.BasicFilter.pagination:type_name -> indexer.Pagination
+	1,  // 2: indexer.AccountFilter.pagination:type_name -> indexer.Pagination
+	1,  // 3: indexer.AnchorFilter.pagination:type_name -> indexer.Pagination
+	1,  // 4: indexer.NFTFilter.pagination:type_name -> indexer.Pagination
+	1,  // 5: indexer.FoundryFilter.pagination:type_name -> indexer.Pagination
+	1,  // 6: indexer.DelegationFilter.pagination:type_name -> indexer.Pagination
+	1,  // 7: indexer.CombinedFilter.pagination:type_name -> indexer.Pagination
+	3,  // 8: indexer.Indexer.Basic:input_type -> indexer.BasicFilter
+	4,  // 9: indexer.Indexer.Account:input_type -> indexer.AccountFilter
+	5,  // 10: indexer.Indexer.Anchor:input_type -> indexer.AnchorFilter
+	6,  // 11: indexer.Indexer.NFT:input_type -> indexer.NFTFilter
+	7,  // 12: indexer.Indexer.Foundry:input_type -> indexer.FoundryFilter
+	8,  // 13: indexer.Indexer.Delegation:input_type -> indexer.DelegationFilter
+	9,  // 14: indexer.Indexer.Combined:input_type -> indexer.CombinedFilter
+	10, // 15: indexer.Indexer.AccountByID:input_type -> indexer.AccountByIDRequest
+	11, // 16: indexer.Indexer.AnchorByID:input_type -> indexer.AnchorByIDRequest
+	12, // 17: indexer.Indexer.NFTByID:input_type -> indexer.NFTByIDRequest
+	13, // 18: indexer.Indexer.FoundryByID:input_type -> indexer.FoundryByIDRequest
+	14, // 19: indexer.Indexer.DelegationByID:input_type -> indexer.DelegationByIDRequest
+	3,  // 20: indexer.Indexer.StreamBasic:input_type -> indexer.BasicFilter
+	4,  // 21: indexer.Indexer.StreamAccount:input_type -> indexer.AccountFilter
+	5,  // 22: indexer.Indexer.StreamAnchor:input_type -> indexer.AnchorFilter
+	6,  // 23: indexer.Indexer.StreamNFT:input_type -> indexer.NFTFilter
+	7,  // 24: indexer.Indexer.StreamFoundry:input_type -> indexer.FoundryFilter
+	8,  // 25: indexer.Indexer.StreamDelegation:input_type -> indexer.DelegationFilter
+	9,  // 26: indexer.Indexer.StreamCombined:input_type -> indexer.CombinedFilter
+	2,  // 27: indexer.Indexer.Basic:output_type -> indexer.IndexerResult
+	2,  // 28: indexer.Indexer.Account:output_type -> indexer.IndexerResult
+	2,  // 29: indexer.Indexer.Anchor:output_type -> indexer.IndexerResult
+	2,  // 30: indexer.Indexer.NFT:output_type -> indexer.IndexerResult
+	2,  // 31: indexer.Indexer.Foundry:output_type -> indexer.IndexerResult
+	2,  // 32: indexer.Indexer.Delegation:output_type -> indexer.IndexerResult
+	2,  // 33: indexer.Indexer.Combined:output_type -> indexer.IndexerResult
+	2,  // 34: indexer.Indexer.AccountByID:output_type -> indexer.IndexerResult
+	2,  // 35: indexer.Indexer.AnchorByID:output_type -> indexer.IndexerResult
+	2,  // 36: indexer.Indexer.NFTByID:output_type -> indexer.IndexerResult
+	2,  // 37: indexer.Indexer.FoundryByID:output_type -> indexer.IndexerResult
+	2,  // 38: indexer.Indexer.DelegationByID:output_type -> indexer.IndexerResult
+	2,  // 39: indexer.Indexer.StreamBasic:output_type -> indexer.IndexerResult
+	2,  // 40: indexer.Indexer.StreamAccount:output_type -> indexer.IndexerResult
+	2,  // 41: indexer.Indexer.StreamAnchor:output_type -> indexer.IndexerResult
+	2,  // 42: indexer.Indexer.StreamNFT:output_type -> indexer.IndexerResult
+	2,  // 43: indexer.Indexer.StreamFoundry:output_type -> indexer.IndexerResult
+	2,  // 44: indexer.Indexer.StreamDelegation:output_type -> indexer.IndexerResult
+	2,  // 45: indexer.Indexer.StreamCombined:output_type -> indexer.IndexerResult
+	27, // [27:46] is the sub-list for method output_type
+	8,  // [8:27] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_indexer_proto_init() }
+func file_indexer_proto_init() {
+	if File_indexer_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_indexer_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pagination); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IndexerResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BasicFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccountFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AnchorFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NFTFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FoundryFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DelegationFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CombinedFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccountByIDRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AnchorByIDRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NFTByIDRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FoundryByIDRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_indexer_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DelegationByIDRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_indexer_proto_msgTypes[0].OneofWrappers = []interface{}{}
+	file_indexer_proto_msgTypes[1].OneofWrappers = []interface{}{}
+	file_indexer_proto_msgTypes[2].OneofWrappers = []interface{}{}
+	file_indexer_proto_msgTypes[3].OneofWrappers = []interface{}{}
+	file_indexer_proto_msgTypes[4].OneofWrappers = []interface{}{}
+	file_indexer_proto_msgTypes[5].OneofWrappers = []interface{}{}
+	file_indexer_proto_msgTypes[6].OneofWrappers = []interface{}{}
+	file_indexer_proto_msgTypes[7].OneofWrappers = []interface{}{}
+	file_indexer_proto_msgTypes[8].OneofWrappers = []interface{}{}
+	file_indexer_proto_msgTypes[9].OneofWrappers = []interface{}{}
+	file_indexer_proto_msgTypes[10].OneofWrappers = []interface{}{}
+	file_indexer_proto_msgTypes[11].OneofWrappers = []interface{}{}
+	file_indexer_proto_msgTypes[12].OneofWrappers = []interface{}{}
+	file_indexer_proto_msgTypes[13].OneofWrappers = []interface{}{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_indexer_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   14,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_indexer_proto_goTypes,
+		DependencyIndexes: file_indexer_proto_depIdxs,
+		EnumInfos:         file_indexer_proto_enumTypes,
+		MessageInfos:      file_indexer_proto_msgTypes,
+	}.Build()
+	File_indexer_proto = out.File
+	file_indexer_proto_rawDesc = nil
+	file_indexer_proto_goTypes = nil
+	file_indexer_proto_depIdxs = nil
+}
@@ -0,0 +1,993 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: indexer.proto
+
+package indexerpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Indexer_Basic_FullMethodName            = "/indexer.Indexer/Basic"
+	Indexer_Account_FullMethodName          = "/indexer.Indexer/Account"
+	Indexer_Anchor_FullMethodName           = "/indexer.Indexer/Anchor"
+	Indexer_NFT_FullMethodName              = "/indexer.Indexer/NFT"
+	Indexer_Foundry_FullMethodName          = "/indexer.Indexer/Foundry"
+	Indexer_Delegation_FullMethodName       = "/indexer.Indexer/Delegation"
+	Indexer_Combined_FullMethodName         = "/indexer.Indexer/Combined"
+	Indexer_AccountByID_FullMethodName      = "/indexer.Indexer/AccountByID"
+	Indexer_AnchorByID_FullMethodName       = "/indexer.Indexer/AnchorByID"
+	Indexer_NFTByID_FullMethodName          = "/indexer.Indexer/NFTByID"
+	Indexer_FoundryByID_FullMethodName      = "/indexer.Indexer/FoundryByID"
+	Indexer_DelegationByID_FullMethodName   = "/indexer.Indexer/DelegationByID"
+	Indexer_StreamBasic_FullMethodName      = "/indexer.Indexer/StreamBasic"
+	Indexer_StreamAccount_FullMethodName    = "/indexer.Indexer/StreamAccount"
+	Indexer_StreamAnchor_FullMethodName     = "/indexer.Indexer/StreamAnchor"
+	Indexer_StreamNFT_FullMethodName        = "/indexer.Indexer/StreamNFT"
+	Indexer_StreamFoundry_FullMethodName    = "/indexer.Indexer/StreamFoundry"
+	Indexer_StreamDelegation_FullMethodName = "/indexer.Indexer/StreamDelegation"
+	Indexer_StreamCombined_FullMethodName   = "/indexer.Indexer/StreamCombined"
+)
+
+// IndexerClient is the client API for Indexer service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type IndexerClient interface {
+	// Basic returns a page of the basic outputs matching the filter.
+	Basic(ctx context.Context, in *BasicFilter, opts ...grpc.CallOption) (*IndexerResult, error)
+	// Account returns a page of the account outputs matching the filter.
+	Account(ctx context.Context, in *AccountFilter, opts ...grpc.CallOption) (*IndexerResult, error)
+	// Anchor returns a page of the anchor outputs matching the filter.
+	Anchor(ctx context.Context, in *AnchorFilter, opts ...grpc.CallOption) (*IndexerResult, error)
+	// NFT returns a page of the NFT outputs matching the filter.
+	NFT(ctx context.Context, in *NFTFilter, opts ...grpc.CallOption) (*IndexerResult, error)
+	// Foundry returns a page of the foundry outputs matching the filter.
+	Foundry(ctx context.Context, in *FoundryFilter, opts ...grpc.CallOption) (*IndexerResult, error)
+	// Delegation returns a page of the delegation outputs matching the filter.
+	Delegation(ctx context.Context, in *DelegationFilter, opts ...grpc.CallOption) (*IndexerResult, error)
+	// Combined returns a page of the outputs of all types matching the filter.
+	Combined(ctx context.Context, in *CombinedFilter, opts ...grpc.CallOption) (*IndexerResult, error)
+	// AccountByID returns the current output of the account.
+	AccountByID(ctx context.Context, in *AccountByIDRequest, opts ...grpc.CallOption) (*IndexerResult, error)
+	// AnchorByID returns the current output of the anchor.
+	AnchorByID(ctx context.Context, in *AnchorByIDRequest, opts ...grpc.CallOption) (*IndexerResult, error)
+	// NFTByID returns the current output of the NFT.
+	NFTByID(ctx context.Context, in *NFTByIDRequest, opts ...grpc.CallOption) (*IndexerResult, error)
+	// FoundryByID returns the current output of the foundry.
+	FoundryByID(ctx context.Context, in *FoundryByIDRequest, opts ...grpc.CallOption) (*IndexerResult, error)
+	// DelegationByID returns the current output of the delegation.
+	DelegationByID(ctx context.Context, in *DelegationByIDRequest, opts ...grpc.CallOption) (*IndexerResult, error)
+	// The streaming variants send all pages of the matching outputs, starting at the cursor of the pagination (if given).
+	// Each page is queried separately, so the committed slot may differ between the pages.
+	StreamBasic(ctx context.Context, in *BasicFilter, opts ...grpc.CallOption) (Indexer_StreamBasicClient, error)
+	StreamAccount(ctx context.Context, in *AccountFilter, opts ...grpc.CallOption) (Indexer_StreamAccountClient, error)
+	StreamAnchor(ctx context.Context, in *AnchorFilter, opts ...grpc.CallOption) (Indexer_StreamAnchorClient, error)
+	StreamNFT(ctx context.Context, in *NFTFilter, opts ...grpc.CallOption) (Indexer_StreamNFTClient, error)
+	StreamFoundry(ctx context.Context, in *FoundryFilter, opts ...grpc.CallOption) (Indexer_StreamFoundryClient, error)
+	StreamDelegation(ctx context.Context, in *DelegationFilter, opts ...grpc.CallOption) (Indexer_StreamDelegationClient, error)
+	StreamCombined(ctx context.Context, in *CombinedFilter, opts ...grpc.CallOption) (Indexer_StreamCombinedClient, error)
+}
+
+type indexerClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewIndexerClient(cc grpc.ClientConnInterface) IndexerClient {
+	return &indexerClient{cc}
+}
+
+func (c *indexerClient) Basic(ctx context.Context, in *BasicFilter, opts ...grpc.CallOption) (*IndexerResult, error) {
+	out := new(IndexerResult)
+	err := c.cc.Invoke(ctx, Indexer_Basic_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexerClient) Account(ctx context.Context, in *AccountFilter, opts ...grpc.CallOption) (*IndexerResult, error) {
+	out := new(IndexerResult)
+	err := c.cc.Invoke(ctx, Indexer_Account_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexerClient) Anchor(ctx context.Context, in *AnchorFilter, opts ...grpc.CallOption) (*IndexerResult, error) {
+	out := new(IndexerResult)
+	err := c.cc.Invoke(ctx, Indexer_Anchor_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexerClient) NFT(ctx context.Context, in *NFTFilter, opts ...grpc.CallOption) (*IndexerResult, error) {
+	out := new(IndexerResult)
+	err := c.cc.Invoke(ctx, Indexer_NFT_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexerClient) Foundry(ctx context.Context, in *FoundryFilter, opts ...grpc.CallOption) (*IndexerResult, error) {
+	out := new(IndexerResult)
+	err := c.cc.Invoke(ctx, Indexer_Foundry_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexerClient) Delegation(ctx context.Context, in *DelegationFilter, opts ...grpc.CallOption) (*IndexerResult, error) {
+	out := new(IndexerResult)
+	err := c.cc.Invoke(ctx, Indexer_Delegation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexerClient) Combined(ctx context.Context, in *CombinedFilter, opts ...grpc.CallOption) (*IndexerResult, error) {
+	out := new(IndexerResult)
+	err := c.cc.Invoke(ctx, Indexer_Combined_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexerClient) AccountByID(ctx context.Context, in *AccountByIDRequest, opts ...grpc.CallOption) (*IndexerResult, error) {
+	out := new(IndexerResult)
+	err := c.cc.Invoke(ctx, Indexer_AccountByID_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexerClient) AnchorByID(ctx context.Context, in *AnchorByIDRequest, opts ...grpc.CallOption) (*IndexerResult, error) {
+	out := new(IndexerResult)
+	err := c.cc.Invoke(ctx, Indexer_AnchorByID_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexerClient) NFTByID(ctx context.Context, in *NFTByIDRequest, opts ...grpc.CallOption) (*IndexerResult, error) {
+	out := new(IndexerResult)
+	err := c.cc.Invoke(ctx, Indexer_NFTByID_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexerClient) FoundryByID(ctx context.Context, in *FoundryByIDRequest, opts ...grpc.CallOption) (*IndexerResult, error) {
+	out := new(IndexerResult)
+	err := c.cc.Invoke(ctx, Indexer_FoundryByID_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexerClient) DelegationByID(ctx context.Context, in *DelegationByIDRequest, opts ...grpc.CallOption) (*IndexerResult, error) {
+	out := new(IndexerResult)
+	err := c.cc.Invoke(ctx, Indexer_DelegationByID_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexerClient) StreamBasic(ctx context.Context, in *BasicFilter, opts ...grpc.CallOption) (Indexer_StreamBasicClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Indexer_ServiceDesc.Streams[0], Indexer_StreamBasic_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &indexerStreamBasicClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Indexer_StreamBasicClient interface {
+	Recv() (*IndexerResult, error)
+	grpc.ClientStream
+}
+
+type indexerStreamBasicClient struct {
+	grpc.ClientStream
+}
+
+func (x *indexerStreamBasicClient) Recv() (*IndexerResult, error) {
+	m := new(IndexerResult)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *indexerClient) StreamAccount(ctx context.Context, in *AccountFilter, opts ...grpc.CallOption) (Indexer_StreamAccountClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Indexer_ServiceDesc.Streams[1], Indexer_StreamAccount_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &indexerStreamAccountClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Indexer_StreamAccountClient interface {
+	Recv() (*IndexerResult, error)
+	grpc.ClientStream
+}
+
+type indexerStreamAccountClient struct {
+	grpc.ClientStream
+}
+
+func (x *indexerStreamAccountClient) Recv() (*IndexerResult, error) {
+	m := new(IndexerResult)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *indexerClient) StreamAnchor(ctx context.Context, in *AnchorFilter, opts ...grpc.CallOption) (Indexer_StreamAnchorClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Indexer_ServiceDesc.Streams[2], Indexer_StreamAnchor_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &indexerStreamAnchorClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Indexer_StreamAnchorClient interface {
+	Recv() (*IndexerResult, error)
+	grpc.ClientStream
+}
+
+type indexerStreamAnchorClient struct {
+	grpc.ClientStream
+}
+
+func (x *indexerStreamAnchorClient) Recv() (*IndexerResult, error) {
+	m := new(IndexerResult)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *indexerClient) StreamNFT(ctx context.Context, in *NFTFilter, opts ...grpc.CallOption) (Indexer_StreamNFTClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Indexer_ServiceDesc.Streams[3], Indexer_StreamNFT_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &indexerStreamNFTClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Indexer_StreamNFTClient interface {
+	Recv() (*IndexerResult, error)
+	grpc.ClientStream
+}
+
+type indexerStreamNFTClient struct {
+	grpc.ClientStream
+}
+
+func (x *indexerStreamNFTClient) Recv() (*IndexerResult, error) {
+	m := new(IndexerResult)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *indexerClient) StreamFoundry(ctx context.Context, in *FoundryFilter, opts ...grpc.CallOption) (Indexer_StreamFoundryClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Indexer_ServiceDesc.Streams[4], Indexer_StreamFoundry_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &indexerStreamFoundryClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Indexer_StreamFoundryClient interface {
+	Recv() (*IndexerResult, error)
+	grpc.ClientStream
+}
+
+type indexerStreamFoundryClient struct {
+	grpc.ClientStream
+}
+
+func (x *indexerStreamFoundryClient) Recv() (*IndexerResult, error) {
+	m := new(IndexerResult)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *indexerClient) StreamDelegation(ctx context.Context, in *DelegationFilter, opts ...grpc.CallOption) (Indexer_StreamDelegationClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Indexer_ServiceDesc.Streams[5], Indexer_StreamDelegation_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &indexerStreamDelegationClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Indexer_StreamDelegationClient interface {
+	Recv() (*IndexerResult, error)
+	grpc.ClientStream
+}
+
+type indexerStreamDelegationClient struct {
+	grpc.ClientStream
+}
+
+func (x *indexerStreamDelegationClient) Recv() (*IndexerResult, error) {
+	m := new(IndexerResult)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *indexerClient) StreamCombined(ctx context.Context, in *CombinedFilter, opts ...grpc.CallOption) (Indexer_StreamCombinedClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Indexer_ServiceDesc.Streams[6], Indexer_StreamCombined_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &indexerStreamCombinedClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Indexer_StreamCombinedClient interface {
+	Recv() (*IndexerResult, error)
+	grpc.ClientStream
+}
+
+type indexerStreamCombinedClient struct {
+	grpc.ClientStream
+}
+
+func (x *indexerStreamCombinedClient) Recv() (*IndexerResult, error) {
+	m := new(IndexerResult)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// IndexerServer is the server API for Indexer service.
+// All implementations must embed UnimplementedIndexerServer
+// for forward compatibility
+type IndexerServer interface {
+	// Basic returns a page of the basic outputs matching the filter.
+	Basic(context.Context, *BasicFilter) (*IndexerResult, error)
+	// Account returns a page of the account outputs matching the filter.
+	Account(context.Context, *AccountFilter) (*IndexerResult, error)
+	// Anchor returns a page of the anchor outputs matching the filter.
+	Anchor(context.Context, *AnchorFilter) (*IndexerResult, error)
+	// NFT returns a page of the NFT outputs matching the filter.
+	NFT(context.Context, *NFTFilter) (*IndexerResult, error)
+	// Foundry returns a page of the foundry outputs matching the filter.
+	Foundry(context.Context, *FoundryFilter) (*IndexerResult, error)
+	// Delegation returns a page of the delegation outputs matching the filter.
+	Delegation(context.Context, *DelegationFilter) (*IndexerResult, error)
+	// Combined returns a page of the outputs of all types matching the filter.
+	Combined(context.Context, *CombinedFilter) (*IndexerResult, error)
+	// AccountByID returns the current output of the account.
+	AccountByID(context.Context, *AccountByIDRequest) (*IndexerResult, error)
+	// AnchorByID returns the current output of the anchor.
+	AnchorByID(context.Context, *AnchorByIDRequest) (*IndexerResult, error)
+	// NFTByID returns the current output of the NFT.
+	NFTByID(context.Context, *NFTByIDRequest) (*IndexerResult, error)
+	// FoundryByID returns the current output of the foundry.
+	FoundryByID(context.Context, *FoundryByIDRequest) (*IndexerResult, error)
+	// DelegationByID returns the current output of the delegation.
+	DelegationByID(context.Context, *DelegationByIDRequest) (*IndexerResult, error)
+	// The streaming variants send all pages of the matching outputs, starting at the cursor of the pagination (if given).
+	// Each page is queried separately, so the committed slot may differ between the pages.
+	StreamBasic(*BasicFilter, Indexer_StreamBasicServer) error
+	StreamAccount(*AccountFilter, Indexer_StreamAccountServer) error
+	StreamAnchor(*AnchorFilter, Indexer_StreamAnchorServer) error
+	StreamNFT(*NFTFilter, Indexer_StreamNFTServer) error
+	StreamFoundry(*FoundryFilter, Indexer_StreamFoundryServer) error
+	StreamDelegation(*DelegationFilter, Indexer_StreamDelegationServer) error
+	StreamCombined(*CombinedFilter, Indexer_StreamCombinedServer) error
+	mustEmbedUnimplementedIndexerServer()
+}
+
+// UnimplementedIndexerServer must be embedded to have forward compatible implementations.
+type UnimplementedIndexerServer struct {
+}
+
+func (UnimplementedIndexerServer) Basic(context.Context, *BasicFilter) (*IndexerResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Basic not implemented")
+}
+func (UnimplementedIndexerServer) Account(context.Context, *AccountFilter) (*IndexerResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Account not implemented")
+}
+func (UnimplementedIndexerServer) Anchor(context.Context, *AnchorFilter) (*IndexerResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Anchor not implemented")
+}
+func (UnimplementedIndexerServer) NFT(context.Context, *NFTFilter) (*IndexerResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NFT not implemented")
+}
+func (UnimplementedIndexerServer) Foundry(context.Context, *FoundryFilter) (*IndexerResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Foundry not implemented")
+}
+func (UnimplementedIndexerServer) Delegation(context.Context, *DelegationFilter) (*IndexerResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delegation not implemented")
+}
+func (UnimplementedIndexerServer) Combined(context.Context, *CombinedFilter) (*IndexerResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Combined not implemented")
+}
+func (UnimplementedIndexerServer) AccountByID(context.Context, *AccountByIDRequest) (*IndexerResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountByID not implemented")
+}
+func (UnimplementedIndexerServer) AnchorByID(context.Context, *AnchorByIDRequest) (*IndexerResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AnchorByID not implemented")
+}
+func (UnimplementedIndexerServer) NFTByID(context.Context, *NFTByIDRequest) (*IndexerResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NFTByID not implemented")
+}
+func (UnimplementedIndexerServer) FoundryByID(context.Context, *FoundryByIDRequest) (*IndexerResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FoundryByID not implemented")
+}
+func (UnimplementedIndexerServer) DelegationByID(context.Context, *DelegationByIDRequest) (*IndexerResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegationByID not implemented")
+}
+func (UnimplementedIndexerServer) StreamBasic(*BasicFilter, Indexer_StreamBasicServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamBasic not implemented")
+}
+func (UnimplementedIndexerServer) StreamAccount(*AccountFilter, Indexer_StreamAccountServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamAccount not implemented")
+}
+func (UnimplementedIndexerServer) StreamAnchor(*AnchorFilter, Indexer_StreamAnchorServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamAnchor not implemented")
+}
+func (UnimplementedIndexerServer) StreamNFT(*NFTFilter, Indexer_StreamNFTServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamNFT not implemented")
+}
+func (UnimplementedIndexerServer) StreamFoundry(*FoundryFilter, Indexer_StreamFoundryServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamFoundry not implemented")
+}
+func (UnimplementedIndexerServer) StreamDelegation(*DelegationFilter, Indexer_StreamDelegationServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamDelegation not implemented")
+}
+func (UnimplementedIndexerServer) StreamCombined(*CombinedFilter, Indexer_StreamCombinedServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamCombined not implemented")
+}
+func (UnimplementedIndexerServer) mustEmbedUnimplementedIndexerServer() {}
+
+// UnsafeIndexerServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to IndexerServer will
+// result in compilation errors.
+type UnsafeIndexerServer interface {
+	mustEmbedUnimplementedIndexerServer()
+}
+
+func RegisterIndexerServer(s grpc.ServiceRegistrar, srv IndexerServer) {
+	s.RegisterService(&Indexer_ServiceDesc, srv)
+}
+
+func _Indexer_Basic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BasicFilter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexerServer).Basic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Indexer_Basic_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexerServer).Basic(ctx, req.(*BasicFilter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Indexer_Account_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountFilter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexerServer).Account(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Indexer_Account_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexerServer).Account(ctx, req.(*AccountFilter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Indexer_Anchor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnchorFilter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexerServer).Anchor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Indexer_Anchor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexerServer).Anchor(ctx, req.(*AnchorFilter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Indexer_NFT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NFTFilter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexerServer).NFT(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Indexer_NFT_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexerServer).NFT(ctx, req.(*NFTFilter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Indexer_Foundry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FoundryFilter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexerServer).Foundry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Indexer_Foundry_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexerServer).Foundry(ctx, req.(*FoundryFilter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Indexer_Delegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DelegationFilter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexerServer).Delegation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Indexer_Delegation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexerServer).Delegation(ctx, req.(*DelegationFilter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Indexer_Combined_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CombinedFilter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexerServer).Combined(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Indexer_Combined_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexerServer).Combined(ctx, req.(*CombinedFilter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Indexer_AccountByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountByIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexerServer).AccountByID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Indexer_AccountByID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexerServer).AccountByID(ctx, req.(*AccountByIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Indexer_AnchorByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnchorByIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexerServer).AnchorByID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Indexer_AnchorByID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexerServer).AnchorByID(ctx, req.(*AnchorByIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Indexer_NFTByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NFTByIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexerServer).NFTByID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Indexer_NFTByID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexerServer).NFTByID(ctx, req.(*NFTByIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Indexer_FoundryByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FoundryByIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexerServer).FoundryByID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Indexer_FoundryByID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexerServer).FoundryByID(ctx, req.(*FoundryByIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Indexer_DelegationByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DelegationByIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexerServer).DelegationByID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Indexer_DelegationByID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexerServer).DelegationByID(ctx, req.(*DelegationByIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Indexer_StreamBasic_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(BasicFilter)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(IndexerServer).StreamBasic(m, &indexerStreamBasicServer{stream})
+}
+
+type Indexer_StreamBasicServer interface {
+	Send(*IndexerResult) error
+	grpc.ServerStream
+}
+
+type indexerStreamBasicServer struct {
+	grpc.ServerStream
+}
+
+func (x *indexerStreamBasicServer) Send(m *IndexerResult) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Indexer_StreamAccount_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(AccountFilter)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(IndexerServer).StreamAccount(m, &indexerStreamAccountServer{stream})
+}
+
+type Indexer_StreamAccountServer interface {
+	Send(*IndexerResult) error
+	grpc.ServerStream
+}
+
+type indexerStreamAccountServer struct {
+	grpc.ServerStream
+}
+
+func (x *indexerStreamAccountServer) Send(m *IndexerResult) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Indexer_StreamAnchor_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(AnchorFilter)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(IndexerServer).StreamAnchor(m, &indexerStreamAnchorServer{stream})
+}
+
+type Indexer_StreamAnchorServer interface {
+	Send(*IndexerResult) error
+	grpc.ServerStream
+}
+
+type indexerStreamAnchorServer struct {
+	grpc.ServerStream
+}
+
+func (x *indexerStreamAnchorServer) Send(m *IndexerResult) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Indexer_StreamNFT_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(NFTFilter)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(IndexerServer).StreamNFT(m, &indexerStreamNFTServer{stream})
+}
+
+type Indexer_StreamNFTServer interface {
+	Send(*IndexerResult) error
+	grpc.ServerStream
+}
+
+type indexerStreamNFTServer struct {
+	grpc.ServerStream
+}
+
+func (x *indexerStreamNFTServer) Send(m *IndexerResult) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Indexer_StreamFoundry_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(FoundryFilter)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(IndexerServer).StreamFoundry(m, &indexerStreamFoundryServer{stream})
+}
+
+type Indexer_StreamFoundryServer interface {
+	Send(*IndexerResult) error
+	grpc.ServerStream
+}
+
+type indexerStreamFoundryServer struct {
+	grpc.ServerStream
+}
+
+func (x *indexerStreamFoundryServer) Send(m *IndexerResult) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Indexer_StreamDelegation_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DelegationFilter)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(IndexerServer).StreamDelegation(m, &indexerStreamDelegationServer{stream})
+}
+
+type Indexer_StreamDelegationServer interface {
+	Send(*IndexerResult) error
+	grpc.ServerStream
+}
+
+type indexerStreamDelegationServer struct {
+	grpc.ServerStream
+}
+
+func (x *indexerStreamDelegationServer) Send(m *IndexerResult) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Indexer_StreamCombined_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(CombinedFilter)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(IndexerServer).StreamCombined(m, &indexerStreamCombinedServer{stream})
+}
+
+type Indexer_StreamCombinedServer interface {
+	Send(*IndexerResult) error
+	grpc.ServerStream
+}
+
+type indexerStreamCombinedServer struct {
+	grpc.ServerStream
+}
+
+func (x *indexerStreamCombinedServer) Send(m *IndexerResult) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// Indexer_ServiceDesc is the grpc.ServiceDesc for Indexer service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Indexer_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "indexer.Indexer",
+	HandlerType: (*IndexerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Basic",
+			Handler:    _Indexer_Basic_Handler,
+		},
+		{
+			MethodName: "Account",
+			Handler:    _Indexer_Account_Handler,
+		},
+		{
+			MethodName: "Anchor",
+			Handler:    _Indexer_Anchor_Handler,
+		},
+		{
+			MethodName: "NFT",
+			Handler:    _Indexer_NFT_Handler,
+		},
+		{
+			MethodName: "Foundry",
+			Handler:    _Indexer_Foundry_Handler,
+		},
+		{
+			MethodName: "Delegation",
+			Handler:    _Indexer_Delegation_Handler,
+		},
+		{
+			MethodName: "Combined",
+			Handler:    _Indexer_Combined_Handler,
+		},
+		{
+			MethodName: "AccountByID",
+			Handler:    _Indexer_AccountByID_Handler,
+		},
+		{
+			MethodName: "AnchorByID",
+			Handler:    _Indexer_AnchorByID_Handler,
+		},
+		{
+			MethodName: "NFTByID",
+			Handler:    _Indexer_NFTByID_Handler,
+		},
+		{
+			MethodName: "FoundryByID",
+			Handler:    _Indexer_FoundryByID_Handler,
+		},
+		{
+			MethodName: "DelegationByID",
+			Handler:    _Indexer_DelegationByID_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamBasic",
+			Handler:       _Indexer_StreamBasic_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamAccount",
+			Handler:       _Indexer_StreamAccount_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamAnchor",
+			Handler:       _Indexer_StreamAnchor_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamNFT",
+			Handler:       _Indexer_StreamNFT_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamFoundry",
+			Handler:       _Indexer_StreamFoundry_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamDelegation",
+			Handler:       _Indexer_StreamDelegation_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamCombined",
+			Handler:       _Indexer_StreamCombined_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "indexer.proto",
+}
@@ -0,0 +1,325 @@
+package grpc
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/hive.go/runtime/options"
+	"github.com/iotaledger/inx-indexer/pkg/grpc/indexerpb"
+	"github.com/iotaledger/inx-indexer/pkg/indexer"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+func errorFromResult(err error) error {
+	if ierrors.Is(err, indexer.ErrSlotNotRetained) {
+		return status.Errorf(codes.InvalidArgument, "invalid asOfSlot: %s", err)
+	}
+
+	if ierrors.Is(err, indexer.ErrInvalidCursor) {
+		return status.Errorf(codes.InvalidArgument, "invalid cursor: %s", err)
+	}
+
+	return status.Errorf(codes.Internal, "reading outputIDs failed: %s", err)
+}
+
+func resultFromIndexerResult(result *indexer.IndexerResult) (*indexerpb.IndexerResult, error) {
+	if result.Error != nil {
+		return nil, errorFromResult(result.Error)
+	}
+
+	outputIDs := make([][]byte, 0, len(result.OutputIDs))
+	for _, outputID := range result.OutputIDs {
+		outputIDs = append(outputIDs, outputID[:])
+	}
+
+	return &indexerpb.IndexerResult{
+		CommittedSlot: uint32(result.CommittedSlot),
+		PageSize:      result.PageSize,
+		OutputIds:     outputIDs,
+		Cursor:        result.Cursor,
+	}, nil
+}
+
+func singleResultFromIndexerResult(result *indexer.IndexerResult) (*indexerpb.IndexerResult, error) {
+	if result.Error == nil && len(result.OutputIDs) == 0 {
+		return nil, status.Error(codes.NotFound, "record not found")
+	}
+
+	return resultFromIndexerResult(result)
+}
+
+// streamResults sends the results page by page until there is no further cursor.
+// query is called with a nil cursor for the first page, which then starts at the cursor of the filter (if given).
+func streamResults(ctx context.Context, send func(*indexerpb.IndexerResult) error, query func(cursor *string) *indexer.IndexerResult) error {
+	var cursor *string
+	for {
+		if err := ctx.Err(); err != nil {
+			return status.FromContextError(err).Err()
+		}
+
+		result, err := resultFromIndexerResult(query(cursor))
+		if err != nil {
+			return err
+		}
+
+		if err := send(result); err != nil {
+			return err
+		}
+
+		if result.Cursor == nil {
+			return nil
+		}
+		cursor = result.Cursor
+	}
+}
+
+func (s *Server) Basic(_ context.Context, filter *indexerpb.BasicFilter) (*indexerpb.IndexerResult, error) {
+	opts, err := s.basicFilterOptions(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return resultFromIndexerResult(s.indexer.Basic(opts...))
+}
+
+func (s *Server) Account(_ context.Context, filter *indexerpb.AccountFilter) (*indexerpb.IndexerResult, error) {
+	opts, err := s.accountFilterOptions(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return resultFromIndexerResult(s.indexer.Account(opts...))
+}
+
+func (s *Server) Anchor(_ context.Context, filter *indexerpb.AnchorFilter) (*indexerpb.IndexerResult, error) {
+	opts, err := s.anchorFilterOptions(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return resultFromIndexerResult(s.indexer.Anchor(opts...))
+}
+
+func (s *Server) NFT(_ context.Context, filter *indexerpb.NFTFilter) (*indexerpb.IndexerResult, error) {
+	opts, err := s.nftFilterOptions(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return resultFromIndexerResult(s.indexer.NFT(opts...))
+}
+
+func (s *Server) Foundry(_ context.Context, filter *indexerpb.FoundryFilter) (*indexerpb.IndexerResult, error) {
+	opts, err := s.foundryFilterOptions(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return resultFromIndexerResult(s.indexer.Foundry(opts...))
+}
+
+func (s *Server) Delegation(_ context.Context, filter *indexerpb.DelegationFilter) (*indexerpb.IndexerResult, error) {
+	opts, err := s.delegationFilterOptions(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return resultFromIndexerResult(s.indexer.Delegation(opts...))
+}
+
+func (s *Server) Combined(_ context.Context, filter *indexerpb.CombinedFilter) (*indexerpb.IndexerResult, error) {
+	opts, err := s.combinedFilterOptions(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return resultFromIndexerResult(s.indexer.Combined(opts...))
+}
+
+func (s *Server) AccountByID(_ context.Context, req *indexerpb.AccountByIDRequest) (*indexerpb.IndexerResult, error) {
+	p := &filterParser{s: s}
+	var accountID iotago.AccountID
+	copy(accountID[:], p.id("accountId", req.GetAccountId(), iotago.AccountIDLength))
+	if p.err != nil {
+		return nil, p.err
+	}
+
+	var opts []options.Option[indexer.AccountFilterOptions]
+	if req.AsOfSlot != nil {
+		opts = append(opts, indexer.AccountAsOfSlot(iotago.SlotIndex(req.GetAsOfSlot())))
+	}
+
+	return singleResultFromIndexerResult(s.indexer.AccountByID(accountID, opts...))
+}
+
+func (s *Server) AnchorByID(_ context.Context, req *indexerpb.AnchorByIDRequest) (*indexerpb.IndexerResult, error) {
+	p := &filterParser{s: s}
+	var anchorID iotago.AnchorID
+	copy(anchorID[:], p.id("anchorId", req.GetAnchorId(), iotago.AnchorIDLength))
+	if p.err != nil {
+		return nil, p.err
+	}
+
+	var opts []options.Option[indexer.AnchorFilterOptions]
+	if req.AsOfSlot != nil {
+		opts = append(opts, indexer.AnchorAsOfSlot(iotago.SlotIndex(req.GetAsOfSlot())))
+	}
+
+	return singleResultFromIndexerResult(s.indexer.AnchorByID(anchorID, opts...))
+}
+
+func (s *Server) NFTByID(_ context.Context, req *indexerpb.NFTByIDRequest) (*indexerpb.IndexerResult, error) {
+	p := &filterParser{s: s}
+	var nftID iotago.NFTID
+	copy(nftID[:], p.id("nftId", req.GetNftId(), iotago.NFTIDLength))
+	if p.err != nil {
+		return nil, p.err
+	}
+
+	var opts []options.Option[indexer.NFTFilterOptions]
+	if req.AsOfSlot != nil {
+		opts = append(opts, indexer.NFTAsOfSlot(iotago.SlotIndex(req.GetAsOfSlot())))
+	}
+
+	return singleResultFromIndexerResult(s.indexer.NFTByID(nftID, opts...))
+}
+
+func (s *Server) FoundryByID(_ context.Context, req *indexerpb.FoundryByIDRequest) (*indexerpb.IndexerResult, error) {
+	p := &filterParser{s: s}
+	var foundryID iotago.FoundryID
+	copy(foundryID[:], p.id("foundryId", req.GetFoundryId(), iotago.FoundryIDLength))
+	if p.err != nil {
+		return nil, p.err
+	}
+
+	var opts []options.Option[indexer.FoundryFilterOptions]
+	if req.AsOfSlot != nil {
+		opts = append(opts, indexer.FoundryAsOfSlot(iotago.SlotIndex(req.GetAsOfSlot())))
+	}
+
+	return singleResultFromIndexerResult(s.indexer.FoundryByID(foundryID, opts...))
+}
+
+func (s *Server) DelegationByID(_ context.Context, req *indexerpb.DelegationByIDRequest) (*indexerpb.IndexerResult, error) {
+	p := &filterParser{s: s}
+	var delegationID iotago.DelegationID
+	copy(delegationID[:], p.id("delegationId", req.GetDelegationId(), iotago.DelegationIDLength))
+	if p.err != nil {
+		return nil, p.err
+	}
+
+	var opts []options.Option[indexer.DelegationFilterOptions]
+	if req.AsOfSlot != nil {
+		opts = append(opts, indexer.DelegationAsOfSlot(iotago.SlotIndex(req.GetAsOfSlot())))
+	}
+
+	return singleResultFromIndexerResult(s.indexer.DelegationByID(delegationID, opts...))
+}
+
+func (s *Server) StreamBasic(filter *indexerpb.BasicFilter, stream indexerpb.Indexer_StreamBasicServer) error {
+	opts, err := s.basicFilterOptions(filter)
+	if err != nil {
+		return err
+	}
+
+	return streamResults(stream.Context(), stream.Send, func(cursor *string) *indexer.IndexerResult {
+		if cursor == nil {
+			return s.indexer.Basic(opts...)
+		}
+
+		return s.indexer.Basic(append(opts, indexer.BasicCursor(*cursor))...)
+	})
+}
+
+func (s *Server) StreamAccount(filter *indexerpb.AccountFilter, stream indexerpb.Indexer_StreamAccountServer) error {
+	opts, err := s.accountFilterOptions(filter)
+	if err != nil {
+		return err
+	}
+
+	return streamResults(stream.Context(), stream.Send, func(cursor *string) *indexer.IndexerResult {
+		if cursor == nil {
+			return s.indexer.Account(opts...)
+		}
+
+		return s.indexer.Account(append(opts, indexer.AccountCursor(*cursor))...)
+	})
+}
+
+func (s *Server) StreamAnchor(filter *indexerpb.AnchorFilter, stream indexerpb.Indexer_StreamAnchorServer) error {
+	opts, err := s.anchorFilterOptions(filter)
+	if err != nil {
+		return err
+	}
+
+	return streamResults(stream.Context(), stream.Send, func(cursor *string) *indexer.IndexerResult {
+		if cursor == nil {
+			return s.indexer.Anchor(opts...)
+		}
+
+		return s.indexer.Anchor(append(opts, indexer.AnchorCursor(*cursor))...)
+	})
+}
+
+func (s *Server) StreamNFT(filter *indexerpb.NFTFilter, stream indexerpb.Indexer_StreamNFTServer) error {
+	opts, err := s.nftFilterOptions(filter)
+	if err != nil {
+		return err
+	}
+
+	return streamResults(stream.Context(), stream.Send, func(cursor *string) *indexer.IndexerResult {
+		if cursor == nil {
+			return s.indexer.NFT(opts...)
+		}
+
+		return s.indexer.NFT(append(opts, indexer.NFTCursor(*cursor))...)
+	})
+}
+
+func (s *Server) StreamFoundry(filter *indexerpb.FoundryFilter, stream indexerpb.Indexer_StreamFoundryServer) error {
+	opts, err := s.foundryFilterOptions(filter)
+	if err != nil {
+		return err
+	}
+
+	return streamResults(stream.Context(), stream.Send, func(cursor *string) *indexer.IndexerResult {
+		if cursor == nil {
+			return s.indexer.Foundry(opts...)
+		}
+
+		return s.indexer.Foundry(append(opts, indexer.FoundryCursor(*cursor))...)
+	})
+}
+
+func (s *Server) StreamDelegation(filter *indexerpb.DelegationFilter, stream indexerpb.Indexer_StreamDelegationServer) error {
+	opts, err := s.delegationFilterOptions(filter)
+	if err != nil {
+		return err
+	}
+
+	return streamResults(stream.Context(), stream.Send, func(cursor *string) *indexer.IndexerResult {
+		if cursor == nil {
+			return s.indexer.Delegation(opts...)
+		}
+
+		return s.indexer.Delegation(append(opts, indexer.DelegationCursor(*cursor))...)
+	})
+}
+
+func (s *Server) StreamCombined(filter *indexerpb.CombinedFilter, stream indexerpb.Indexer_StreamCombinedServer) error {
+	opts, err := s.combinedFilterOptions(filter)
+	if err != nil {
+		return err
+	}
+
+	return streamResults(stream.Context(), stream.Send, func(cursor *string) *indexer.IndexerResult {
+		if cursor == nil {
+			return s.indexer.Combined(opts...)
+		}
+
+		return s.indexer.Combined(append(opts, indexer.CombinedCursor(*cursor))...)
+	})
+}
@@ -0,0 +1,111 @@
+package grpc
+
+import (
+	"context"
+	"net"
+
+	grpcgo "google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/iotaledger/hive.go/runtime/options"
+	"github.com/iotaledger/inx-indexer/pkg/grpc/indexerpb"
+	"github.com/iotaledger/inx-indexer/pkg/indexer"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+// Server implements the Indexer gRPC service with the same indexer methods that back the REST API.
+type Server struct {
+	indexerpb.UnimplementedIndexerServer
+
+	indexer    *indexer.Indexer
+	bech32HRP  iotago.NetworkPrefix
+	grpcServer *grpcgo.Server
+	// streams holds a slot for every running stream to limit the number of concurrent streams.
+	streams chan struct{}
+
+	// optsMaxPageSize defines the maximum number of outputIDs returned per page.
+	optsMaxPageSize uint32
+	// optsMaxAddresses defines the maximum number of addresses in a single filter.
+	optsMaxAddresses int
+	// optsMaxStreams defines the maximum number of concurrent streams.
+	optsMaxStreams int
+}
+
+// NewServer creates a new gRPC server and registers the Indexer service.
+func NewServer(idx *indexer.Indexer, apiProvider iotago.APIProvider, opts ...options.Option[Server]) *Server {
+	s := options.Apply(&Server{
+		indexer:          idx,
+		bech32HRP:        apiProvider.CommittedAPI().ProtocolParameters().Bech32HRP(),
+		optsMaxPageSize:  indexer.DefaultPageSize,
+		optsMaxAddresses: 1000,
+		optsMaxStreams:   100,
+	}, opts)
+
+	s.streams = make(chan struct{}, s.optsMaxStreams)
+	s.grpcServer = grpcgo.NewServer(grpcgo.StreamInterceptor(s.limitStreams))
+
+	indexerpb.RegisterIndexerServer(s.grpcServer, s)
+
+	return s
+}
+
+// WithMaxPageSize sets the maximum number of outputIDs returned per page.
+func WithMaxPageSize(maxPageSize uint32) options.Option[Server] {
+	return func(s *Server) {
+		s.optsMaxPageSize = maxPageSize
+	}
+}
+
+// WithMaxAddresses sets the maximum number of addresses in a single filter.
+func WithMaxAddresses(maxAddresses int) options.Option[Server] {
+	return func(s *Server) {
+		s.optsMaxAddresses = maxAddresses
+	}
+}
+
+// WithMaxStreams sets the maximum number of concurrent streams.
+func WithMaxStreams(maxStreams int) options.Option[Server] {
+	return func(s *Server) {
+		s.optsMaxStreams = maxStreams
+	}
+}
+
+// limitStreams rejects new streams while the maximum number of concurrent streams are running.
+func (s *Server) limitStreams(srv interface{}, stream grpcgo.ServerStream, _ *grpcgo.StreamServerInfo, handler grpcgo.StreamHandler) error {
+	select {
+	case s.streams <- struct{}{}:
+		defer func() { <-s.streams }()
+
+		return handler(srv, stream)
+	default:
+		return status.Errorf(codes.ResourceExhausted, "maximum number of concurrent streams reached: %d", s.optsMaxStreams)
+	}
+}
+
+// Serve accepts connections on the listener until Stop is called.
+func (s *Server) Serve(listener net.Listener) error {
+	return s.grpcServer.Serve(listener)
+}
+
+// Stop closes the listener and all open connections, including the ones of running streams.
+func (s *Server) Stop() {
+	s.grpcServer.Stop()
+}
+
+// Shutdown closes the listener and waits for the running calls and streams to finish.
+// The remaining connections are closed once the context is done.
+func (s *Server) Shutdown(ctx context.Context) {
+	stopped := make(chan struct{})
+	go func() {
+		s.grpcServer.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+	case <-ctx.Done():
+		s.grpcServer.Stop()
+		<-stopped
+	}
+}
@@ -5,35 +5,103 @@ import (
 	"fmt"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 
 	"github.com/iotaledger/hive.go/runtime/options"
 	iotago "github.com/iotaledger/iota.go/v4"
 )
 
 type account struct {
-	OutputID      []byte `gorm:"primaryKey;notnull"`
-	AccountID     []byte `gorm:"notnull"`
-	Amount        iotago.BaseToken
-	Issuer        []byte           `gorm:"index:accounts_issuer"`
-	Sender        []byte           `gorm:"index:accounts_sender"`
-	Address       []byte           `gorm:"notnull;index:accounts_address"`
-	CreatedAtSlot iotago.SlotIndex `gorm:"notnull;index:accounts_created_at_slot"`
-	DeletedAtSlot iotago.SlotIndex `gorm:"notnull;index:accounts_deleted_at_slot"`
-	Committed     bool
+	OutputID              []byte            `gorm:"primaryKey;notnull;index:accounts_created_at_slot_output_id,priority:2;index:accounts_amount_output_id,priority:2"`
+	AccountID             []byte            `gorm:"notnull"`
+	Amount                iotago.BaseToken  `gorm:"index:accounts_amount_output_id,priority:1"`
+	Issuer                []byte            `gorm:"index:accounts_issuer"`
+	Sender                []byte            `gorm:"index:accounts_sender"`
+	Address               []byte            `gorm:"notnull;index:accounts_address"`
+	BlockIssuerExpirySlot *iotago.SlotIndex `gorm:"index:accounts_block_issuer_expiry_slot"`
+	StakedAmount          *iotago.BaseToken `gorm:"index:accounts_staked_amount"`
+	FixedCost             *iotago.Mana
+	StakingStartEpoch     *iotago.EpochIndex
+	StakingEndEpoch       *iotago.EpochIndex
+	CreatedAtSlot         iotago.SlotIndex `gorm:"notnull;index:accounts_created_at_slot_output_id,priority:1"`
+	DeletedAtSlot         iotago.SlotIndex `gorm:"notnull;index:accounts_deleted_at_slot"`
+	SpentAtSlot           iotago.SlotIndex `gorm:"notnull;index:accounts_spent_at_slot"`
+	Committed             bool
 }
 
 func (a *account) String() string {
 	return fmt.Sprintf("account output => AccountID: %s, OutputID: %s", hex.EncodeToString(a.AccountID), hex.EncodeToString(a.OutputID))
 }
 
+// blockIssuerKey contains a key of the BlockIssuerFeature of an account output.
+// The keys live and die with the account output they belong to.
+type blockIssuerKey struct {
+	OutputID       []byte `gorm:"primaryKey;notnull"`
+	BlockIssuerKey []byte `gorm:"primaryKey;notnull;index:block_issuer_keys_block_issuer_key"`
+}
+
+func (k *blockIssuerKey) String() string {
+	return fmt.Sprintf("block issuer key => OutputID: %s, Key: %s", hex.EncodeToString(k.OutputID), hex.EncodeToString(k.BlockIssuerKey))
+}
+
+func blockIssuerKeysForOutput(outputID iotago.OutputID, output iotago.Output) ([]*blockIssuerKey, error) {
+	accountOutput, isAccount := output.(*iotago.AccountOutput)
+	if !isAccount {
+		return nil, nil
+	}
+
+	blockIssuer := accountOutput.FeatureSet().BlockIssuer()
+	if blockIssuer == nil {
+		return nil, nil
+	}
+
+	keys := make([]*blockIssuerKey, 0, len(blockIssuer.BlockIssuerKeys))
+	for _, key := range blockIssuer.BlockIssuerKeys {
+		keyBytes, err := key.Bytes()
+		if err != nil {
+			return nil, err
+		}
+
+		keys = append(keys, &blockIssuerKey{
+			OutputID:       outputID[:],
+			BlockIssuerKey: keyBytes,
+		})
+	}
+
+	return keys, nil
+}
+
+func insertBlockIssuerKeysFromOutput(tx *gorm.DB, outputID iotago.OutputID, output iotago.Output) error {
+	keys, err := blockIssuerKeysForOutput(outputID, output)
+	if err != nil || len(keys) == 0 {
+		return err
+	}
+
+	// The keys might still be in the database from a previous uncommitted state
+	return tx.Clauses(clause.OnConflict{DoNothing: true}).Create(keys).Error
+}
+
+// deleteBlockIssuerKeysOfAccounts removes the block issuer keys of all account outputs matching the given conditions.
+func deleteBlockIssuerKeysOfAccounts(tx *gorm.DB, query interface{}, args ...interface{}) error {
+	return tx.Where("output_id IN (?)", tx.Model(&account{}).Select("output_id").Where(query, args...)).Delete(&blockIssuerKey{}).Error
+}
+
 type AccountFilterOptions struct {
-	address       iotago.Address
-	issuer        iotago.Address
-	sender        iotago.Address
-	pageSize      uint32
-	cursor        *string
-	createdBefore *iotago.SlotIndex
-	createdAfter  *iotago.SlotIndex
+	address                  iotago.Address
+	issuer                   iotago.Address
+	sender                   iotago.Address
+	isBlockIssuer            *bool
+	blockIssuerExpiresBefore *iotago.SlotIndex
+	blockIssuerExpiresAfter  *iotago.SlotIndex
+	blockIssuerKey           []byte
+	pageSize                 uint32
+	cursor                   *string
+	sortOrder                SortOrder
+	createdBefore            *iotago.SlotIndex
+	createdAfter             *iotago.SlotIndex
+	minAmount                *iotago.BaseToken
+	maxAmount                *iotago.BaseToken
+	asOfSlot                 *iotago.SlotIndex
 }
 
 func AccountUnlockAddress(address iotago.Address) options.Option[AccountFilterOptions] {
@@ -54,6 +122,45 @@ func AccountIssuer(address iotago.Address) options.Option[AccountFilterOptions]
 	}
 }
 
+func AccountIsBlockIssuer(value bool) options.Option[AccountFilterOptions] {
+	return func(args *AccountFilterOptions) {
+		args.isBlockIssuer = &value
+	}
+}
+
+func AccountBlockIssuerExpiresBefore(slot iotago.SlotIndex) options.Option[AccountFilterOptions] {
+	return func(args *AccountFilterOptions) {
+		args.blockIssuerExpiresBefore = &slot
+	}
+}
+
+func AccountBlockIssuerExpiresAfter(slot iotago.SlotIndex) options.Option[AccountFilterOptions] {
+	return func(args *AccountFilterOptions) {
+		args.blockIssuerExpiresAfter = &slot
+	}
+}
+
+// AccountBlockIssuerKey filters for accounts that contain the given serialized key in their BlockIssuerFeature.
+func AccountBlockIssuerKey(key []byte) options.Option[AccountFilterOptions] {
+	return func(args *AccountFilterOptions) {
+		args.blockIssuerKey = key
+	}
+}
+
+// AccountMinAmount filters for outputs holding at least the given amount of base tokens.
+func AccountMinAmount(amount iotago.BaseToken) options.Option[AccountFilterOptions] {
+	return func(args *AccountFilterOptions) {
+		args.minAmount = &amount
+	}
+}
+
+// AccountMaxAmount filters for outputs holding at most the given amount of base tokens.
+func AccountMaxAmount(amount iotago.BaseToken) options.Option[AccountFilterOptions] {
+	return func(args *AccountFilterOptions) {
+		args.maxAmount = &amount
+	}
+}
+
 func AccountPageSize(pageSize uint32) options.Option[AccountFilterOptions] {
 	return func(args *AccountFilterOptions) {
 		args.pageSize = pageSize
@@ -66,6 +173,13 @@ func AccountCursor(cursor string) options.Option[AccountFilterOptions] {
 	}
 }
 
+// AccountSortOrder defines the order in which the outputIDs are returned.
+func AccountSortOrder(sortOrder SortOrder) options.Option[AccountFilterOptions] {
+	return func(args *AccountFilterOptions) {
+		args.sortOrder = sortOrder
+	}
+}
+
 func AccountCreatedBefore(slot iotago.SlotIndex) options.Option[AccountFilterOptions] {
 	return func(args *AccountFilterOptions) {
 		args.createdBefore = &slot
@@ -78,19 +192,31 @@ func AccountCreatedAfter(slot iotago.SlotIndex) options.Option[AccountFilterOpti
 	}
 }
 
-func (i *Indexer) AccountByID(accountID iotago.AccountID) *IndexerResult {
-	query := i.db.Model(&account{}).
+func AccountAsOfSlot(slot iotago.SlotIndex) options.Option[AccountFilterOptions] {
+	return func(args *AccountFilterOptions) {
+		args.asOfSlot = &slot
+	}
+}
+
+// AccountByID returns the output of the given AccountID. Only the AccountAsOfSlot filter is taken into account.
+func (i *Indexer) AccountByID(accountID iotago.AccountID, filters ...options.Option[AccountFilterOptions]) *IndexerResult {
+	opts := options.Apply(&AccountFilterOptions{}, filters)
+	if err := i.checkAsOfSlot(opts.asOfSlot); err != nil {
+		return errorResult(err)
+	}
+
+	query := chainOutputAtSlotQuery(i.db.Model(&account{}), opts.asOfSlot).
 		Where("account_id = ?", accountID[:]).
 		Limit(1)
 
-	return i.combineOutputIDFilteredQuery(query, 0, nil)
+	return i.combineOutputIDFilteredQuery(query, 0, nil, SortCreatedAscending)
 }
 
 func (i *Indexer) accountQueryWithFilter(opts *AccountFilterOptions) *gorm.DB {
-	query := i.db.Model(&account{}).Where("deleted_at_slot = 0")
+	query := unspentAtSlotQuery(i.db.Model(&account{}), opts.asOfSlot)
 
 	if opts.address != nil {
-		query = query.Where("address = ?", opts.address.ID())
+		query = query.Where("output_id IN (?)", i.addressRefQuery(opts.address, iotago.OutputAccount, addressRoleUnlock))
 	}
 
 	if opts.sender != nil {
@@ -101,6 +227,34 @@ func (i *Indexer) accountQueryWithFilter(opts *AccountFilterOptions) *gorm.DB {
 		query = query.Where("issuer = ?", opts.issuer.ID())
 	}
 
+	if opts.isBlockIssuer != nil {
+		if *opts.isBlockIssuer {
+			query = query.Where("block_issuer_expiry_slot IS NOT NULL")
+		} else {
+			query = query.Where("block_issuer_expiry_slot IS NULL")
+		}
+	}
+
+	if opts.blockIssuerExpiresBefore != nil {
+		query = query.Where("block_issuer_expiry_slot < ?", *opts.blockIssuerExpiresBefore)
+	}
+
+	if opts.blockIssuerExpiresAfter != nil {
+		query = query.Where("block_issuer_expiry_slot > ?", *opts.blockIssuerExpiresAfter)
+	}
+
+	if len(opts.blockIssuerKey) > 0 {
+		query = query.Where("output_id IN (?)", i.db.Model(&blockIssuerKey{}).Select("output_id").Where("block_issuer_key = ?", opts.blockIssuerKey))
+	}
+
+	if opts.minAmount != nil {
+		query = query.Where("amount >= ?", *opts.minAmount)
+	}
+
+	if opts.maxAmount != nil {
+		query = query.Where("amount <= ?", *opts.maxAmount)
+	}
+
 	if opts.createdBefore != nil {
 		query = query.Where("created_at_slot < ?", *opts.createdBefore)
 	}
@@ -116,7 +270,45 @@ func (i *Indexer) Account(filters ...options.Option[AccountFilterOptions]) *Inde
 	opts := options.Apply(&AccountFilterOptions{
 		pageSize: DefaultPageSize,
 	}, filters)
+	if err := i.checkAsOfSlot(opts.asOfSlot); err != nil {
+		return errorResult(err)
+	}
+
 	query := i.accountQueryWithFilter(opts)
 
-	return i.combineOutputIDFilteredQuery(query, opts.pageSize, opts.cursor)
+	return i.combineOutputIDFilteredQuery(query, opts.pageSize, opts.cursor, opts.sortOrder)
+}
+
+// AccountCount returns the amount of outputs matching the filters. The page size, cursor and sort order are ignored.
+func (i *Indexer) AccountCount(filters ...options.Option[AccountFilterOptions]) *CountResult {
+	opts := options.Apply(&AccountFilterOptions{}, filters)
+	if err := i.checkAsOfSlot(opts.asOfSlot); err != nil {
+		return errorCountResult(err)
+	}
+
+	return i.countQueries([]*gorm.DB{i.accountQueryWithFilter(opts)})
+}
+
+// AccountSubscription subscribes to the events of the account outputs matching the filters. The page size, cursor, sort order and asOfSlot are ignored.
+func (i *Indexer) AccountSubscription(filters ...options.Option[AccountFilterOptions]) *OutputSubscription {
+	opts := options.Apply(&AccountFilterOptions{}, filters)
+
+	return i.subscribe(opts.matchesOutput)
+}
+
+// matchesOutput matches the filters against the rows of an output in the same way accountQueryWithFilter does.
+func (o *AccountFilterOptions) matchesOutput(rows *outputRows) bool {
+	entry, isAccount := rows.entry.(*account)
+	if !isAccount {
+		return false
+	}
+
+	return rows.referencedBy(o.address, addressRoleUnlock) &&
+		matchesAddress(entry.Sender, o.sender) &&
+		matchesAddress(entry.Issuer, o.issuer) &&
+		matchesPresence(o.isBlockIssuer, entry.BlockIssuerExpirySlot != nil) &&
+		matchesLess(entry.BlockIssuerExpirySlot, o.blockIssuerExpiresBefore) &&
+		matchesGreater(entry.BlockIssuerExpirySlot, o.blockIssuerExpiresAfter) &&
+		(len(o.blockIssuerKey) == 0 || rows.hasBlockIssuerKey(o.blockIssuerKey)) &&
+		matchesCommonFilters(entry.Amount, entry.CreatedAtSlot, o.minAmount, o.maxAmount, o.createdBefore, o.createdAfter)
 }
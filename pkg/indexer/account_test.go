@@ -25,6 +25,13 @@ func TestIndexer_NewAccountOutput(t *testing.T) {
 	issuerAddress := iotago_tpkg.RandEd25519Address()
 	address := iotago_tpkg.RandEd25519Address()
 
+	blockIssuerKey := iotago.Ed25519PublicKeyHashBlockIssuerKeyFromPublicKey(hive_ed25519.PublicKey(iotago_tpkg.RandEd25519PrivateKey().Public().(ed25519.PublicKey)))
+	blockIssuerKeyBytes, err := blockIssuerKey.Bytes()
+	require.NoError(t, err)
+
+	randomBlockIssuerKeyBytes, err := iotago.Ed25519PublicKeyHashBlockIssuerKeyFromPublicKey(hive_ed25519.PublicKey(iotago_tpkg.RandEd25519PrivateKey().Public().(ed25519.PublicKey))).Bytes()
+	require.NoError(t, err)
+
 	output := &iotago.AccountOutput{
 		Amount:         iotago.BaseToken(iotago_tpkg.RandUint64(uint64(iotago_tpkg.ZeroCostTestAPI.ProtocolParameters().TokenSupply()))),
 		Mana:           iotago.Mana(iotago_tpkg.RandUint64(math.MaxUint64)),
@@ -46,9 +53,9 @@ func TestIndexer_NewAccountOutput(t *testing.T) {
 			},
 			&iotago.BlockIssuerFeature{
 				BlockIssuerKeys: iotago.BlockIssuerKeys{
-					iotago.Ed25519PublicKeyHashBlockIssuerKeyFromPublicKey(hive_ed25519.PublicKey(iotago_tpkg.RandEd25519PrivateKey().Public().(ed25519.PublicKey))),
+					blockIssuerKey,
 				},
-				ExpirySlot: 0,
+				ExpirySlot: 100,
 			},
 		},
 		ImmutableFeatures: iotago.AccountOutputImmFeatures{
@@ -95,6 +102,18 @@ func TestIndexer_NewAccountOutput(t *testing.T) {
 	// Issuer
 	outputSet.requireAccountFound(indexer.AccountIssuer(issuerAddress))
 	outputSet.requireAccountNotFound(indexer.AccountIssuer(randomAddress))
+
+	// Block Issuer
+	outputSet.requireAccountFound(indexer.AccountIsBlockIssuer(true))
+	outputSet.requireAccountNotFound(indexer.AccountIsBlockIssuer(false))
+
+	outputSet.requireAccountFound(indexer.AccountBlockIssuerExpiresBefore(101))
+	outputSet.requireAccountNotFound(indexer.AccountBlockIssuerExpiresBefore(100))
+	outputSet.requireAccountFound(indexer.AccountBlockIssuerExpiresAfter(99))
+	outputSet.requireAccountNotFound(indexer.AccountBlockIssuerExpiresAfter(100))
+
+	outputSet.requireAccountFound(indexer.AccountBlockIssuerKey(blockIssuerKeyBytes))
+	outputSet.requireAccountNotFound(indexer.AccountBlockIssuerKey(randomBlockIssuerKeyBytes))
 }
 
 func TestIndexer_ExistingAccountOutput(t *testing.T) {
@@ -251,3 +270,50 @@ func TestIndexer_MutateExistingAccount(t *testing.T) {
 	ts.requireFound(newOutputID)
 	ts.requireFound(foundryOutputID)
 }
+
+func TestIndexer_AccountBlockIssuerKeys(t *testing.T) {
+	ts := newTestSuite(t)
+
+	sharedKey := iotago.Ed25519PublicKeyHashBlockIssuerKeyFromPublicKey(hive_ed25519.PublicKey(iotago_tpkg.RandEd25519PrivateKey().Public().(ed25519.PublicKey)))
+	sharedKeyBytes, err := sharedKey.Bytes()
+	require.NoError(t, err)
+
+	secondKey := iotago.Ed25519PublicKeyHashBlockIssuerKeyFromPublicKey(hive_ed25519.PublicKey(iotago_tpkg.RandEd25519PrivateKey().Public().(ed25519.PublicKey)))
+	secondKeyBytes, err := secondKey.Bytes()
+	require.NoError(t, err)
+
+	blockIssuerOutput := func(keys ...iotago.BlockIssuerKey) *iotago.AccountOutput {
+		output := accountOutputWithAddress(iotago_tpkg.RandEd25519Address()).(*iotago.AccountOutput)
+		output.Features = iotago.AccountOutputFeatures{
+			&iotago.BlockIssuerFeature{
+				BlockIssuerKeys: keys,
+				ExpirySlot:      100,
+			},
+		}
+
+		return output
+	}
+
+	// An account can have several keys
+	firstOutputID := iotago_tpkg.RandOutputID(0)
+	firstOutputSet := ts.AddOutputOnCommitment(blockIssuerOutput(sharedKey, secondKey), firstOutputID)
+	firstOutputSet.requireAccountFound(indexer.AccountBlockIssuerKey(sharedKeyBytes))
+	firstOutputSet.requireAccountFound(indexer.AccountBlockIssuerKey(secondKeyBytes))
+
+	// Accounts without the feature are no block issuers
+	plainOutputSet := ts.AddOutputOnCommitment(accountOutputWithAddress(iotago_tpkg.RandEd25519Address()), iotago_tpkg.RandOutputID(0))
+	plainOutputSet.requireAccountFound(indexer.AccountIsBlockIssuer(false))
+	firstOutputSet.requireAccountFound(indexer.AccountIsBlockIssuer(true))
+
+	// Keys of uncommitted accounts are removed together with the account
+	ts.AddOutputOnAcceptance(blockIssuerOutput(sharedKey), iotago_tpkg.RandOutputID(0), ts.CurrentSlot()+1)
+	require.Len(t, ts.Indexer.Account(indexer.AccountBlockIssuerKey(sharedKeyBytes)).OutputIDs, 2)
+
+	require.NoError(t, ts.Indexer.RemoveUncommittedChanges())
+	firstOutputSet.requireAccountFound(indexer.AccountBlockIssuerKey(sharedKeyBytes))
+
+	// Keys of spent accounts are removed
+	ts.DeleteOutputOnCommitment(firstOutputID)
+	require.Empty(t, ts.Indexer.Account(indexer.AccountBlockIssuerKey(sharedKeyBytes)).OutputIDs)
+	require.Empty(t, ts.Indexer.Account(indexer.AccountBlockIssuerKey(secondKeyBytes)).OutputIDs)
+}
@@ -0,0 +1,125 @@
+package indexer
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+// addressRole defines in which way an address is referenced by an output.
+type addressRole uint8
+
+const (
+	addressRoleUnlock addressRole = iota
+	addressRoleStorageDepositReturn
+	addressRoleExpirationReturn
+	addressRoleStateController
+	addressRoleGovernor
+	addressRoleImmutableAccount
+)
+
+// addressRolesUnlockable are the roles that allow an address to unlock an output.
+var addressRolesUnlockable = []addressRole{
+	addressRoleUnlock,
+	addressRoleStorageDepositReturn,
+	addressRoleExpirationReturn,
+	addressRoleStateController,
+	addressRoleGovernor,
+}
+
+// addressRef references an output from an address found in its unlock conditions.
+// The primary key allows looking up all outputs of a given type referenced by an address with a single index scan.
+// The sort columns of the outputs are copied, so that the outputs of all types referenced by an address
+// can be paginated with a single scan of the (address_id, sort column, output_id) indexes.
+// The references live and die with the output row they belong to.
+type addressRef struct {
+	AddressID     []byte            `gorm:"primaryKey;notnull;index:address_refs_address_id_created_at_slot_output_id,priority:1;index:address_refs_address_id_amount_output_id,priority:1"`
+	OutputType    iotago.OutputType `gorm:"primaryKey;notnull"`
+	Role          addressRole       `gorm:"primaryKey;notnull"`
+	OutputID      []byte            `gorm:"primaryKey;notnull;index:address_refs_output_id;index:address_refs_address_id_created_at_slot_output_id,priority:3;index:address_refs_address_id_amount_output_id,priority:3"`
+	CreatedAtSlot iotago.SlotIndex  `gorm:"notnull;index:address_refs_address_id_created_at_slot_output_id,priority:2"`
+	Amount        iotago.BaseToken  `gorm:"notnull;index:address_refs_address_id_amount_output_id,priority:2"`
+}
+
+func (r *addressRef) String() string {
+	return fmt.Sprintf("address ref => AddressID: %s, OutputID: %s, Role: %d", hex.EncodeToString(r.AddressID), hex.EncodeToString(r.OutputID), r.Role)
+}
+
+func addressRefsForOutput(outputID iotago.OutputID, output iotago.Output, slotBooked iotago.SlotIndex) []*addressRef {
+	var refs []*addressRef
+	addRef := func(address iotago.Address, role addressRole) {
+		refs = append(refs, &addressRef{
+			AddressID:     address.ID(),
+			OutputType:    output.Type(),
+			Role:          role,
+			OutputID:      outputID[:],
+			CreatedAtSlot: slotBooked,
+			Amount:        output.BaseTokenAmount(),
+		})
+	}
+
+	conditions := output.UnlockConditionSet()
+	if addressUnlock := conditions.Address(); addressUnlock != nil {
+		addRef(addressUnlock.Address, addressRoleUnlock)
+	}
+	if storageDepositReturn := conditions.StorageDepositReturn(); storageDepositReturn != nil {
+		addRef(storageDepositReturn.ReturnAddress, addressRoleStorageDepositReturn)
+	}
+	if expiration := conditions.Expiration(); expiration != nil {
+		addRef(expiration.ReturnAddress, addressRoleExpirationReturn)
+	}
+	if stateController := conditions.StateControllerAddress(); stateController != nil {
+		addRef(stateController.Address, addressRoleStateController)
+	}
+	if governor := conditions.GovernorAddress(); governor != nil {
+		addRef(governor.Address, addressRoleGovernor)
+	}
+	if accountUnlock := conditions.ImmutableAccount(); accountUnlock != nil {
+		addRef(accountUnlock.Address, addressRoleImmutableAccount)
+	}
+
+	return refs
+}
+
+func insertAddressRefsFromOutput(tx *gorm.DB, output *LedgerOutput) error {
+	refs := addressRefsForOutput(output.OutputID, output.Output, output.BookedAt)
+	if len(refs) == 0 {
+		return nil
+	}
+
+	// The references might still be in the database from a previous uncommitted state
+	return tx.Clauses(clause.OnConflict{DoNothing: true}).Create(refs).Error
+}
+
+// addressIDs returns the IDs of the addresses as they are stored in the address references.
+func addressIDs(addresses []iotago.Address) []bytesValue {
+	ids := make([]bytesValue, 0, len(addresses))
+	for _, address := range addresses {
+		ids = append(ids, address.ID())
+	}
+
+	return ids
+}
+
+// addressRefQuery returns a subquery selecting the outputIDs of the given type that reference the address in one of the given roles.
+func (i *Indexer) addressRefQuery(address iotago.Address, outputType iotago.OutputType, roles ...addressRole) *gorm.DB {
+	return i.addressRefsQueryWithCondition(i.db.Model(&addressRef{}).Where("address_id = ?", address.ID()), outputType, roles...)
+}
+
+// addressRefsQuery returns a subquery selecting the outputIDs of the given type that reference any of the addresses in one of the given roles.
+func (i *Indexer) addressRefsQuery(addresses []iotago.Address, outputType iotago.OutputType, roles ...addressRole) *gorm.DB {
+	return i.addressRefsQueryWithCondition(i.db.Model(&addressRef{}).Where("address_id IN ?", addressIDs(addresses)), outputType, roles...)
+}
+
+func (i *Indexer) addressRefsQueryWithCondition(query *gorm.DB, outputType iotago.OutputType, roles ...addressRole) *gorm.DB {
+	query = query.Select("output_id").Where("output_type = ?", outputType)
+	if len(roles) == 1 {
+		return query.Where("role = ?", roles[0])
+	}
+
+	return query.Where("role IN ?", roles)
+}
@@ -0,0 +1,143 @@
+package indexer_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/hive.go/runtime/options"
+
+	"github.com/iotaledger/inx-indexer/pkg/indexer"
+	iotago "github.com/iotaledger/iota.go/v4"
+	iotago_tpkg "github.com/iotaledger/iota.go/v4/tpkg"
+)
+
+func basicOutputWithExpiration(address iotago.Address, returnAddress iotago.Address) iotago.Output {
+	output := basicOutputWithAddress(address).(*iotago.BasicOutput)
+	output.UnlockConditions = append(output.UnlockConditions, &iotago.ExpirationUnlockCondition{
+		ReturnAddress: returnAddress,
+		Slot:          100,
+	})
+
+	return output
+}
+
+func TestIndexer_AddressRefs_Import(t *testing.T) {
+	ts := newTestSuite(t)
+
+	address := iotago_tpkg.RandEd25519Address()
+	returnAddress := iotago_tpkg.RandEd25519Address()
+	basicOutputID := iotago_tpkg.RandOutputID(0)
+	anchorOutputID := iotago_tpkg.RandOutputID(0)
+
+	tx := ts.Indexer.ImportTransaction(context.Background())
+	require.NoError(t, tx.AddOutput(basicOutputID, basicOutputWithExpiration(address, returnAddress), 1))
+	require.NoError(t, tx.AddOutput(anchorOutputID, anchorOutputWithAddress(address), 1))
+	require.NoError(t, tx.Finalize(1, t.Name(), 1))
+
+	result := ts.Indexer.Combined(indexer.CombinedUnlockableByAddress(address))
+	require.NoError(t, result.Error)
+	require.ElementsMatch(t, iotago.OutputIDs{basicOutputID, anchorOutputID}, result.OutputIDs)
+
+	result = ts.Indexer.Combined(indexer.CombinedUnlockableByAddress(returnAddress))
+	require.NoError(t, result.Error)
+	require.Equal(t, iotago.OutputIDs{basicOutputID}, result.OutputIDs)
+
+	result = ts.Indexer.Basic(indexer.BasicUnlockAddress(returnAddress))
+	require.NoError(t, result.Error)
+	require.Empty(t, result.OutputIDs)
+}
+
+func TestIndexer_AddressRefs_Uncommitted(t *testing.T) {
+	ts := newTestSuite(t)
+
+	oldAddress := iotago_tpkg.RandEd25519Address()
+	newAddress := iotago_tpkg.RandEd25519Address()
+	outputID := iotago_tpkg.RandOutputID(0)
+
+	ts.CommitEmptyLedgerUpdate() // Slot 1
+
+	ts.AddOutputOnAcceptance(basicOutputWithAddress(oldAddress), outputID, 2).requireBasicFound(indexer.BasicUnlockableByAddress(oldAddress))
+
+	// The references of outputs that were never committed are removed together with the outputs
+	require.NoError(t, ts.Indexer.RemoveUncommittedChanges())
+
+	output := ts.AddOutputOnCommitment(basicOutputWithAddress(newAddress), outputID)
+	output.requireBasicFound(indexer.BasicUnlockableByAddress(newAddress))
+	output.requireBasicNotFound(indexer.BasicUnlockableByAddress(oldAddress))
+	output.requireBasicNotFound(indexer.BasicUnlockAddress(oldAddress))
+}
+
+func TestIndexer_AddressRefs_UnlockableByAnyAddress(t *testing.T) {
+	ts := newTestSuite(t)
+
+	address := iotago_tpkg.RandEd25519Address()
+	returnAddress := iotago_tpkg.RandEd25519Address()
+	otherAddress := iotago_tpkg.RandEd25519Address()
+
+	// The first output references both addresses, but is only returned once
+	outputIDs := iotago.OutputIDs{iotago_tpkg.RandOutputID(0), iotago_tpkg.RandOutputID(0), iotago_tpkg.RandOutputID(0)}
+	ts.AddOutputOnCommitment(basicOutputWithExpiration(address, returnAddress), outputIDs[0])
+	ts.AddOutputOnCommitment(nftOutputWithAddressAndSender(returnAddress), outputIDs[1])
+	ts.AddOutputOnCommitment(accountOutputWithAddress(address), outputIDs[2])
+	ts.AddOutputOnCommitment(basicOutputWithAddress(otherAddress), iotago_tpkg.RandOutputID(0))
+
+	var foundOutputIDs iotago.OutputIDs
+	opts := []options.Option[indexer.CombinedFilterOptions]{
+		indexer.CombinedUnlockableByAnyAddress(address, returnAddress),
+		indexer.CombinedPageSize(2),
+	}
+	for {
+		result := ts.Indexer.Combined(opts...)
+		require.NoError(t, result.Error)
+		foundOutputIDs = append(foundOutputIDs, result.OutputIDs...)
+
+		if result.Cursor == nil {
+			break
+		}
+		opts = append(opts, indexer.CombinedCursor(*result.Cursor))
+	}
+	require.ElementsMatch(t, outputIDs, foundOutputIDs)
+
+	count := ts.Indexer.CombinedCount(indexer.CombinedUnlockableByAnyAddress(address, returnAddress))
+	require.NoError(t, count.Error)
+	require.Equal(t, uint64(len(outputIDs)), count.Count)
+
+	// The other filters still apply
+	result := ts.Indexer.Combined(indexer.CombinedUnlockableByAnyAddress(address, returnAddress), indexer.CombinedUnlockableByAddress(returnAddress))
+	require.NoError(t, result.Error)
+	require.ElementsMatch(t, outputIDs[:2], result.OutputIDs)
+}
+
+// BenchmarkIndexer_UnlockableByAddress measures the lookup of the outputs unlockable by an address among many unrelated outputs.
+func BenchmarkIndexer_UnlockableByAddress(b *testing.B) {
+	const (
+		unrelatedOutputs = 20_000
+		addressOutputs   = 100
+	)
+
+	ts := newTestSuite(b)
+
+	address := iotago_tpkg.RandEd25519Address()
+
+	tx := ts.Indexer.ImportTransaction(context.Background())
+	for range unrelatedOutputs {
+		require.NoError(b, tx.AddOutput(iotago_tpkg.RandOutputID(0), basicOutputWithExpiration(iotago_tpkg.RandEd25519Address(), iotago_tpkg.RandEd25519Address()), 1))
+	}
+	for idx := range addressOutputs {
+		output := basicOutputWithAddress(address)
+		if idx%2 == 0 {
+			output = basicOutputWithExpiration(iotago_tpkg.RandEd25519Address(), address)
+		}
+		require.NoError(b, tx.AddOutput(iotago_tpkg.RandOutputID(0), output, 1))
+	}
+	require.NoError(b, tx.Finalize(1, b.Name(), 1))
+
+	b.ResetTimer()
+	for range b.N {
+		result := ts.Indexer.Combined(indexer.CombinedUnlockableByAddress(address), indexer.CombinedPageSize(addressOutputs))
+		require.NoError(b, result.Error)
+		require.Len(b, result.OutputIDs, addressOutputs)
+	}
+}
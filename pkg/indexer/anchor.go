@@ -11,15 +11,16 @@ import (
 )
 
 type anchor struct {
-	OutputID        []byte `gorm:"primaryKey;notnull"`
-	AnchorID        []byte `gorm:"notnull"`
-	Amount          iotago.BaseToken
+	OutputID        []byte           `gorm:"primaryKey;notnull;index:anchors_created_at_slot_output_id,priority:2;index:anchors_amount_output_id,priority:2"`
+	AnchorID        []byte           `gorm:"notnull"`
+	Amount          iotago.BaseToken `gorm:"index:anchors_amount_output_id,priority:1"`
 	StateController []byte           `gorm:"notnull;index:anchors_state_controller"`
 	Governor        []byte           `gorm:"notnull;index:anchors_governor"`
 	Issuer          []byte           `gorm:"index:anchors_issuer"`
 	Sender          []byte           `gorm:"index:anchors_sender"`
-	CreatedAtSlot   iotago.SlotIndex `gorm:"notnull;index:anchors_created_at_slot"`
+	CreatedAtSlot   iotago.SlotIndex `gorm:"notnull;index:anchors_created_at_slot_output_id,priority:1"`
 	DeletedAtSlot   iotago.SlotIndex `gorm:"notnull;index:anchors_deleted_at_slot"`
+	SpentAtSlot     iotago.SlotIndex `gorm:"notnull;index:anchors_spent_at_slot"`
 	Committed       bool
 }
 
@@ -35,8 +36,12 @@ type AnchorFilterOptions struct {
 	sender              iotago.Address
 	pageSize            uint32
 	cursor              *string
+	sortOrder           SortOrder
 	createdBefore       *iotago.SlotIndex
 	createdAfter        *iotago.SlotIndex
+	minAmount           *iotago.BaseToken
+	maxAmount           *iotago.BaseToken
+	asOfSlot            *iotago.SlotIndex
 }
 
 func AnchorUnlockableByAddress(address iotago.Address) options.Option[AnchorFilterOptions] {
@@ -69,6 +74,20 @@ func AnchorIssuer(address iotago.Address) options.Option[AnchorFilterOptions] {
 	}
 }
 
+// AnchorMinAmount filters for outputs holding at least the given amount of base tokens.
+func AnchorMinAmount(amount iotago.BaseToken) options.Option[AnchorFilterOptions] {
+	return func(args *AnchorFilterOptions) {
+		args.minAmount = &amount
+	}
+}
+
+// AnchorMaxAmount filters for outputs holding at most the given amount of base tokens.
+func AnchorMaxAmount(amount iotago.BaseToken) options.Option[AnchorFilterOptions] {
+	return func(args *AnchorFilterOptions) {
+		args.maxAmount = &amount
+	}
+}
+
 func AnchorPageSize(pageSize uint32) options.Option[AnchorFilterOptions] {
 	return func(args *AnchorFilterOptions) {
 		args.pageSize = pageSize
@@ -81,6 +100,13 @@ func AnchorCursor(cursor string) options.Option[AnchorFilterOptions] {
 	}
 }
 
+// AnchorSortOrder defines the order in which the outputIDs are returned.
+func AnchorSortOrder(sortOrder SortOrder) options.Option[AnchorFilterOptions] {
+	return func(args *AnchorFilterOptions) {
+		args.sortOrder = sortOrder
+	}
+}
+
 func AnchorCreatedBefore(slot iotago.SlotIndex) options.Option[AnchorFilterOptions] {
 	return func(args *AnchorFilterOptions) {
 		args.createdBefore = &slot
@@ -93,20 +119,31 @@ func AnchorCreatedAfter(slot iotago.SlotIndex) options.Option[AnchorFilterOption
 	}
 }
 
-func (i *Indexer) AnchorByID(anchorID iotago.AnchorID) *IndexerResult {
-	query := i.db.Model(&anchor{}).
+func AnchorAsOfSlot(slot iotago.SlotIndex) options.Option[AnchorFilterOptions] {
+	return func(args *AnchorFilterOptions) {
+		args.asOfSlot = &slot
+	}
+}
+
+// AnchorByID returns the output of the given AnchorID. Only the AnchorAsOfSlot filter is taken into account.
+func (i *Indexer) AnchorByID(anchorID iotago.AnchorID, filters ...options.Option[AnchorFilterOptions]) *IndexerResult {
+	opts := options.Apply(&AnchorFilterOptions{}, filters)
+	if err := i.checkAsOfSlot(opts.asOfSlot); err != nil {
+		return errorResult(err)
+	}
+
+	query := chainOutputAtSlotQuery(i.db.Model(&anchor{}), opts.asOfSlot).
 		Where("anchor_id = ?", anchorID[:]).
 		Limit(1)
 
-	return i.combineOutputIDFilteredQuery(query, 0, nil)
+	return i.combineOutputIDFilteredQuery(query, 0, nil, SortCreatedAscending)
 }
 
 func (i *Indexer) anchorQueryWithFilter(opts *AnchorFilterOptions) *gorm.DB {
-	query := i.db.Model(&anchor{}).Where("deleted_at_slot = 0")
+	query := unspentAtSlotQuery(i.db.Model(&anchor{}), opts.asOfSlot)
 
 	if opts.unlockableByAddress != nil {
-		addrID := opts.unlockableByAddress.ID()
-		query = query.Where("(state_controller = ? OR governor = ?)", addrID, addrID)
+		query = query.Where("output_id IN (?)", i.addressRefQuery(opts.unlockableByAddress, iotago.OutputAnchor, addressRolesUnlockable...))
 	}
 
 	if opts.stateController != nil {
@@ -125,6 +162,14 @@ func (i *Indexer) anchorQueryWithFilter(opts *AnchorFilterOptions) *gorm.DB {
 		query = query.Where("issuer = ?", opts.issuer.ID())
 	}
 
+	if opts.minAmount != nil {
+		query = query.Where("amount >= ?", *opts.minAmount)
+	}
+
+	if opts.maxAmount != nil {
+		query = query.Where("amount <= ?", *opts.maxAmount)
+	}
+
 	if opts.createdBefore != nil {
 		query = query.Where("created_at_slot < ?", *opts.createdBefore)
 	}
@@ -140,7 +185,43 @@ func (i *Indexer) Anchor(filters ...options.Option[AnchorFilterOptions]) *Indexe
 	opts := options.Apply(&AnchorFilterOptions{
 		pageSize: DefaultPageSize,
 	}, filters)
+	if err := i.checkAsOfSlot(opts.asOfSlot); err != nil {
+		return errorResult(err)
+	}
+
 	query := i.anchorQueryWithFilter(opts)
 
-	return i.combineOutputIDFilteredQuery(query, opts.pageSize, opts.cursor)
+	return i.combineOutputIDFilteredQuery(query, opts.pageSize, opts.cursor, opts.sortOrder)
+}
+
+// AnchorCount returns the amount of outputs matching the filters. The page size, cursor and sort order are ignored.
+func (i *Indexer) AnchorCount(filters ...options.Option[AnchorFilterOptions]) *CountResult {
+	opts := options.Apply(&AnchorFilterOptions{}, filters)
+	if err := i.checkAsOfSlot(opts.asOfSlot); err != nil {
+		return errorCountResult(err)
+	}
+
+	return i.countQueries([]*gorm.DB{i.anchorQueryWithFilter(opts)})
+}
+
+// AnchorSubscription subscribes to the events of the anchor outputs matching the filters. The page size, cursor, sort order and asOfSlot are ignored.
+func (i *Indexer) AnchorSubscription(filters ...options.Option[AnchorFilterOptions]) *OutputSubscription {
+	opts := options.Apply(&AnchorFilterOptions{}, filters)
+
+	return i.subscribe(opts.matchesOutput)
+}
+
+// matchesOutput matches the filters against the rows of an output in the same way anchorQueryWithFilter does.
+func (o *AnchorFilterOptions) matchesOutput(rows *outputRows) bool {
+	entry, isAnchor := rows.entry.(*anchor)
+	if !isAnchor {
+		return false
+	}
+
+	return rows.referencedBy(o.unlockableByAddress, addressRolesUnlockable...) &&
+		matchesAddress(entry.StateController, o.stateController) &&
+		matchesAddress(entry.Governor, o.governor) &&
+		matchesAddress(entry.Sender, o.sender) &&
+		matchesAddress(entry.Issuer, o.issuer) &&
+		matchesCommonFilters(entry.Amount, entry.CreatedAtSlot, o.minAmount, o.maxAmount, o.createdBefore, o.createdAfter)
 }
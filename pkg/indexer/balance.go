@@ -0,0 +1,200 @@
+package indexer
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"gorm.io/gorm"
+
+	"github.com/iotaledger/hive.go/ierrors"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+// Balance contains the aggregated amounts of a set of outputs.
+type Balance struct {
+	BaseTokens   iotago.BaseToken
+	NativeTokens map[iotago.NativeTokenID]*big.Int
+	OutputCounts map[iotago.OutputType]uint64
+}
+
+func newBalance() *Balance {
+	return &Balance{
+		NativeTokens: make(map[iotago.NativeTokenID]*big.Int),
+		OutputCounts: make(map[iotago.OutputType]uint64),
+	}
+}
+
+func (b *Balance) addNativeToken(nativeTokenID iotago.NativeTokenID, amount *big.Int) {
+	if current, exists := b.NativeTokens[nativeTokenID]; exists {
+		current.Add(current, amount)
+		return
+	}
+
+	b.NativeTokens[nativeTokenID] = new(big.Int).Set(amount)
+}
+
+// AddressBalance contains the balance of an address split into the outputs that are directly owned by the address
+// and the outputs that can be unlocked by the address via an expiration or storage deposit return unlock condition.
+type AddressBalance struct {
+	Owned         *Balance
+	Unlockable    *Balance
+	CommittedSlot iotago.SlotIndex
+}
+
+type balanceQueryResult struct {
+	Count  uint64
+	Amount iotago.BaseToken
+}
+
+type nativeTokenQueryResult struct {
+	NativeToken       []byte
+	NativeTokenAmount string
+}
+
+// addOutputsToBalance aggregates the count and the base token amounts of all outputs matching the query.
+func addOutputsToBalance(tx *gorm.DB, balance *Balance, outputType iotago.OutputType, query *gorm.DB) error {
+	var result balanceQueryResult
+	if err := tx.Table("(?) as outputs", query).Select("COUNT(*) as count, COALESCE(SUM(amount), 0) as amount").Scan(&result).Error; err != nil {
+		return err
+	}
+
+	balance.BaseTokens += result.Amount
+	if result.Count > 0 {
+		balance.OutputCounts[outputType] += result.Count
+	}
+
+	return nil
+}
+
+// scanRows calls f for every row of the query.
+// The rows are scanned one by one instead of being loaded at once, so that the memory doesn't grow with the amount of rows.
+func scanRows[T any](tx *gorm.DB, query *gorm.DB, f func(row *T) error) error {
+	rows, err := query.Rows()
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var row T
+		if err := tx.ScanRows(rows, &row); err != nil {
+			return err
+		}
+
+		if err := f(&row); err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
+
+// addNativeTokensToBalance sums up the native tokens of all outputs matching the query.
+// The query needs to select the native token ID as "native_token" and the hex encoded amount as "native_token_amount".
+// The amounts are 256 bit integers that can't be summed up by all database engines, so they are summed up while the rows are scanned.
+func addNativeTokensToBalance(tx *gorm.DB, balance *Balance, query *gorm.DB) error {
+	return scanRows(tx, tx.Table("(?) as outputs", query).Where("native_token_amount IS NOT NULL"), func(result *nativeTokenQueryResult) error {
+		if len(result.NativeToken) != iotago.NativeTokenIDLength {
+			return ierrors.Errorf("invalid native token ID length: %d", len(result.NativeToken))
+		}
+
+		amount, err := hexutil.DecodeBig(result.NativeTokenAmount)
+		if err != nil {
+			return ierrors.Wrapf(err, "invalid native token amount: %s", result.NativeTokenAmount)
+		}
+
+		balance.addNativeToken(iotago.NativeTokenID(result.NativeToken), amount)
+
+		return nil
+	})
+}
+
+func (i *Indexer) ownedBalanceForAddress(tx *gorm.DB, address iotago.Address) (*Balance, error) {
+	balance := newBalance()
+
+	basicQuery := i.basicQueryWithFilter(&BasicFilterOptions{address: address})
+	if err := addOutputsToBalance(tx, balance, iotago.OutputBasic, basicQuery); err != nil {
+		return nil, err
+	}
+	if err := addNativeTokensToBalance(tx, balance, basicQuery.Select("native_token", "native_token_amount")); err != nil {
+		return nil, err
+	}
+
+	if err := addOutputsToBalance(tx, balance, iotago.OutputNFT, i.nftQueryWithFilter(&NFTFilterOptions{address: address})); err != nil {
+		return nil, err
+	}
+
+	if err := addOutputsToBalance(tx, balance, iotago.OutputAccount, i.accountQueryWithFilter(&AccountFilterOptions{address: address})); err != nil {
+		return nil, err
+	}
+
+	if err := addOutputsToBalance(tx, balance, iotago.OutputAnchor, i.anchorQueryWithFilter(&AnchorFilterOptions{unlockableByAddress: address})); err != nil {
+		return nil, err
+	}
+
+	if err := addOutputsToBalance(tx, balance, iotago.OutputDelegation, i.delegationQueryWithFilter(&DelegationFilterOptions{address: address})); err != nil {
+		return nil, err
+	}
+
+	// Foundries can only be owned by accounts, and they can only hold their own native token
+	if accountAddress, isAccount := address.(*iotago.AccountAddress); isAccount {
+		foundryQuery := i.foundryOutputsQueryWithFilter(&FoundryFilterOptions{account: accountAddress})
+		if err := addOutputsToBalance(tx, balance, iotago.OutputFoundry, foundryQuery); err != nil {
+			return nil, err
+		}
+		if err := addNativeTokensToBalance(tx, balance, foundryQuery.Select("foundry_id as native_token", "native_token_amount")); err != nil {
+			return nil, err
+		}
+	}
+
+	return balance, nil
+}
+
+func (i *Indexer) unlockableBalanceForAddress(tx *gorm.DB, address iotago.Address) (*Balance, error) {
+	balance := newBalance()
+	addrID := address.ID()
+
+	// All outputs that are unlockable by the address, but not owned by it, can only be unlocked via the return address
+	// of an expiration or storage deposit return unlock condition.
+	basicQuery := i.basicQueryWithFilter(&BasicFilterOptions{unlockableByAddress: address}).Where("address <> ?", addrID)
+	if err := addOutputsToBalance(tx, balance, iotago.OutputBasic, basicQuery); err != nil {
+		return nil, err
+	}
+	if err := addNativeTokensToBalance(tx, balance, basicQuery.Select("native_token", "native_token_amount")); err != nil {
+		return nil, err
+	}
+
+	nftQuery := i.nftQueryWithFilter(&NFTFilterOptions{unlockableByAddress: address}).Where("address <> ?", addrID)
+	if err := addOutputsToBalance(tx, balance, iotago.OutputNFT, nftQuery); err != nil {
+		return nil, err
+	}
+
+	return balance, nil
+}
+
+// BalanceForAddress returns the aggregated balance of all unspent outputs that can be unlocked by the given address.
+// The balance is aggregated in a single transaction, so that it matches the returned committed slot.
+func (i *Indexer) BalanceForAddress(address iotago.Address) (*AddressBalance, error) {
+	balance := &AddressBalance{}
+	if err := i.readTransaction(func(tx *gorm.DB) error {
+		status, err := i.statusWithDB(tx)
+		if err != nil {
+			return err
+		}
+		balance.CommittedSlot = status.CommittedSlot
+
+		if balance.Owned, err = i.ownedBalanceForAddress(tx, address); err != nil {
+			return err
+		}
+
+		if balance.Unlockable, err = i.unlockableBalanceForAddress(tx, address); err != nil {
+			return err
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return balance, nil
+}
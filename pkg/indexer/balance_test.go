@@ -0,0 +1,132 @@
+package indexer_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	iotago "github.com/iotaledger/iota.go/v4"
+	iotago_tpkg "github.com/iotaledger/iota.go/v4/tpkg"
+)
+
+func TestIndexer_BalanceForAddress(t *testing.T) {
+	ts := newTestSuite(t)
+
+	address := iotago_tpkg.RandEd25519Address()
+	otherAddress := iotago_tpkg.RandEd25519Address()
+	nativeTokenID := iotago_tpkg.RandNativeTokenID()
+
+	// Directly owned basic output with native tokens
+	ts.AddOutputOnCommitment(&iotago.BasicOutput{
+		Amount: 1000,
+		UnlockConditions: iotago.BasicOutputUnlockConditions{
+			&iotago.AddressUnlockCondition{
+				Address: address,
+			},
+		},
+		Features: iotago.BasicOutputFeatures{
+			&iotago.NativeTokenFeature{
+				ID:     nativeTokenID,
+				Amount: big.NewInt(50),
+			},
+		},
+	}, iotago_tpkg.RandOutputID(0))
+
+	// Directly owned basic output with the same native token
+	ts.AddOutputOnCommitment(&iotago.BasicOutput{
+		Amount: 500,
+		UnlockConditions: iotago.BasicOutputUnlockConditions{
+			&iotago.AddressUnlockCondition{
+				Address: address,
+			},
+		},
+		Features: iotago.BasicOutputFeatures{
+			&iotago.NativeTokenFeature{
+				ID:     nativeTokenID,
+				Amount: big.NewInt(25),
+			},
+		},
+	}, iotago_tpkg.RandOutputID(0))
+
+	// Directly owned NFT output
+	ts.AddOutputOnCommitment(nftOutputWithAddressAndSender(address), iotago_tpkg.RandOutputID(0))
+
+	// Basic output that can be unlocked via the expiration return address
+	ts.AddOutputOnCommitment(&iotago.BasicOutput{
+		Amount: 300,
+		UnlockConditions: iotago.BasicOutputUnlockConditions{
+			&iotago.AddressUnlockCondition{
+				Address: otherAddress,
+			},
+			&iotago.ExpirationUnlockCondition{
+				ReturnAddress: address,
+				Slot:          100,
+			},
+		},
+	}, iotago_tpkg.RandOutputID(0))
+
+	// Basic output owned by another address
+	ts.AddOutputOnCommitment(basicOutputWithAddress(otherAddress), iotago_tpkg.RandOutputID(0))
+
+	balance, err := ts.Indexer.BalanceForAddress(address)
+	require.NoError(t, err)
+	require.Equal(t, ts.CurrentSlot(), balance.CommittedSlot)
+
+	require.Equal(t, iotago.BaseToken(1000+500+100000), balance.Owned.BaseTokens)
+	require.Equal(t, map[iotago.NativeTokenID]*big.Int{nativeTokenID: big.NewInt(75)}, balance.Owned.NativeTokens)
+	require.Equal(t, map[iotago.OutputType]uint64{
+		iotago.OutputBasic: 2,
+		iotago.OutputNFT:   1,
+	}, balance.Owned.OutputCounts)
+
+	require.Equal(t, iotago.BaseToken(300), balance.Unlockable.BaseTokens)
+	require.Empty(t, balance.Unlockable.NativeTokens)
+	require.Equal(t, map[iotago.OutputType]uint64{
+		iotago.OutputBasic: 1,
+	}, balance.Unlockable.OutputCounts)
+
+	// An unknown address has no balance
+	balance, err = ts.Indexer.BalanceForAddress(iotago_tpkg.RandEd25519Address())
+	require.NoError(t, err)
+	require.Zero(t, balance.Owned.BaseTokens)
+	require.Empty(t, balance.Owned.OutputCounts)
+	require.Zero(t, balance.Unlockable.BaseTokens)
+}
+
+func TestIndexer_BalanceForAddress_Foundry(t *testing.T) {
+	ts := newTestSuite(t)
+
+	accountAddress := iotago_tpkg.RandAccountAddress()
+	foundryID, err := iotago.FoundryIDFromAddressAndSerialNumberAndTokenScheme(accountAddress, 0, iotago.TokenSchemeSimple)
+	require.NoError(t, err)
+
+	ts.AddOutputOnCommitment(&iotago.FoundryOutput{
+		Amount: 100,
+		TokenScheme: &iotago.SimpleTokenScheme{
+			MintedTokens:  big.NewInt(100),
+			MeltedTokens:  big.NewInt(0),
+			MaximumSupply: big.NewInt(1000),
+		},
+		UnlockConditions: iotago.FoundryOutputUnlockConditions{
+			&iotago.ImmutableAccountUnlockCondition{
+				Address: accountAddress,
+			},
+		},
+		Features: iotago.FoundryOutputFeatures{
+			&iotago.NativeTokenFeature{
+				ID:     foundryID,
+				Amount: big.NewInt(40),
+			},
+		},
+	}, iotago_tpkg.RandOutputID(0))
+
+	balance, err := ts.Indexer.BalanceForAddress(accountAddress)
+	require.NoError(t, err)
+
+	require.Equal(t, iotago.BaseToken(100), balance.Owned.BaseTokens)
+	require.Equal(t, map[iotago.NativeTokenID]*big.Int{foundryID: big.NewInt(40)}, balance.Owned.NativeTokens)
+	require.Equal(t, map[iotago.OutputType]uint64{
+		iotago.OutputFoundry: 1,
+	}, balance.Owned.OutputCounts)
+}
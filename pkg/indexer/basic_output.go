@@ -11,9 +11,9 @@ import (
 )
 
 type basic struct {
-	OutputID                    []byte `gorm:"primaryKey;notnull"`
-	Amount                      iotago.BaseToken
-	NativeToken                 []byte
+	OutputID                    []byte           `gorm:"primaryKey;notnull;index:basics_created_at_slot_output_id,priority:2;index:basics_amount_output_id,priority:2"`
+	Amount                      iotago.BaseToken `gorm:"index:basics_amount_output_id,priority:1"`
+	NativeToken                 []byte           `gorm:"index:basics_native_token"`
 	NativeTokenAmount           *string
 	Sender                      []byte `gorm:"index:basics_sender_tag"`
 	Tag                         []byte `gorm:"index:basics_sender_tag"`
@@ -23,8 +23,9 @@ type basic struct {
 	TimelockSlot                *iotago.SlotIndex
 	ExpirationSlot              *iotago.SlotIndex
 	ExpirationReturnAddress     []byte           `gorm:"index:basics_expiration_return_address"`
-	CreatedAtSlot               iotago.SlotIndex `gorm:"notnull;index:basics_created_at_slot"`
+	CreatedAtSlot               iotago.SlotIndex `gorm:"notnull;index:basics_created_at_slot_output_id,priority:1"`
 	DeletedAtSlot               iotago.SlotIndex `gorm:"notnull;index:basics_deleted_at_slot"`
+	SpentAtSlot                 iotago.SlotIndex `gorm:"notnull;index:basics_spent_at_slot"`
 	Committed                   bool
 }
 
@@ -50,8 +51,12 @@ type BasicFilterOptions struct {
 	tag                              []byte
 	pageSize                         uint32
 	cursor                           *string
+	sortOrder                        SortOrder
 	createdBefore                    *iotago.SlotIndex
 	createdAfter                     *iotago.SlotIndex
+	minAmount                        *iotago.BaseToken
+	maxAmount                        *iotago.BaseToken
+	asOfSlot                         *iotago.SlotIndex
 }
 
 func BasicHasNativeToken(value bool) options.Option[BasicFilterOptions] {
@@ -144,6 +149,20 @@ func BasicTag(tag []byte) options.Option[BasicFilterOptions] {
 	}
 }
 
+// BasicMinAmount filters for outputs holding at least the given amount of base tokens.
+func BasicMinAmount(amount iotago.BaseToken) options.Option[BasicFilterOptions] {
+	return func(args *BasicFilterOptions) {
+		args.minAmount = &amount
+	}
+}
+
+// BasicMaxAmount filters for outputs holding at most the given amount of base tokens.
+func BasicMaxAmount(amount iotago.BaseToken) options.Option[BasicFilterOptions] {
+	return func(args *BasicFilterOptions) {
+		args.maxAmount = &amount
+	}
+}
+
 func BasicPageSize(pageSize uint32) options.Option[BasicFilterOptions] {
 	return func(args *BasicFilterOptions) {
 		args.pageSize = pageSize
@@ -156,6 +175,13 @@ func BasicCursor(cursor string) options.Option[BasicFilterOptions] {
 	}
 }
 
+// BasicSortOrder defines the order in which the outputIDs are returned.
+func BasicSortOrder(sortOrder SortOrder) options.Option[BasicFilterOptions] {
+	return func(args *BasicFilterOptions) {
+		args.sortOrder = sortOrder
+	}
+}
+
 func BasicCreatedBefore(slot iotago.SlotIndex) options.Option[BasicFilterOptions] {
 	return func(args *BasicFilterOptions) {
 		args.createdBefore = &slot
@@ -168,8 +194,14 @@ func BasicCreatedAfter(slot iotago.SlotIndex) options.Option[BasicFilterOptions]
 	}
 }
 
+func BasicAsOfSlot(slot iotago.SlotIndex) options.Option[BasicFilterOptions] {
+	return func(args *BasicFilterOptions) {
+		args.asOfSlot = &slot
+	}
+}
+
 func (i *Indexer) basicQueryWithFilter(opts *BasicFilterOptions) *gorm.DB {
-	query := i.db.Model(&basic{}).Where("deleted_at_slot = 0")
+	query := unspentAtSlotQuery(i.db.Model(&basic{}), opts.asOfSlot)
 
 	if opts.hasNativeToken != nil {
 		if *opts.hasNativeToken {
@@ -184,12 +216,11 @@ func (i *Indexer) basicQueryWithFilter(opts *BasicFilterOptions) *gorm.DB {
 	}
 
 	if opts.unlockableByAddress != nil {
-		addrID := opts.unlockableByAddress.ID()
-		query = query.Where("(address = ? OR expiration_return_address = ? OR storage_deposit_return_address = ?)", addrID, addrID, addrID)
+		query = query.Where("output_id IN (?)", i.addressRefQuery(opts.unlockableByAddress, iotago.OutputBasic, addressRolesUnlockable...))
 	}
 
 	if opts.address != nil {
-		query = query.Where("address = ?", opts.address.ID())
+		query = query.Where("output_id IN (?)", i.addressRefQuery(opts.address, iotago.OutputBasic, addressRoleUnlock))
 	}
 
 	if opts.hasStorageDepositReturnCondition != nil {
@@ -248,6 +279,14 @@ func (i *Indexer) basicQueryWithFilter(opts *BasicFilterOptions) *gorm.DB {
 		query = query.Where("tag = ?", opts.tag)
 	}
 
+	if opts.minAmount != nil {
+		query = query.Where("amount >= ?", *opts.minAmount)
+	}
+
+	if opts.maxAmount != nil {
+		query = query.Where("amount <= ?", *opts.maxAmount)
+	}
+
 	if opts.createdBefore != nil {
 		query = query.Where("created_at_slot < ?", *opts.createdBefore)
 	}
@@ -263,7 +302,58 @@ func (i *Indexer) Basic(filters ...options.Option[BasicFilterOptions]) *IndexerR
 	opts := options.Apply(&BasicFilterOptions{
 		pageSize: DefaultPageSize,
 	}, filters)
+	if err := i.checkAsOfSlot(opts.asOfSlot); err != nil {
+		return errorResult(err)
+	}
+
 	query := i.basicQueryWithFilter(opts)
 
-	return i.combineOutputIDFilteredQuery(query, opts.pageSize, opts.cursor)
+	return i.combineOutputIDFilteredQuery(query, opts.pageSize, opts.cursor, opts.sortOrder)
+}
+
+// BasicCount returns the amount of outputs matching the filters. The page size, cursor and sort order are ignored.
+func (i *Indexer) BasicCount(filters ...options.Option[BasicFilterOptions]) *CountResult {
+	opts := options.Apply(&BasicFilterOptions{}, filters)
+	if err := i.checkAsOfSlot(opts.asOfSlot); err != nil {
+		return errorCountResult(err)
+	}
+
+	return i.countQueries([]*gorm.DB{i.basicQueryWithFilter(opts)})
+}
+
+// BasicSubscription subscribes to the events of the basic outputs matching the filters. The page size, cursor, sort order and asOfSlot are ignored.
+func (i *Indexer) BasicSubscription(filters ...options.Option[BasicFilterOptions]) *OutputSubscription {
+	opts := options.Apply(&BasicFilterOptions{}, filters)
+
+	return i.subscribe(opts.matchesOutput)
+}
+
+// matchesOutput matches the filters against the rows of an output in the same way basicQueryWithFilter does.
+func (o *BasicFilterOptions) matchesOutput(rows *outputRows) bool {
+	entry, isBasic := rows.entry.(*basic)
+	if !isBasic {
+		return false
+	}
+
+	var nativeToken []byte
+	if o.nativeToken != nil {
+		nativeToken = o.nativeToken[:]
+	}
+
+	return matchesPresence(o.hasNativeToken, entry.NativeTokenAmount != nil) &&
+		matchesBytes(entry.NativeToken, nativeToken) &&
+		rows.referencedBy(o.unlockableByAddress, addressRolesUnlockable...) &&
+		rows.referencedBy(o.address, addressRoleUnlock) &&
+		matchesPresence(o.hasStorageDepositReturnCondition, entry.StorageDepositReturn != nil) &&
+		matchesAddress(entry.StorageDepositReturnAddress, o.storageDepositReturnAddress) &&
+		matchesPresence(o.hasExpirationCondition, entry.ExpirationReturnAddress != nil) &&
+		matchesAddress(entry.ExpirationReturnAddress, o.expirationReturnAddress) &&
+		matchesLess(entry.ExpirationSlot, o.expiresBefore) &&
+		matchesGreater(entry.ExpirationSlot, o.expiresAfter) &&
+		matchesPresence(o.hasTimelockCondition, entry.TimelockSlot != nil) &&
+		matchesLess(entry.TimelockSlot, o.timelockedBefore) &&
+		matchesGreater(entry.TimelockSlot, o.timelockedAfter) &&
+		matchesAddress(entry.Sender, o.sender) &&
+		matchesBytes(entry.Tag, o.tag) &&
+		matchesCommonFilters(entry.Amount, entry.CreatedAtSlot, o.minAmount, o.maxAmount, o.createdBefore, o.createdAfter)
 }
@@ -0,0 +1,330 @@
+package indexer
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+
+	"gorm.io/gorm"
+
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/hive.go/runtime/options"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+const (
+	// changeLogIDLength is the length of the generated change log IDs in bytes.
+	changeLogIDLength = 16
+)
+
+var (
+	// ErrChangeLogIDMismatch is returned if the changes are read with the ID of a change log that was replaced by a re-import of the ledger.
+	ErrChangeLogIDMismatch = ierrors.New("change log ID mismatch")
+	// ErrChangeLogPruned is returned if the changes are read from a sequence number that was already pruned.
+	ErrChangeLogPruned = ierrors.New("change log pruned")
+)
+
+// changeLogEntry records the creation or the spending of an output by a committed ledger update.
+// The entries are written in the same transaction as the ledger update and are never changed afterwards,
+// so the sequence number defines the order in which the changes were applied.
+// The serialized output is kept with the entry, so that the changes can be filtered after spent outputs were deleted.
+type changeLogEntry struct {
+	SequenceNumber uint64            `gorm:"primaryKey;notnull;autoIncrement"`
+	Slot           iotago.SlotIndex  `gorm:"notnull"`
+	OutputID       []byte            `gorm:"notnull"`
+	OutputType     iotago.OutputType `gorm:"notnull"`
+	Spent          bool
+	BookedAt       iotago.SlotIndex `gorm:"notnull"`
+	Output         []byte           `gorm:"notnull"`
+}
+
+func (e *changeLogEntry) TableName() string {
+	return "change_log"
+}
+
+func (e *changeLogEntry) String() string {
+	return fmt.Sprintf("change log entry => SequenceNumber: %d, Slot: %d, OutputID: %s, Spent: %t", e.SequenceNumber, e.Slot, hex.EncodeToString(e.OutputID), e.Spent)
+}
+
+// Change is an entry of the change log.
+type Change struct {
+	SequenceNumber uint64
+	Slot           iotago.SlotIndex
+	OutputID       iotago.OutputID
+	OutputType     iotago.OutputType
+	Spent          bool
+}
+
+// ChangesResult contains a page of the change log.
+type ChangesResult struct {
+	// ChangeLogID identifies the change log the sequence numbers belong to.
+	ChangeLogID string
+	Changes     []*Change
+	// NextSequenceNumber is the sequence number to continue reading from, it is returned even if there are no further changes yet.
+	NextSequenceNumber uint64
+	CommittedSlot      iotago.SlotIndex
+	PageSize           uint32
+	Error              error
+}
+
+type ChangesFilterOptions struct {
+	pageSize           uint32
+	fromSequenceNumber uint64
+	changeLogID        *string
+	combinedFilter     *CombinedFilterOptions
+}
+
+func ChangesPageSize(pageSize uint32) options.Option[ChangesFilterOptions] {
+	return func(args *ChangesFilterOptions) {
+		args.pageSize = pageSize
+	}
+}
+
+func ChangesFromSequenceNumber(sequenceNumber uint64) options.Option[ChangesFilterOptions] {
+	return func(args *ChangesFilterOptions) {
+		args.fromSequenceNumber = sequenceNumber
+	}
+}
+
+// ChangesChangeLogID makes sure that the sequence number belongs to the given change log.
+// The sequence numbers start again whenever the ledger is re-imported, so they can't be used without the ID of their change log.
+func ChangesChangeLogID(changeLogID string) options.Option[ChangesFilterOptions] {
+	return func(args *ChangesFilterOptions) {
+		args.changeLogID = &changeLogID
+	}
+}
+
+// ChangesCombinedFilter only returns the changes of the outputs matching the filters of the combined outputs query.
+// The page size limits the number of scanned entries, so a page might contain less changes than the page size even if there are further changes.
+// The page size, cursor, sort order and asOfSlot of the filters are ignored.
+func ChangesCombinedFilter(filters ...options.Option[CombinedFilterOptions]) options.Option[ChangesFilterOptions] {
+	return func(args *ChangesFilterOptions) {
+		args.combinedFilter = options.Apply(&CombinedFilterOptions{}, filters)
+	}
+}
+
+// newChangeLogID generates the random ID of a new change log.
+func newChangeLogID() (string, error) {
+	changeLogID := make([]byte, changeLogIDLength)
+	if _, err := rand.Read(changeLogID); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(changeLogID), nil
+}
+
+// changeLogEntryForOutput serializes the output with the API of the slot it was booked in.
+func changeLogEntryForOutput(apiProvider iotago.APIProvider, slot iotago.SlotIndex, output *LedgerOutput, spent bool) (*changeLogEntry, error) {
+	data, err := apiProvider.APIForSlot(output.BookedAt).Encode(output.Output)
+	if err != nil {
+		return nil, ierrors.Wrapf(err, "failed to serialize output %s", output.OutputID.ToHex())
+	}
+
+	return &changeLogEntry{
+		Slot:       slot,
+		OutputID:   output.OutputID[:],
+		OutputType: output.Output.Type(),
+		Spent:      spent,
+		BookedAt:   output.BookedAt,
+		Output:     data,
+	}, nil
+}
+
+// insertChangeLogEntries records the spent and the created outputs of a committed ledger update.
+// Outputs that were created and spent in the same update never existed for the consumers of the change log, so they are skipped.
+func (i *Indexer) insertChangeLogEntries(tx *gorm.DB, update *LedgerUpdate) error {
+	createdOutputs := make(map[iotago.OutputID]struct{}, len(update.Created))
+	for _, output := range update.Created {
+		createdOutputs[output.OutputID] = struct{}{}
+	}
+
+	entries := make([]*changeLogEntry, 0, len(update.Consumed)+len(update.Created))
+	spentOutputs := make(map[iotago.OutputID]struct{}, len(update.Consumed))
+	for _, output := range update.Consumed {
+		spentOutputs[output.OutputID] = struct{}{}
+		if _, wasCreatedInSameSlot := createdOutputs[output.OutputID]; wasCreatedInSameSlot {
+			continue
+		}
+
+		entry, err := changeLogEntryForOutput(i.apiProvider, update.Slot, output, true)
+		if err != nil {
+			// The output is still indexed, it is just not part of the change log
+			i.LogWarn(err.Error())
+
+			continue
+		}
+		entries = append(entries, entry)
+	}
+
+	for _, output := range update.Created {
+		if _, wasSpentInSameSlot := spentOutputs[output.OutputID]; wasSpentInSameSlot {
+			continue
+		}
+
+		entry, err := changeLogEntryForOutput(i.apiProvider, update.Slot, output, false)
+		if err != nil {
+			// The output is still indexed, it is just not part of the change log
+			i.LogWarn(err.Error())
+
+			continue
+		}
+		entries = append(entries, entry)
+	}
+
+	if len(entries) == 0 {
+		return nil
+	}
+
+	return tx.Create(entries).Error
+}
+
+// Changes returns the entries of the change log starting at the given sequence number, ordered by their sequence number.
+// The sequence numbers are increasing, but they are not guaranteed to be contiguous.
+// Reading from a sequence number that was already pruned fails with ErrChangeLogPruned,
+// reading with the ID of a replaced change log fails with ErrChangeLogIDMismatch.
+func (i *Indexer) Changes(filters ...options.Option[ChangesFilterOptions]) *ChangesResult {
+	opts := options.Apply(&ChangesFilterOptions{
+		pageSize: DefaultPageSize,
+	}, filters)
+
+	query := i.db.Where("sequence_number >= ?", opts.fromSequenceNumber).Order("sequence_number asc")
+	if opts.pageSize > 0 {
+		query = query.Limit(int(opts.pageSize))
+	}
+
+	var entries []*changeLogEntry
+	if err := query.Find(&entries).Error; err != nil {
+		return &ChangesResult{Error: err}
+	}
+
+	// The status is read after the entries, so the committed slot is never older than the returned changes
+	status, err := i.Status()
+	if err != nil {
+		return &ChangesResult{Error: err}
+	}
+
+	if opts.changeLogID != nil && *opts.changeLogID != status.ChangeLogID {
+		return &ChangesResult{Error: ierrors.Wrapf(ErrChangeLogIDMismatch, "the change log %s was replaced by %s", *opts.changeLogID, status.ChangeLogID)}
+	}
+
+	// Reading from the start of the change log always succeeds, any other position must not have been pruned
+	if opts.fromSequenceNumber > 0 && opts.fromSequenceNumber < status.ChangeLogStartSequenceNumber {
+		return &ChangesResult{Error: ierrors.Wrapf(ErrChangeLogPruned, "the change log starts at sequence number %d", status.ChangeLogStartSequenceNumber)}
+	}
+
+	nextSequenceNumber := opts.fromSequenceNumber
+	changes := make([]*Change, 0, len(entries))
+	for _, entry := range entries {
+		// The scanned entries are skipped by the next page even if they don't match the filter
+		nextSequenceNumber = entry.SequenceNumber + 1
+
+		if opts.combinedFilter != nil {
+			matches, err := i.changeLogEntryMatches(entry, opts.combinedFilter)
+			if err != nil {
+				return &ChangesResult{Error: err}
+			}
+			if !matches {
+				continue
+			}
+		}
+
+		changes = append(changes, &Change{
+			SequenceNumber: entry.SequenceNumber,
+			Slot:           entry.Slot,
+			OutputID:       iotago.OutputID(entry.OutputID),
+			OutputType:     entry.OutputType,
+			Spent:          entry.Spent,
+		})
+	}
+
+	return &ChangesResult{
+		ChangeLogID:        status.ChangeLogID,
+		Changes:            changes,
+		NextSequenceNumber: nextSequenceNumber,
+		CommittedSlot:      status.CommittedSlot,
+		PageSize:           opts.pageSize,
+	}
+}
+
+// changeLogEntryMatches matches the output of the entry against the filters in the same way the subscriptions do.
+func (i *Indexer) changeLogEntryMatches(entry *changeLogEntry, filter *CombinedFilterOptions) (bool, error) {
+	outputID := iotago.OutputID(entry.OutputID)
+
+	var output iotago.TxEssenceOutput
+	if _, err := i.apiProvider.APIForSlot(entry.BookedAt).Decode(entry.Output, &output); err != nil {
+		return false, ierrors.Wrapf(err, "failed to deserialize output %s", outputID.ToHex())
+	}
+
+	rows, err := outputRowsForOutput(&LedgerOutput{
+		OutputID: outputID,
+		Output:   output,
+		BookedAt: entry.BookedAt,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return filter.matchesOutput(rows), nil
+}
+
+// ChangeLogHead returns the ID of the change log and the sequence number the next change will be recorded with at the earliest.
+func (i *Indexer) ChangeLogHead() (string, uint64, error) {
+	status, err := i.Status()
+	if err != nil {
+		return "", 0, err
+	}
+
+	var lastSequenceNumber uint64
+	if err := i.db.Model(&changeLogEntry{}).Select("COALESCE(MAX(sequence_number), 0)").Scan(&lastSequenceNumber).Error; err != nil {
+		return "", 0, err
+	}
+
+	return status.ChangeLogID, lastSequenceNumber + 1, nil
+}
+
+// PruneChangeLog removes the entries of the change log that are outside the configured retention window
+// and returns the amount of removed entries.
+func (i *Indexer) PruneChangeLog() (int64, error) {
+	if i.optsChangeLogRetention == 0 {
+		return 0, nil
+	}
+
+	status, err := i.Status()
+	if err != nil {
+		return 0, err
+	}
+
+	if status.CommittedSlot <= i.optsChangeLogRetention {
+		// Nothing to prune
+		return 0, nil
+	}
+	pruneUntilSlot := status.CommittedSlot - i.optsChangeLogRetention
+
+	var pruned int64
+	if err := i.db.Transaction(func(tx *gorm.DB) error {
+		// The newest entry is always kept, so that the sequence numbers never start again
+		var lastSequenceNumber uint64
+		if err := tx.Model(&changeLogEntry{}).
+			Select("COALESCE(MAX(sequence_number), 0)").
+			Where("slot <= ? AND sequence_number < (?)", pruneUntilSlot, tx.Model(&changeLogEntry{}).Select("MAX(sequence_number)")).
+			Scan(&lastSequenceNumber).Error; err != nil {
+			return err
+		}
+
+		if lastSequenceNumber == 0 {
+			return nil
+		}
+
+		result := tx.Where("sequence_number <= ?", lastSequenceNumber).Delete(&changeLogEntry{})
+		if err := result.Error; err != nil {
+			return err
+		}
+		pruned = result.RowsAffected
+
+		return tx.Model(&Status{}).Where("id = ? AND change_log_start_sequence_number <= ?", 1, lastSequenceNumber).Update("change_log_start_sequence_number", lastSequenceNumber+1).Error
+	}); err != nil {
+		return 0, err
+	}
+
+	return pruned, nil
+}
@@ -0,0 +1,189 @@
+package indexer_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/inx-indexer/pkg/indexer"
+	iotago "github.com/iotaledger/iota.go/v4"
+	iotago_tpkg "github.com/iotaledger/iota.go/v4/tpkg"
+)
+
+func TestIndexer_Changes(t *testing.T) {
+	ts := newTestSuite(t)
+
+	// The change log is empty before any ledger update was committed
+	result := ts.Indexer.Changes()
+	require.NoError(t, result.Error)
+	require.Empty(t, result.Changes)
+	require.Zero(t, result.NextSequenceNumber)
+
+	address := iotago_tpkg.RandEd25519Address()
+	basicOutputID := iotago_tpkg.RandOutputID(0)
+	ts.AddOutputOnCommitment(basicOutputWithAddress(address), basicOutputID) // Slot 1
+
+	// Accepted outputs are not part of the change log until they are committed
+	nftOutputID := iotago_tpkg.RandOutputID(0)
+	ts.AddOutputOnAcceptance(nftOutputWithAddressAndSender(address), nftOutputID, 2)
+	result = ts.Indexer.Changes()
+	require.NoError(t, result.Error)
+	require.Len(t, result.Changes, 1)
+
+	ts.AddOutputOnCommitment(nftOutputWithAddressAndSender(address), nftOutputID) // Slot 2
+	ts.DeleteOutputOnCommitment(basicOutputID)                                    // Slot 3
+
+	// Outputs created and spent in the same slot are not part of the change log
+	transientOutputID := iotago_tpkg.RandOutputID(0)
+	transientOutput := basicOutputWithAddress(address)
+	require.NoError(t, ts.Indexer.CommitLedgerUpdate(&indexer.LedgerUpdate{
+		Slot:     4,
+		Created:  []*indexer.LedgerOutput{{OutputID: transientOutputID, Output: transientOutput, BookedAt: 4}},
+		Consumed: []*indexer.LedgerOutput{{OutputID: transientOutputID, Output: transientOutput, SpentAt: 4}},
+	}))
+
+	result = ts.Indexer.Changes()
+	require.NoError(t, result.Error)
+	require.Equal(t, iotago.SlotIndex(4), result.CommittedSlot)
+	require.Len(t, result.Changes, 3)
+
+	require.Equal(t, basicOutputID, result.Changes[0].OutputID)
+	require.Equal(t, iotago.OutputBasic, result.Changes[0].OutputType)
+	require.Equal(t, iotago.SlotIndex(1), result.Changes[0].Slot)
+	require.False(t, result.Changes[0].Spent)
+
+	require.Equal(t, nftOutputID, result.Changes[1].OutputID)
+	require.Equal(t, iotago.OutputNFT, result.Changes[1].OutputType)
+	require.Equal(t, iotago.SlotIndex(2), result.Changes[1].Slot)
+	require.False(t, result.Changes[1].Spent)
+
+	require.Equal(t, basicOutputID, result.Changes[2].OutputID)
+	require.Equal(t, iotago.SlotIndex(3), result.Changes[2].Slot)
+	require.True(t, result.Changes[2].Spent)
+
+	require.Less(t, result.Changes[0].SequenceNumber, result.Changes[1].SequenceNumber)
+	require.Less(t, result.Changes[1].SequenceNumber, result.Changes[2].SequenceNumber)
+	require.Equal(t, result.Changes[2].SequenceNumber+1, result.NextSequenceNumber)
+
+	// Reading page by page returns every change exactly once
+	var paged []*indexer.Change
+	var nextSequenceNumber uint64
+	for {
+		page := ts.Indexer.Changes(indexer.ChangesFromSequenceNumber(nextSequenceNumber), indexer.ChangesPageSize(2))
+		require.NoError(t, page.Error)
+		if len(page.Changes) == 0 {
+			require.Equal(t, nextSequenceNumber, page.NextSequenceNumber)
+			break
+		}
+		paged = append(paged, page.Changes...)
+		nextSequenceNumber = page.NextSequenceNumber
+	}
+	require.Equal(t, result.Changes, paged)
+
+	// New changes are appended after the last read sequence number
+	ts.DeleteOutputOnCommitment(nftOutputID) // Slot 5
+	result = ts.Indexer.Changes(indexer.ChangesFromSequenceNumber(nextSequenceNumber))
+	require.NoError(t, result.Error)
+	require.Len(t, result.Changes, 1)
+	require.Equal(t, nftOutputID, result.Changes[0].OutputID)
+	require.True(t, result.Changes[0].Spent)
+	require.Equal(t, iotago.SlotIndex(5), result.Changes[0].Slot)
+}
+
+func TestIndexer_Changes_ChangeLogID(t *testing.T) {
+	ts := newTestSuite(t)
+
+	ts.AddOutputOnCommitment(basicOutputWithAddress(iotago_tpkg.RandEd25519Address()), iotago_tpkg.RandOutputID(0)) // Slot 1
+
+	result := ts.Indexer.Changes()
+	require.NoError(t, result.Error)
+	require.NotEmpty(t, result.ChangeLogID)
+	require.Len(t, result.Changes, 1)
+
+	result = ts.Indexer.Changes(indexer.ChangesChangeLogID(result.ChangeLogID), indexer.ChangesFromSequenceNumber(result.NextSequenceNumber))
+	require.NoError(t, result.Error)
+	require.Empty(t, result.Changes)
+
+	// Cursors of a replaced change log are rejected
+	result = ts.Indexer.Changes(indexer.ChangesChangeLogID("unknown"), indexer.ChangesFromSequenceNumber(result.NextSequenceNumber))
+	require.True(t, ierrors.Is(result.Error, indexer.ErrChangeLogIDMismatch))
+}
+
+func TestIndexer_PruneChangeLog(t *testing.T) {
+	ts := newTestSuite(t, indexer.WithChangeLogRetention(2))
+
+	address := iotago_tpkg.RandEd25519Address()
+	outputIDs := make([]iotago.OutputID, 0, 5)
+	for range 5 {
+		outputID := iotago_tpkg.RandOutputID(0)
+		ts.AddOutputOnCommitment(basicOutputWithAddress(address), outputID) // Slot 1-5
+		outputIDs = append(outputIDs, outputID)
+	}
+
+	result := ts.Indexer.Changes()
+	require.NoError(t, result.Error)
+	require.Len(t, result.Changes, 5)
+	firstSequenceNumber := result.Changes[0].SequenceNumber
+
+	// The entries of the slots 1-3 are outside the retention window
+	pruned, err := ts.Indexer.PruneChangeLog()
+	require.NoError(t, err)
+	require.EqualValues(t, 3, pruned)
+
+	result = ts.Indexer.Changes()
+	require.NoError(t, result.Error)
+	require.Len(t, result.Changes, 2)
+	require.Equal(t, outputIDs[3], result.Changes[0].OutputID)
+	require.Equal(t, outputIDs[4], result.Changes[1].OutputID)
+
+	// Cursors pointing to pruned entries are rejected instead of silently skipping the pruned changes
+	result = ts.Indexer.Changes(indexer.ChangesFromSequenceNumber(firstSequenceNumber + 1))
+	require.True(t, ierrors.Is(result.Error, indexer.ErrChangeLogPruned))
+
+	// The newest entry is kept even if it is outside the retention window, so the sequence numbers never start again
+	for range 5 {
+		ts.CommitEmptyLedgerUpdate() // Slot 6-10
+	}
+	pruned, err = ts.Indexer.PruneChangeLog()
+	require.NoError(t, err)
+	require.EqualValues(t, 1, pruned)
+
+	result = ts.Indexer.Changes()
+	require.NoError(t, result.Error)
+	require.Len(t, result.Changes, 1)
+	require.Equal(t, outputIDs[4], result.Changes[0].OutputID)
+
+	pruned, err = ts.Indexer.PruneChangeLog()
+	require.NoError(t, err)
+	require.Zero(t, pruned)
+}
+
+func TestIndexer_Changes_CombinedFilter(t *testing.T) {
+	ts := newTestSuite(t)
+
+	address := iotago_tpkg.RandEd25519Address()
+	outputID := iotago_tpkg.RandOutputID(0)
+	ts.AddOutputOnCommitment(basicOutputWithAddress(address), outputID)                                             // Slot 1
+	ts.AddOutputOnCommitment(basicOutputWithAddress(iotago_tpkg.RandEd25519Address()), iotago_tpkg.RandOutputID(0)) // Slot 2
+	ts.DeleteOutputOnCommitment(outputID)                                                                           // Slot 3
+
+	// The spending still matches after the output was deleted
+	result := ts.Indexer.Changes(indexer.ChangesCombinedFilter(indexer.CombinedUnlockableByAddress(address)))
+	require.NoError(t, result.Error)
+	require.Len(t, result.Changes, 2)
+	require.Equal(t, outputID, result.Changes[0].OutputID)
+	require.False(t, result.Changes[0].Spent)
+	require.Equal(t, outputID, result.Changes[1].OutputID)
+	require.True(t, result.Changes[1].Spent)
+
+	// The entries that don't match are skipped by the next page as well
+	result = ts.Indexer.Changes(indexer.ChangesCombinedFilter(indexer.CombinedUnlockableByAddress(address)), indexer.ChangesPageSize(2))
+	require.NoError(t, result.Error)
+	require.Len(t, result.Changes, 1)
+
+	result = ts.Indexer.Changes(indexer.ChangesCombinedFilter(indexer.CombinedUnlockableByAddress(address)), indexer.ChangesFromSequenceNumber(result.NextSequenceNumber))
+	require.NoError(t, result.Error)
+	require.Len(t, result.Changes, 1)
+	require.True(t, result.Changes[0].Spent)
+}
@@ -1,6 +1,8 @@
 package indexer
 
 import (
+	"strings"
+
 	"gorm.io/gorm"
 
 	"github.com/iotaledger/hive.go/runtime/options"
@@ -11,10 +13,15 @@ type CombinedFilterOptions struct {
 	hasNativeToken      *bool
 	nativeToken         *iotago.NativeTokenID
 	unlockableByAddress iotago.Address
+	unlockableByAny     []iotago.Address
 	pageSize            uint32
 	cursor              *string
+	sortOrder           SortOrder
 	createdBefore       *iotago.SlotIndex
 	createdAfter        *iotago.SlotIndex
+	minAmount           *iotago.BaseToken
+	maxAmount           *iotago.BaseToken
+	asOfSlot            *iotago.SlotIndex
 }
 
 func CombinedHasNativeToken(value bool) options.Option[CombinedFilterOptions] {
@@ -35,6 +42,14 @@ func CombinedUnlockableByAddress(address iotago.Address) options.Option[Combined
 	}
 }
 
+// CombinedUnlockableByAnyAddress filters for outputs that are unlockable by at least one of the given addresses.
+// Outputs referencing several of the addresses are only returned once.
+func CombinedUnlockableByAnyAddress(addresses ...iotago.Address) options.Option[CombinedFilterOptions] {
+	return func(args *CombinedFilterOptions) {
+		args.unlockableByAny = addresses
+	}
+}
+
 func CombinedPageSize(pageSize uint32) options.Option[CombinedFilterOptions] {
 	return func(args *CombinedFilterOptions) {
 		args.pageSize = pageSize
@@ -47,6 +62,13 @@ func CombinedCursor(cursor string) options.Option[CombinedFilterOptions] {
 	}
 }
 
+// CombinedSortOrder defines the order in which the outputIDs are returned.
+func CombinedSortOrder(sortOrder SortOrder) options.Option[CombinedFilterOptions] {
+	return func(args *CombinedFilterOptions) {
+		args.sortOrder = sortOrder
+	}
+}
+
 func CombinedCreatedBefore(slot iotago.SlotIndex) options.Option[CombinedFilterOptions] {
 	return func(args *CombinedFilterOptions) {
 		args.createdBefore = &slot
@@ -59,6 +81,26 @@ func CombinedCreatedAfter(slot iotago.SlotIndex) options.Option[CombinedFilterOp
 	}
 }
 
+// CombinedMinAmount filters for outputs holding at least the given amount of base tokens.
+func CombinedMinAmount(amount iotago.BaseToken) options.Option[CombinedFilterOptions] {
+	return func(args *CombinedFilterOptions) {
+		args.minAmount = &amount
+	}
+}
+
+// CombinedMaxAmount filters for outputs holding at most the given amount of base tokens.
+func CombinedMaxAmount(amount iotago.BaseToken) options.Option[CombinedFilterOptions] {
+	return func(args *CombinedFilterOptions) {
+		args.maxAmount = &amount
+	}
+}
+
+func CombinedAsOfSlot(slot iotago.SlotIndex) options.Option[CombinedFilterOptions] {
+	return func(args *CombinedFilterOptions) {
+		args.asOfSlot = &slot
+	}
+}
+
 func (o *CombinedFilterOptions) BasicFilterOptions() *BasicFilterOptions {
 	return &BasicFilterOptions{
 		hasNativeToken:      o.hasNativeToken,
@@ -66,8 +108,12 @@ func (o *CombinedFilterOptions) BasicFilterOptions() *BasicFilterOptions {
 		unlockableByAddress: o.unlockableByAddress,
 		pageSize:            o.pageSize,
 		cursor:              o.cursor,
+		sortOrder:           o.sortOrder,
 		createdBefore:       o.createdBefore,
 		createdAfter:        o.createdAfter,
+		minAmount:           o.minAmount,
+		maxAmount:           o.maxAmount,
+		asOfSlot:            o.asOfSlot,
 	}
 }
 
@@ -87,8 +133,12 @@ func (o *CombinedFilterOptions) FoundryFilterOptions() *FoundryFilterOptions {
 		account:        accountAddress,
 		pageSize:       o.pageSize,
 		cursor:         o.cursor,
+		sortOrder:      o.sortOrder,
 		createdBefore:  o.createdBefore,
 		createdAfter:   o.createdAfter,
+		minAmount:      o.minAmount,
+		maxAmount:      o.maxAmount,
+		asOfSlot:       o.asOfSlot,
 	}
 }
 
@@ -102,8 +152,12 @@ func (o *CombinedFilterOptions) AccountFilterOptions() *AccountFilterOptions {
 		address:       o.unlockableByAddress,
 		pageSize:      o.pageSize,
 		cursor:        o.cursor,
+		sortOrder:     o.sortOrder,
 		createdBefore: o.createdBefore,
 		createdAfter:  o.createdAfter,
+		minAmount:     o.minAmount,
+		maxAmount:     o.maxAmount,
+		asOfSlot:      o.asOfSlot,
 	}
 }
 
@@ -117,8 +171,12 @@ func (o *CombinedFilterOptions) AnchorFilterOptions() *AnchorFilterOptions {
 		unlockableByAddress: o.unlockableByAddress,
 		pageSize:            o.pageSize,
 		cursor:              o.cursor,
+		sortOrder:           o.sortOrder,
 		createdBefore:       o.createdBefore,
 		createdAfter:        o.createdAfter,
+		minAmount:           o.minAmount,
+		maxAmount:           o.maxAmount,
+		asOfSlot:            o.asOfSlot,
 	}
 }
 
@@ -132,8 +190,12 @@ func (o *CombinedFilterOptions) NFTFilterOptions() *NFTFilterOptions {
 		unlockableByAddress: o.unlockableByAddress,
 		pageSize:            o.pageSize,
 		cursor:              o.cursor,
+		sortOrder:           o.sortOrder,
 		createdBefore:       o.createdBefore,
 		createdAfter:        o.createdAfter,
+		minAmount:           o.minAmount,
+		maxAmount:           o.maxAmount,
+		asOfSlot:            o.asOfSlot,
 	}
 }
 
@@ -147,41 +209,171 @@ func (o *CombinedFilterOptions) DelegationFilterOptions() *DelegationFilterOptio
 		address:       o.unlockableByAddress,
 		pageSize:      o.pageSize,
 		cursor:        o.cursor,
+		sortOrder:     o.sortOrder,
 		createdBefore: o.createdBefore,
 		createdAfter:  o.createdAfter,
+		minAmount:     o.minAmount,
+		maxAmount:     o.maxAmount,
+		asOfSlot:      o.asOfSlot,
 	}
 }
 
+// combinedTypeQuery is the query of an output type affected by the combined filters,
+// together with the roles in which the filtered addresses need to be referenced by its outputs.
+type combinedTypeQuery struct {
+	outputType iotago.OutputType
+	roles      []addressRole
+	query      *gorm.DB
+}
+
+// combinedTypeQueries returns the queries of all output types that are affected by the filters in opts.
+// The queries themselves are built from the filters in typeOpts.
+func (i *Indexer) combinedTypeQueries(opts *CombinedFilterOptions, typeOpts *CombinedFilterOptions) []*combinedTypeQuery {
+	var typeQueries []*combinedTypeQuery
+
+	if opts.BasicFilterOptions() != nil {
+		typeQueries = append(typeQueries, &combinedTypeQuery{iotago.OutputBasic, addressRolesUnlockable, i.basicQueryWithFilter(typeOpts.BasicFilterOptions())})
+	}
+
+	if opts.AccountFilterOptions() != nil {
+		typeQueries = append(typeQueries, &combinedTypeQuery{iotago.OutputAccount, []addressRole{addressRoleUnlock}, i.accountQueryWithFilter(typeOpts.AccountFilterOptions())})
+	}
+
+	if opts.AnchorFilterOptions() != nil {
+		typeQueries = append(typeQueries, &combinedTypeQuery{iotago.OutputAnchor, addressRolesUnlockable, i.anchorQueryWithFilter(typeOpts.AnchorFilterOptions())})
+	}
+
+	if opts.NFTFilterOptions() != nil {
+		typeQueries = append(typeQueries, &combinedTypeQuery{iotago.OutputNFT, addressRolesUnlockable, i.nftQueryWithFilter(typeOpts.NFTFilterOptions())})
+	}
+
+	if opts.FoundryFilterOptions() != nil {
+		typeQueries = append(typeQueries, &combinedTypeQuery{iotago.OutputFoundry, []addressRole{addressRoleImmutableAccount}, i.foundryOutputsQueryWithFilter(typeOpts.FoundryFilterOptions())})
+	}
+
+	if opts.DelegationFilterOptions() != nil {
+		typeQueries = append(typeQueries, &combinedTypeQuery{iotago.OutputDelegation, []addressRole{addressRoleUnlock}, i.delegationQueryWithFilter(typeOpts.DelegationFilterOptions())})
+	}
+
+	if len(typeOpts.unlockableByAny) > 0 {
+		for _, typeQuery := range typeQueries {
+			typeQuery.query = typeQuery.query.Where("output_id IN (?)", i.addressRefsQuery(typeOpts.unlockableByAny, typeQuery.outputType, typeQuery.roles...))
+		}
+	}
+
+	return typeQueries
+}
+
+// combinedQueriesWithFilter returns the queries of all output types that are affected by the filters.
+func (i *Indexer) combinedQueriesWithFilter(opts *CombinedFilterOptions) []*gorm.DB {
+	typeQueries := i.combinedTypeQueries(opts, opts)
+
+	queries := make([]*gorm.DB, 0, len(typeQueries))
+	for _, typeQuery := range typeQueries {
+		queries = append(queries, typeQuery.query)
+	}
+
+	return queries
+}
+
+// combinedAddressRefsQuery returns a single query over the address references of the outputs unlockable by the filtered addresses,
+// or nil if the filters don't contain an address.
+// The references are scanned in the order of their own (address_id, sort column, output_id) indexes
+// and are only joined with the output tables to apply the filters of the output types.
+func (i *Indexer) combinedAddressRefsQuery(opts *CombinedFilterOptions) *gorm.DB {
+	typeOpts := *opts
+
+	var addresses []iotago.Address
+	switch {
+	case opts.unlockableByAddress != nil:
+		// a list of addresses is still applied to the queries of the output types
+		addresses = []iotago.Address{opts.unlockableByAddress}
+		typeOpts.unlockableByAddress = nil
+	case len(opts.unlockableByAny) > 0:
+		addresses = opts.unlockableByAny
+		typeOpts.unlockableByAny = nil
+	default:
+		return nil
+	}
+
+	typeQueries := i.combinedTypeQueries(opts, &typeOpts)
+
+	typeConditions := make([]string, 0, len(typeQueries))
+	args := make([]interface{}, 0, 3*len(typeQueries))
+	for _, typeQuery := range typeQueries {
+		// The correlated subquery looks up the output of each reference by its primary key
+		typeConditions = append(typeConditions, "(output_type = ? AND role IN ? AND EXISTS (?))")
+		args = append(args, typeQuery.outputType, typeQuery.roles, typeQuery.query.Select("1").Where("output_id = address_refs.output_id"))
+	}
+
+	// An output referencing the addresses in several roles is only returned once
+	return i.db.Model(&addressRef{}).
+		Distinct().
+		Where("address_id IN ?", addressIDs(addresses)).
+		Where(strings.Join(typeConditions, " OR "), args...)
+}
+
 func (i *Indexer) Combined(filters ...options.Option[CombinedFilterOptions]) *IndexerResult {
 	opts := options.Apply(&CombinedFilterOptions{
 		pageSize: DefaultPageSize,
 	}, filters)
-
-	var queries []*gorm.DB
-
-	if filter := opts.BasicFilterOptions(); filter != nil {
-		queries = append(queries, i.basicQueryWithFilter(filter))
+	if err := i.checkAsOfSlot(opts.asOfSlot); err != nil {
+		return errorResult(err)
 	}
 
-	if filter := opts.AccountFilterOptions(); filter != nil {
-		queries = append(queries, i.accountQueryWithFilter(filter))
+	if query := i.combinedAddressRefsQuery(opts); query != nil {
+		return i.combineOutputIDFilteredQuery(query, opts.pageSize, opts.cursor, opts.sortOrder)
 	}
 
-	if filter := opts.AnchorFilterOptions(); filter != nil {
-		queries = append(queries, i.anchorQueryWithFilter(filter))
-	}
+	return i.combineOutputIDFilteredQueries(i.combinedQueriesWithFilter(opts), opts.pageSize, opts.cursor, opts.sortOrder)
+}
 
-	if filter := opts.NFTFilterOptions(); filter != nil {
-		queries = append(queries, i.nftQueryWithFilter(filter))
+// CombinedCount returns the amount of outputs of all types matching the filters. The page size, cursor and sort order are ignored.
+func (i *Indexer) CombinedCount(filters ...options.Option[CombinedFilterOptions]) *CountResult {
+	opts := options.Apply(&CombinedFilterOptions{}, filters)
+	if err := i.checkAsOfSlot(opts.asOfSlot); err != nil {
+		return errorCountResult(err)
 	}
 
-	if filter := opts.FoundryFilterOptions(); filter != nil {
-		queries = append(queries, i.foundryOutputsQueryWithFilter(filter))
+	if query := i.combinedAddressRefsQuery(opts); query != nil {
+		return i.countQueries([]*gorm.DB{query})
 	}
 
-	if filter := opts.DelegationFilterOptions(); filter != nil {
-		queries = append(queries, i.delegationQueryWithFilter(filter))
+	return i.countQueries(i.combinedQueriesWithFilter(opts))
+}
+
+// CombinedSubscription subscribes to the events of the outputs of all types matching the filters. The page size, cursor, sort order and asOfSlot are ignored.
+func (i *Indexer) CombinedSubscription(filters ...options.Option[CombinedFilterOptions]) *OutputSubscription {
+	opts := options.Apply(&CombinedFilterOptions{}, filters)
+
+	return i.subscribe(opts.matchesOutput)
+}
+
+// matchesOutput matches the filters against the rows of an output in the same way the queries of combinedQueriesWithFilter do.
+func (o *CombinedFilterOptions) matchesOutput(rows *outputRows) bool {
+	var matches bool
+	var roles []addressRole
+
+	switch rows.entry.(type) {
+	case *basic:
+		typeOpts := o.BasicFilterOptions()
+		matches, roles = typeOpts != nil && typeOpts.matchesOutput(rows), addressRolesUnlockable
+	case *account:
+		typeOpts := o.AccountFilterOptions()
+		matches, roles = typeOpts != nil && typeOpts.matchesOutput(rows), []addressRole{addressRoleUnlock}
+	case *anchor:
+		typeOpts := o.AnchorFilterOptions()
+		matches, roles = typeOpts != nil && typeOpts.matchesOutput(rows), addressRolesUnlockable
+	case *nft:
+		typeOpts := o.NFTFilterOptions()
+		matches, roles = typeOpts != nil && typeOpts.matchesOutput(rows), addressRolesUnlockable
+	case *foundry:
+		typeOpts := o.FoundryFilterOptions()
+		matches, roles = typeOpts != nil && typeOpts.matchesOutput(rows), []addressRole{addressRoleImmutableAccount}
+	case *delegation:
+		typeOpts := o.DelegationFilterOptions()
+		matches, roles = typeOpts != nil && typeOpts.matchesOutput(rows), []addressRole{addressRoleUnlock}
 	}
 
-	return i.combineOutputIDFilteredQueries(queries, opts.pageSize, opts.cursor)
+	return matches && (len(o.unlockableByAny) == 0 || rows.referencedByAny(o.unlockableByAny, roles...))
 }
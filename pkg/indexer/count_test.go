@@ -0,0 +1,62 @@
+package indexer_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/inx-indexer/pkg/indexer"
+	iotago "github.com/iotaledger/iota.go/v4"
+	iotago_tpkg "github.com/iotaledger/iota.go/v4/tpkg"
+)
+
+func TestIndexer_Count(t *testing.T) {
+	ts := newTestSuite(t)
+
+	address := iotago_tpkg.RandEd25519Address()
+	tag := []byte("dashboard")
+
+	basicOutputWithTag := func() iotago.Output {
+		output := basicOutputWithAddress(address).(*iotago.BasicOutput)
+		output.Features = iotago.BasicOutputFeatures{
+			&iotago.TagFeature{Tag: tag},
+		}
+
+		return output
+	}
+
+	ts.AddOutputOnCommitment(basicOutputWithTag(), iotago_tpkg.RandOutputID(0))
+	ts.AddOutputOnCommitment(basicOutputWithTag(), iotago_tpkg.RandOutputID(0))
+	spentOutputID := iotago_tpkg.RandOutputID(0)
+	ts.AddOutputOnCommitment(basicOutputWithTag(), spentOutputID)
+	ts.AddOutputOnCommitment(basicOutputWithAddress(address), iotago_tpkg.RandOutputID(0))
+	ts.AddOutputOnCommitment(nftOutputWithAddressAndSender(address), iotago_tpkg.RandOutputID(0))
+	ts.AddOutputOnCommitment(basicOutputWithAddress(iotago_tpkg.RandEd25519Address()), iotago_tpkg.RandOutputID(0))
+	ts.DeleteOutputOnCommitment(spentOutputID)
+
+	requireCount := func(expected uint64, result *indexer.CountResult) {
+		require.NoError(t, result.Error)
+		require.Equal(t, expected, result.Count)
+		require.Equal(t, ts.CurrentSlot(), result.CommittedSlot)
+	}
+
+	// Spent outputs are not counted
+	requireCount(2, ts.Indexer.BasicCount(indexer.BasicTag(tag)))
+	requireCount(3, ts.Indexer.BasicCount(indexer.BasicUnlockAddress(address)))
+	requireCount(4, ts.Indexer.BasicCount())
+	requireCount(1, ts.Indexer.NFTCount(indexer.NFTUnlockAddress(address)))
+	requireCount(0, ts.Indexer.AccountCount())
+	requireCount(0, ts.Indexer.AnchorCount())
+	requireCount(0, ts.Indexer.FoundryCount())
+	requireCount(0, ts.Indexer.DelegationCount())
+
+	// The page size does not limit the count
+	requireCount(3, ts.Indexer.BasicCount(indexer.BasicUnlockAddress(address), indexer.BasicPageSize(1)))
+
+	// The combined count includes all output types
+	requireCount(4, ts.Indexer.CombinedCount(indexer.CombinedUnlockableByAddress(address)))
+	requireCount(3, ts.Indexer.CombinedCount(indexer.CombinedUnlockableByAddress(address), indexer.CombinedCreatedAfter(1)))
+
+	result := ts.Indexer.CombinedCount(indexer.CombinedAsOfSlot(ts.CurrentSlot() + 1))
+	require.ErrorIs(t, result.Error, indexer.ErrSlotNotRetained)
+}
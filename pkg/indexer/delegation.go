@@ -11,14 +11,18 @@ import (
 )
 
 type delegation struct {
-	OutputID      []byte `gorm:"primaryKey;notnull"`
-	DelegationID  []byte `gorm:"notnull"`
-	Amount        iotago.BaseToken
-	Address       []byte           `gorm:"notnull;index:delegations_address"`
-	Validator     []byte           `gorm:"index:delegations_validator"`
-	CreatedAtSlot iotago.SlotIndex `gorm:"notnull;index:delegations_created_at_slot"`
-	DeletedAtSlot iotago.SlotIndex `gorm:"notnull;index:delegations_deleted_at_slot"`
-	Committed     bool
+	OutputID        []byte            `gorm:"primaryKey;notnull;index:delegations_created_at_slot_output_id,priority:2;index:delegations_amount_output_id,priority:2"`
+	DelegationID    []byte            `gorm:"notnull"`
+	Amount          iotago.BaseToken  `gorm:"index:delegations_amount_output_id,priority:1"`
+	DelegatedAmount iotago.BaseToken  `gorm:"notnull;index:delegations_delegated_amount"`
+	StartEpoch      iotago.EpochIndex `gorm:"notnull;index:delegations_start_epoch"`
+	EndEpoch        iotago.EpochIndex `gorm:"notnull;index:delegations_end_epoch"`
+	Address         []byte            `gorm:"notnull;index:delegations_address"`
+	Validator       []byte            `gorm:"index:delegations_validator"`
+	CreatedAtSlot   iotago.SlotIndex  `gorm:"notnull;index:delegations_created_at_slot_output_id,priority:1"`
+	DeletedAtSlot   iotago.SlotIndex  `gorm:"notnull;index:delegations_deleted_at_slot"`
+	SpentAtSlot     iotago.SlotIndex  `gorm:"notnull;index:delegations_spent_at_slot"`
+	Committed       bool
 }
 
 func (d *delegation) String() string {
@@ -26,12 +30,21 @@ func (d *delegation) String() string {
 }
 
 type DelegationFilterOptions struct {
-	address       iotago.Address
-	validator     *iotago.AccountAddress
-	pageSize      uint32
-	cursor        *string
-	createdBefore *iotago.SlotIndex
-	createdAfter  *iotago.SlotIndex
+	address            iotago.Address
+	validator          *iotago.AccountAddress
+	minDelegatedAmount *iotago.BaseToken
+	maxDelegatedAmount *iotago.BaseToken
+	startEpochBefore   *iotago.EpochIndex
+	startEpochAfter    *iotago.EpochIndex
+	hasEndEpoch        *bool
+	pageSize           uint32
+	cursor             *string
+	sortOrder          SortOrder
+	createdBefore      *iotago.SlotIndex
+	createdAfter       *iotago.SlotIndex
+	minAmount          *iotago.BaseToken
+	maxAmount          *iotago.BaseToken
+	asOfSlot           *iotago.SlotIndex
 }
 
 func DelegationAddress(address iotago.Address) options.Option[DelegationFilterOptions] {
@@ -46,6 +59,51 @@ func DelegationValidator(address *iotago.AccountAddress) options.Option[Delegati
 	}
 }
 
+func DelegationMinDelegatedAmount(amount iotago.BaseToken) options.Option[DelegationFilterOptions] {
+	return func(args *DelegationFilterOptions) {
+		args.minDelegatedAmount = &amount
+	}
+}
+
+func DelegationMaxDelegatedAmount(amount iotago.BaseToken) options.Option[DelegationFilterOptions] {
+	return func(args *DelegationFilterOptions) {
+		args.maxDelegatedAmount = &amount
+	}
+}
+
+func DelegationStartEpochBefore(epoch iotago.EpochIndex) options.Option[DelegationFilterOptions] {
+	return func(args *DelegationFilterOptions) {
+		args.startEpochBefore = &epoch
+	}
+}
+
+func DelegationStartEpochAfter(epoch iotago.EpochIndex) options.Option[DelegationFilterOptions] {
+	return func(args *DelegationFilterOptions) {
+		args.startEpochAfter = &epoch
+	}
+}
+
+// DelegationHasEndEpoch filters for delegations that were already ended (end epoch set) or that are still active (end epoch unset).
+func DelegationHasEndEpoch(value bool) options.Option[DelegationFilterOptions] {
+	return func(args *DelegationFilterOptions) {
+		args.hasEndEpoch = &value
+	}
+}
+
+// DelegationMinAmount filters for outputs holding at least the given amount of base tokens.
+func DelegationMinAmount(amount iotago.BaseToken) options.Option[DelegationFilterOptions] {
+	return func(args *DelegationFilterOptions) {
+		args.minAmount = &amount
+	}
+}
+
+// DelegationMaxAmount filters for outputs holding at most the given amount of base tokens.
+func DelegationMaxAmount(amount iotago.BaseToken) options.Option[DelegationFilterOptions] {
+	return func(args *DelegationFilterOptions) {
+		args.maxAmount = &amount
+	}
+}
+
 func DelegationPageSize(pageSize uint32) options.Option[DelegationFilterOptions] {
 	return func(args *DelegationFilterOptions) {
 		args.pageSize = pageSize
@@ -58,6 +116,13 @@ func DelegationCursor(cursor string) options.Option[DelegationFilterOptions] {
 	}
 }
 
+// DelegationSortOrder defines the order in which the outputIDs are returned.
+func DelegationSortOrder(sortOrder SortOrder) options.Option[DelegationFilterOptions] {
+	return func(args *DelegationFilterOptions) {
+		args.sortOrder = sortOrder
+	}
+}
+
 func DelegationCreatedBefore(slot iotago.SlotIndex) options.Option[DelegationFilterOptions] {
 	return func(args *DelegationFilterOptions) {
 		args.createdBefore = &slot
@@ -70,25 +135,69 @@ func DelegationCreatedAfter(slot iotago.SlotIndex) options.Option[DelegationFilt
 	}
 }
 
-func (i *Indexer) DelegationByID(delegationID iotago.DelegationID) *IndexerResult {
-	query := i.db.Model(&delegation{}).
+func DelegationAsOfSlot(slot iotago.SlotIndex) options.Option[DelegationFilterOptions] {
+	return func(args *DelegationFilterOptions) {
+		args.asOfSlot = &slot
+	}
+}
+
+// DelegationByID returns the output of the given DelegationID. Only the DelegationAsOfSlot filter is taken into account.
+func (i *Indexer) DelegationByID(delegationID iotago.DelegationID, filters ...options.Option[DelegationFilterOptions]) *IndexerResult {
+	opts := options.Apply(&DelegationFilterOptions{}, filters)
+	if err := i.checkAsOfSlot(opts.asOfSlot); err != nil {
+		return errorResult(err)
+	}
+
+	query := chainOutputAtSlotQuery(i.db.Model(&delegation{}), opts.asOfSlot).
 		Where("delegation_id = ?", delegationID[:]).
 		Limit(1)
 
-	return i.combineOutputIDFilteredQuery(query, 0, nil)
+	return i.combineOutputIDFilteredQuery(query, 0, nil, SortCreatedAscending)
 }
 
 func (i *Indexer) delegationQueryWithFilter(opts *DelegationFilterOptions) *gorm.DB {
-	query := i.db.Model(&delegation{}).Where("deleted_at_slot = 0")
+	query := unspentAtSlotQuery(i.db.Model(&delegation{}), opts.asOfSlot)
 
 	if opts.address != nil {
-		query = query.Where("address = ?", opts.address.ID())
+		query = query.Where("output_id IN (?)", i.addressRefQuery(opts.address, iotago.OutputDelegation, addressRoleUnlock))
 	}
 
 	if opts.validator != nil {
 		query = query.Where("validator = ?", opts.validator.ID())
 	}
 
+	if opts.minDelegatedAmount != nil {
+		query = query.Where("delegated_amount >= ?", *opts.minDelegatedAmount)
+	}
+
+	if opts.maxDelegatedAmount != nil {
+		query = query.Where("delegated_amount <= ?", *opts.maxDelegatedAmount)
+	}
+
+	if opts.startEpochBefore != nil {
+		query = query.Where("start_epoch < ?", *opts.startEpochBefore)
+	}
+
+	if opts.startEpochAfter != nil {
+		query = query.Where("start_epoch > ?", *opts.startEpochAfter)
+	}
+
+	if opts.hasEndEpoch != nil {
+		if *opts.hasEndEpoch {
+			query = query.Where("end_epoch > 0")
+		} else {
+			query = query.Where("end_epoch = 0")
+		}
+	}
+
+	if opts.minAmount != nil {
+		query = query.Where("amount >= ?", *opts.minAmount)
+	}
+
+	if opts.maxAmount != nil {
+		query = query.Where("amount <= ?", *opts.maxAmount)
+	}
+
 	if opts.createdBefore != nil {
 		query = query.Where("created_at_slot < ?", *opts.createdBefore)
 	}
@@ -104,7 +213,48 @@ func (i *Indexer) Delegation(filters ...options.Option[DelegationFilterOptions])
 	opts := options.Apply(&DelegationFilterOptions{
 		pageSize: DefaultPageSize,
 	}, filters)
+	if err := i.checkAsOfSlot(opts.asOfSlot); err != nil {
+		return errorResult(err)
+	}
+
 	query := i.delegationQueryWithFilter(opts)
 
-	return i.combineOutputIDFilteredQuery(query, opts.pageSize, opts.cursor)
+	return i.combineOutputIDFilteredQuery(query, opts.pageSize, opts.cursor, opts.sortOrder)
+}
+
+// DelegationCount returns the amount of outputs matching the filters. The page size, cursor and sort order are ignored.
+func (i *Indexer) DelegationCount(filters ...options.Option[DelegationFilterOptions]) *CountResult {
+	opts := options.Apply(&DelegationFilterOptions{}, filters)
+	if err := i.checkAsOfSlot(opts.asOfSlot); err != nil {
+		return errorCountResult(err)
+	}
+
+	return i.countQueries([]*gorm.DB{i.delegationQueryWithFilter(opts)})
+}
+
+// DelegationSubscription subscribes to the events of the delegation outputs matching the filters. The page size, cursor, sort order and asOfSlot are ignored.
+func (i *Indexer) DelegationSubscription(filters ...options.Option[DelegationFilterOptions]) *OutputSubscription {
+	opts := options.Apply(&DelegationFilterOptions{}, filters)
+
+	return i.subscribe(opts.matchesOutput)
+}
+
+// matchesOutput matches the filters against the rows of an output in the same way delegationQueryWithFilter does.
+func (o *DelegationFilterOptions) matchesOutput(rows *outputRows) bool {
+	entry, isDelegation := rows.entry.(*delegation)
+	if !isDelegation {
+		return false
+	}
+
+	if o.validator != nil && !matchesAddress(entry.Validator, o.validator) {
+		return false
+	}
+
+	return rows.referencedBy(o.address, addressRoleUnlock) &&
+		matchesAtLeast(entry.DelegatedAmount, o.minDelegatedAmount) &&
+		matchesAtMost(entry.DelegatedAmount, o.maxDelegatedAmount) &&
+		matchesLess(&entry.StartEpoch, o.startEpochBefore) &&
+		matchesGreater(&entry.StartEpoch, o.startEpochAfter) &&
+		matchesPresence(o.hasEndEpoch, entry.EndEpoch > 0) &&
+		matchesCommonFilters(entry.Amount, entry.CreatedAtSlot, o.minAmount, o.maxAmount, o.createdBefore, o.createdAfter)
 }
@@ -25,7 +25,7 @@ func TestIndexer_DelegationOutput(t *testing.T) {
 		Amount:           amount,
 		DelegatedAmount:  amount,
 		ValidatorAddress: validatorAddress,
-		StartEpoch:       0,
+		StartEpoch:       5,
 		EndEpoch:         0,
 		UnlockConditions: iotago.DelegationOutputUnlockConditions{
 			&iotago.AddressUnlockCondition{
@@ -67,4 +67,35 @@ func TestIndexer_DelegationOutput(t *testing.T) {
 	// Validator
 	outputSet.requireDelegationFound(indexer.DelegationValidator(validatorAddress))
 	outputSet.requireDelegationNotFound(indexer.DelegationValidator(randomValidatorAddress))
+
+	// Delegated Amount
+	outputSet.requireDelegationFound(indexer.DelegationMinDelegatedAmount(amount))
+	outputSet.requireDelegationNotFound(indexer.DelegationMinDelegatedAmount(amount + 1))
+	outputSet.requireDelegationFound(indexer.DelegationMaxDelegatedAmount(amount))
+	outputSet.requireDelegationNotFound(indexer.DelegationMaxDelegatedAmount(amount - 1))
+
+	// Start Epoch
+	outputSet.requireDelegationFound(indexer.DelegationStartEpochBefore(6))
+	outputSet.requireDelegationNotFound(indexer.DelegationStartEpochBefore(5))
+	outputSet.requireDelegationFound(indexer.DelegationStartEpochAfter(4))
+	outputSet.requireDelegationNotFound(indexer.DelegationStartEpochAfter(5))
+
+	// End Epoch
+	outputSet.requireDelegationFound(indexer.DelegationHasEndEpoch(false))
+	outputSet.requireDelegationNotFound(indexer.DelegationHasEndEpoch(true))
+}
+
+func TestIndexer_DelegationOutput_Ended(t *testing.T) {
+	ts := newTestSuite(t)
+
+	output := delegationOutputWithAddress(iotago_tpkg.RandEd25519Address()).(*iotago.DelegationOutput)
+	output.DelegatedAmount = output.Amount
+	output.StartEpoch = 1
+	output.EndEpoch = 10
+
+	outputSet := ts.AddOutputOnCommitment(output, iotago_tpkg.RandOutputID(0))
+
+	outputSet.requireDelegationFound(indexer.DelegationHasEndEpoch(true))
+	outputSet.requireDelegationNotFound(indexer.DelegationHasEndEpoch(false))
+	outputSet.requireDelegationFound(indexer.DelegationHasEndEpoch(true), indexer.DelegationStartEpochBefore(2))
 }
@@ -1,23 +1,33 @@
 package indexer
 
 import (
+	"bytes"
 	"encoding/hex"
 	"fmt"
+	"math/big"
 
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"gorm.io/gorm"
 
+	"github.com/iotaledger/hive.go/ierrors"
 	"github.com/iotaledger/hive.go/runtime/options"
 	iotago "github.com/iotaledger/iota.go/v4"
 )
 
 type foundry struct {
-	OutputID          []byte `gorm:"primaryKey;notnull"`
-	FoundryID         []byte `gorm:"notnull"`
-	Amount            iotago.BaseToken
+	OutputID          []byte           `gorm:"primaryKey;notnull;index:foundries_created_at_slot_output_id,priority:2;index:foundries_amount_output_id,priority:2"`
+	FoundryID         []byte           `gorm:"notnull"`
+	Amount            iotago.BaseToken `gorm:"index:foundries_amount_output_id,priority:1"`
 	NativeTokenAmount *string
+	SerialNumber      uint32           `gorm:"notnull;index:foundries_serial_number"`
+	MintedTokens      string           `gorm:"notnull"`
+	MeltedTokens      string           `gorm:"notnull"`
+	MaximumSupply     string           `gorm:"notnull"`
+	HasMintCapacity   bool             `gorm:"notnull;index:foundries_has_mint_capacity"`
 	AccountAddress    []byte           `gorm:"notnull;index:foundries_account_address"`
-	CreatedAtSlot     iotago.SlotIndex `gorm:"notnull;index:foundries_created_at_slot"`
+	CreatedAtSlot     iotago.SlotIndex `gorm:"notnull;index:foundries_created_at_slot_output_id,priority:1"`
 	DeletedAtSlot     iotago.SlotIndex `gorm:"notnull;index:foundries_deleted_at_slot"`
+	SpentAtSlot       iotago.SlotIndex `gorm:"notnull;index:foundries_spent_at_slot"`
 	Committed         bool
 }
 
@@ -26,13 +36,19 @@ func (o *foundry) String() string {
 }
 
 type FoundryFilterOptions struct {
-	hasNativeToken *bool
-	nativeToken    *iotago.NativeTokenID
-	account        *iotago.AccountAddress
-	pageSize       uint32
-	cursor         *string
-	createdBefore  *iotago.SlotIndex
-	createdAfter   *iotago.SlotIndex
+	hasNativeToken  *bool
+	nativeToken     *iotago.NativeTokenID
+	account         *iotago.AccountAddress
+	serialNumber    *uint32
+	hasMintCapacity *bool
+	pageSize        uint32
+	cursor          *string
+	sortOrder       SortOrder
+	createdBefore   *iotago.SlotIndex
+	createdAfter    *iotago.SlotIndex
+	minAmount       *iotago.BaseToken
+	maxAmount       *iotago.BaseToken
+	asOfSlot        *iotago.SlotIndex
 }
 
 func FoundryHasNativeToken(value bool) options.Option[FoundryFilterOptions] {
@@ -53,6 +69,32 @@ func FoundryWithAccountAddress(address *iotago.AccountAddress) options.Option[Fo
 	}
 }
 
+func FoundrySerialNumber(serialNumber uint32) options.Option[FoundryFilterOptions] {
+	return func(args *FoundryFilterOptions) {
+		args.serialNumber = &serialNumber
+	}
+}
+
+func FoundryHasMintCapacity(value bool) options.Option[FoundryFilterOptions] {
+	return func(args *FoundryFilterOptions) {
+		args.hasMintCapacity = &value
+	}
+}
+
+// FoundryMinAmount filters for outputs holding at least the given amount of base tokens.
+func FoundryMinAmount(amount iotago.BaseToken) options.Option[FoundryFilterOptions] {
+	return func(args *FoundryFilterOptions) {
+		args.minAmount = &amount
+	}
+}
+
+// FoundryMaxAmount filters for outputs holding at most the given amount of base tokens.
+func FoundryMaxAmount(amount iotago.BaseToken) options.Option[FoundryFilterOptions] {
+	return func(args *FoundryFilterOptions) {
+		args.maxAmount = &amount
+	}
+}
+
 func FoundryPageSize(pageSize uint32) options.Option[FoundryFilterOptions] {
 	return func(args *FoundryFilterOptions) {
 		args.pageSize = pageSize
@@ -65,6 +107,13 @@ func FoundryCursor(cursor string) options.Option[FoundryFilterOptions] {
 	}
 }
 
+// FoundrySortOrder defines the order in which the outputIDs are returned.
+func FoundrySortOrder(sortOrder SortOrder) options.Option[FoundryFilterOptions] {
+	return func(args *FoundryFilterOptions) {
+		args.sortOrder = sortOrder
+	}
+}
+
 func FoundryCreatedBefore(slot iotago.SlotIndex) options.Option[FoundryFilterOptions] {
 	return func(args *FoundryFilterOptions) {
 		args.createdBefore = &slot
@@ -77,16 +126,101 @@ func FoundryCreatedAfter(slot iotago.SlotIndex) options.Option[FoundryFilterOpti
 	}
 }
 
-func (i *Indexer) FoundryByID(foundryID iotago.FoundryID) *IndexerResult {
-	query := i.db.Model(&foundry{}).
+func FoundryAsOfSlot(slot iotago.SlotIndex) options.Option[FoundryFilterOptions] {
+	return func(args *FoundryFilterOptions) {
+		args.asOfSlot = &slot
+	}
+}
+
+// FoundryByID returns the output of the given FoundryID. Only the FoundryAsOfSlot filter is taken into account.
+func (i *Indexer) FoundryByID(foundryID iotago.FoundryID, filters ...options.Option[FoundryFilterOptions]) *IndexerResult {
+	opts := options.Apply(&FoundryFilterOptions{}, filters)
+	if err := i.checkAsOfSlot(opts.asOfSlot); err != nil {
+		return errorResult(err)
+	}
+
+	query := chainOutputAtSlotQuery(i.db.Model(&foundry{}), opts.asOfSlot).
 		Where("foundry_id = ?", foundryID[:]).
 		Limit(1)
 
-	return i.combineOutputIDFilteredQuery(query, 0, nil)
+	return i.combineOutputIDFilteredQuery(query, 0, nil, SortCreatedAscending)
+}
+
+// FoundryTokenSupply contains the token scheme of a foundry.
+type FoundryTokenSupply struct {
+	FoundryID         iotago.FoundryID
+	OutputID          iotago.OutputID
+	SerialNumber      uint32
+	MintedTokens      *big.Int
+	MeltedTokens      *big.Int
+	MaximumSupply     *big.Int
+	CirculatingSupply *big.Int
+	CommittedSlot     iotago.SlotIndex
+}
+
+type foundryTokenSupplyResult struct {
+	OutputID      []byte
+	SerialNumber  uint32
+	MintedTokens  string
+	MeltedTokens  string
+	MaximumSupply string
+}
+
+// FoundryTokenSupplyByID returns the token supply of the given FoundryID. Only the FoundryAsOfSlot filter is taken into account.
+func (i *Indexer) FoundryTokenSupplyByID(foundryID iotago.FoundryID, filters ...options.Option[FoundryFilterOptions]) (*FoundryTokenSupply, error) {
+	opts := options.Apply(&FoundryFilterOptions{}, filters)
+	if err := i.checkAsOfSlot(opts.asOfSlot); err != nil {
+		return nil, err
+	}
+
+	status, err := i.Status()
+	if err != nil {
+		return nil, err
+	}
+
+	var results []foundryTokenSupplyResult
+	if err := chainOutputAtSlotQuery(i.db.Model(&foundry{}), opts.asOfSlot).
+		Select("output_id", "serial_number", "minted_tokens", "melted_tokens", "maximum_supply").
+		Where("foundry_id = ?", foundryID[:]).
+		Limit(1).
+		Find(&results).Error; err != nil {
+		return nil, err
+	}
+
+	if len(results) == 0 {
+		return nil, ErrFoundryNotFound
+	}
+	result := results[0]
+
+	mintedTokens, err := hexutil.DecodeBig(result.MintedTokens)
+	if err != nil {
+		return nil, ierrors.Wrapf(err, "invalid minted tokens: %s", result.MintedTokens)
+	}
+
+	meltedTokens, err := hexutil.DecodeBig(result.MeltedTokens)
+	if err != nil {
+		return nil, ierrors.Wrapf(err, "invalid melted tokens: %s", result.MeltedTokens)
+	}
+
+	maximumSupply, err := hexutil.DecodeBig(result.MaximumSupply)
+	if err != nil {
+		return nil, ierrors.Wrapf(err, "invalid maximum supply: %s", result.MaximumSupply)
+	}
+
+	return &FoundryTokenSupply{
+		FoundryID:         foundryID,
+		OutputID:          iotago.OutputID(result.OutputID),
+		SerialNumber:      result.SerialNumber,
+		MintedTokens:      mintedTokens,
+		MeltedTokens:      meltedTokens,
+		MaximumSupply:     maximumSupply,
+		CirculatingSupply: new(big.Int).Sub(mintedTokens, meltedTokens),
+		CommittedSlot:     status.CommittedSlot,
+	}, nil
 }
 
 func (i *Indexer) foundryOutputsQueryWithFilter(opts *FoundryFilterOptions) *gorm.DB {
-	query := i.db.Model(&foundry{}).Where("deleted_at_slot = 0")
+	query := unspentAtSlotQuery(i.db.Model(&foundry{}), opts.asOfSlot)
 
 	if opts.hasNativeToken != nil {
 		if *opts.hasNativeToken {
@@ -102,7 +236,23 @@ func (i *Indexer) foundryOutputsQueryWithFilter(opts *FoundryFilterOptions) *gor
 	}
 
 	if opts.account != nil {
-		query = query.Where("account_address = ?", opts.account.ID())
+		query = query.Where("output_id IN (?)", i.addressRefQuery(opts.account, iotago.OutputFoundry, addressRoleImmutableAccount))
+	}
+
+	if opts.serialNumber != nil {
+		query = query.Where("serial_number = ?", *opts.serialNumber)
+	}
+
+	if opts.hasMintCapacity != nil {
+		query = query.Where("has_mint_capacity = ?", *opts.hasMintCapacity)
+	}
+
+	if opts.minAmount != nil {
+		query = query.Where("amount >= ?", *opts.minAmount)
+	}
+
+	if opts.maxAmount != nil {
+		query = query.Where("amount <= ?", *opts.maxAmount)
 	}
 
 	if opts.createdBefore != nil {
@@ -120,7 +270,50 @@ func (i *Indexer) Foundry(filters ...options.Option[FoundryFilterOptions]) *Inde
 	opts := options.Apply(&FoundryFilterOptions{
 		pageSize: DefaultPageSize,
 	}, filters)
+	if err := i.checkAsOfSlot(opts.asOfSlot); err != nil {
+		return errorResult(err)
+	}
+
 	query := i.foundryOutputsQueryWithFilter(opts)
 
-	return i.combineOutputIDFilteredQuery(query, opts.pageSize, opts.cursor)
+	return i.combineOutputIDFilteredQuery(query, opts.pageSize, opts.cursor, opts.sortOrder)
+}
+
+// FoundryCount returns the amount of outputs matching the filters. The page size, cursor and sort order are ignored.
+func (i *Indexer) FoundryCount(filters ...options.Option[FoundryFilterOptions]) *CountResult {
+	opts := options.Apply(&FoundryFilterOptions{}, filters)
+	if err := i.checkAsOfSlot(opts.asOfSlot); err != nil {
+		return errorCountResult(err)
+	}
+
+	return i.countQueries([]*gorm.DB{i.foundryOutputsQueryWithFilter(opts)})
+}
+
+// FoundrySubscription subscribes to the events of the foundry outputs matching the filters. The page size, cursor, sort order and asOfSlot are ignored.
+func (i *Indexer) FoundrySubscription(filters ...options.Option[FoundryFilterOptions]) *OutputSubscription {
+	opts := options.Apply(&FoundryFilterOptions{}, filters)
+
+	return i.subscribe(opts.matchesOutput)
+}
+
+// matchesOutput matches the filters against the rows of an output in the same way foundryOutputsQueryWithFilter does.
+func (o *FoundryFilterOptions) matchesOutput(rows *outputRows) bool {
+	entry, isFoundry := rows.entry.(*foundry)
+	if !isFoundry {
+		return false
+	}
+
+	// Since the foundry can only hold its own native token, we can filter out by foundry_id here.
+	if o.nativeToken != nil && !bytes.Equal(entry.FoundryID, o.nativeToken[:]) {
+		return false
+	}
+
+	if o.account != nil && !rows.referencedBy(o.account, addressRoleImmutableAccount) {
+		return false
+	}
+
+	return matchesPresence(o.hasNativeToken, entry.NativeTokenAmount != nil) &&
+		matchesEqual(&entry.SerialNumber, o.serialNumber) &&
+		matchesEqual(&entry.HasMintCapacity, o.hasMintCapacity) &&
+		matchesCommonFilters(entry.Amount, entry.CreatedAtSlot, o.minAmount, o.maxAmount, o.createdBefore, o.createdAfter)
 }
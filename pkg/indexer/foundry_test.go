@@ -1,6 +1,7 @@
 package indexer_test
 
 import (
+	"math/big"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -111,3 +112,80 @@ func TestIndexer_FoundryOutput_NativeToken(t *testing.T) {
 	outputSet.requireFoundryFound(indexer.FoundryNativeToken(foundryID))
 	outputSet.requireFoundryNotFound(indexer.FoundryNativeToken(iotago_tpkg.RandNativeTokenID()))
 }
+
+func TestIndexer_FoundryOutput_TokenScheme(t *testing.T) {
+	ts := newTestSuite(t)
+
+	accountAddress := iotago_tpkg.RandAccountAddress()
+	foundryID, err := iotago.FoundryIDFromAddressAndSerialNumberAndTokenScheme(accountAddress, 5, iotago.TokenSchemeSimple)
+	require.NoError(t, err)
+
+	output := &iotago.FoundryOutput{
+		Amount:       0,
+		SerialNumber: 5,
+		TokenScheme: &iotago.SimpleTokenScheme{
+			MintedTokens:  big.NewInt(1000),
+			MeltedTokens:  big.NewInt(250),
+			MaximumSupply: big.NewInt(5000),
+		},
+		UnlockConditions: iotago.FoundryOutputUnlockConditions{
+			&iotago.ImmutableAccountUnlockCondition{
+				Address: accountAddress,
+			},
+		},
+		Features:          iotago.FoundryOutputFeatures{},
+		ImmutableFeatures: iotago.FoundryOutputImmFeatures{},
+	}
+
+	outputID := iotago_tpkg.RandOutputID(0)
+	outputSet := ts.AddOutputOnCommitment(output, outputID)
+
+	// Serial number
+	outputSet.requireFoundryFound(indexer.FoundrySerialNumber(5))
+	outputSet.requireFoundryNotFound(indexer.FoundrySerialNumber(0))
+
+	// Mint capacity
+	outputSet.requireFoundryFound(indexer.FoundryHasMintCapacity(true))
+	outputSet.requireFoundryNotFound(indexer.FoundryHasMintCapacity(false))
+
+	// Token supply
+	supply, err := ts.Indexer.FoundryTokenSupplyByID(foundryID)
+	require.NoError(t, err)
+	require.Equal(t, foundryID, supply.FoundryID)
+	require.Equal(t, outputID, supply.OutputID)
+	require.Equal(t, uint32(5), supply.SerialNumber)
+	require.Equal(t, big.NewInt(1000), supply.MintedTokens)
+	require.Equal(t, big.NewInt(250), supply.MeltedTokens)
+	require.Equal(t, big.NewInt(5000), supply.MaximumSupply)
+	require.Equal(t, big.NewInt(750), supply.CirculatingSupply)
+	require.Equal(t, ts.CurrentSlot(), supply.CommittedSlot)
+
+	_, err = ts.Indexer.FoundryTokenSupplyByID(iotago_tpkg.RandNativeTokenID())
+	require.ErrorIs(t, err, indexer.ErrFoundryNotFound)
+}
+
+func TestIndexer_FoundryOutput_NoMintCapacity(t *testing.T) {
+	ts := newTestSuite(t)
+
+	output := &iotago.FoundryOutput{
+		Amount:       0,
+		SerialNumber: 0,
+		TokenScheme: &iotago.SimpleTokenScheme{
+			MintedTokens:  big.NewInt(5000),
+			MeltedTokens:  big.NewInt(0),
+			MaximumSupply: big.NewInt(5000),
+		},
+		UnlockConditions: iotago.FoundryOutputUnlockConditions{
+			&iotago.ImmutableAccountUnlockCondition{
+				Address: iotago_tpkg.RandAccountAddress(),
+			},
+		},
+		Features:          iotago.FoundryOutputFeatures{},
+		ImmutableFeatures: iotago.FoundryOutputImmFeatures{},
+	}
+
+	outputSet := ts.AddOutputOnCommitment(output, iotago_tpkg.RandOutputID(0))
+
+	outputSet.requireFoundryFound(indexer.FoundryHasMintCapacity(false))
+	outputSet.requireFoundryNotFound(indexer.FoundryHasMintCapacity(true))
+}
@@ -0,0 +1,166 @@
+package indexer_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/inx-indexer/pkg/indexer"
+	iotago "github.com/iotaledger/iota.go/v4"
+	iotago_tpkg "github.com/iotaledger/iota.go/v4/tpkg"
+)
+
+// TestIndexer_History_CommitAdd_CommitDelete tests the following scenario with the history enabled:
+// 1. Add output on commitment
+// 2. Delete output on commitment
+// 3. Remove uncommitted changes -> committed deletion must not be reverted
+func TestIndexer_History_CommitAdd_CommitDelete(t *testing.T) {
+	for _, tt := range tests {
+		t.Run(tt.name, tt.historyCommitAddThenCommitDelete)
+	}
+}
+
+func (o *outputTest) historyCommitAddThenCommitDelete(t *testing.T) {
+	ts := newTestSuite(t, indexer.WithHistoryRetention(0))
+
+	// Commit Add
+	ts.AddOutputOnCommitment(o.output, o.outputID) // Slot 1
+
+	// Committed outputs are found
+	ts.requireFound(o.outputID)
+
+	// Commit Delete
+	ts.DeleteOutputOnCommitment(o.outputID) // Slot 2
+
+	// Output should not be found anymore (but still in db)
+	ts.requireNotFound(o.outputID)
+
+	// Commit (so that all uncommitted deletes are reverted)
+	ts.CommitEmptyLedgerUpdate() // Slot 3
+
+	// Output should still not be found because the deletion was committed
+	ts.requireNotFound(o.outputID)
+
+	require.NoError(t, ts.Indexer.RemoveUncommittedChanges())
+
+	// Output should still not be found because the deletion was committed
+	ts.requireNotFound(o.outputID)
+
+	// Nothing is pruned if the history is kept forever
+	pruned, err := ts.Indexer.PruneHistory()
+	require.NoError(t, err)
+	require.Zero(t, pruned)
+	require.Equal(t, iotago.SlotIndex(0), ts.HistoryStartSlot())
+}
+
+func TestIndexer_History_Pruning(t *testing.T) {
+	ts := newTestSuite(t, indexer.WithHistoryRetention(2))
+
+	// Commit Add
+	ts.AddOutputOnCommitment(basicOutputWithAddress(iotago_tpkg.RandEd25519Address()), iotago_tpkg.RandOutputID(0))              // Slot 1
+	outputSet := ts.AddOutputOnCommitment(basicOutputWithAddress(iotago_tpkg.RandEd25519Address()), iotago_tpkg.RandOutputID(0)) // Slot 2
+
+	// Commit Delete
+	ts.DeleteOutputOnCommitment(outputSet.Outputs[0]) // Slot 3
+
+	// The spent output is still within the retention window
+	ts.CommitEmptyLedgerUpdate() // Slot 4
+	pruned, err := ts.Indexer.PruneHistory()
+	require.NoError(t, err)
+	require.Zero(t, pruned)
+	require.Equal(t, iotago.SlotIndex(2), ts.HistoryStartSlot())
+
+	// The spent output is outside the retention window
+	ts.CommitEmptyLedgerUpdate() // Slot 5
+	pruned, err = ts.Indexer.PruneHistory()
+	require.NoError(t, err)
+	require.Equal(t, int64(1), pruned)
+	require.Equal(t, iotago.SlotIndex(3), ts.HistoryStartSlot())
+
+	// The unspent output is still found
+	require.Len(t, ts.Indexer.Combined().OutputIDs, 1)
+}
+
+func TestIndexer_History_Disabled(t *testing.T) {
+	ts := newTestSuite(t)
+
+	outputSet := ts.AddOutputOnCommitment(basicOutputWithAddress(iotago_tpkg.RandEd25519Address()), iotago_tpkg.RandOutputID(0)) // Slot 1
+	require.Equal(t, iotago.SlotIndex(1), ts.HistoryStartSlot())
+
+	ts.DeleteOutputOnCommitment(outputSet.Outputs[0]) // Slot 2
+	require.Equal(t, iotago.SlotIndex(2), ts.HistoryStartSlot())
+
+	// Spent outputs were deleted right away
+	pruned, err := ts.Indexer.PruneHistory()
+	require.NoError(t, err)
+	require.Zero(t, pruned)
+}
+
+func TestIndexer_History_AsOfSlot(t *testing.T) {
+	ts := newTestSuite(t, indexer.WithHistoryRetention(0))
+
+	outputSetA := ts.AddOutputOnCommitment(basicOutputWithAddress(iotago_tpkg.RandEd25519Address()), iotago_tpkg.RandOutputID(0)) // Slot 1
+	outputSetB := ts.AddOutputOnCommitment(basicOutputWithAddress(iotago_tpkg.RandEd25519Address()), iotago_tpkg.RandOutputID(0)) // Slot 2
+	ts.DeleteOutputOnCommitment(outputSetA.Outputs[0])                                                                            // Slot 3
+
+	require.Equal(t, iotago.OutputIDs{}, ts.Indexer.Basic(indexer.BasicAsOfSlot(0)).OutputIDs)
+	require.Equal(t, outputSetA.Outputs, ts.Indexer.Basic(indexer.BasicAsOfSlot(1)).OutputIDs)
+	require.ElementsMatch(t, iotago.OutputIDs{outputSetA.Outputs[0], outputSetB.Outputs[0]}, ts.Indexer.Basic(indexer.BasicAsOfSlot(2)).OutputIDs)
+	require.Equal(t, outputSetB.Outputs, ts.Indexer.Basic(indexer.BasicAsOfSlot(3)).OutputIDs)
+	require.Equal(t, outputSetB.Outputs, ts.Indexer.Basic().OutputIDs)
+
+	require.ElementsMatch(t, iotago.OutputIDs{outputSetA.Outputs[0], outputSetB.Outputs[0]}, ts.Indexer.Combined(indexer.CombinedAsOfSlot(2)).OutputIDs)
+
+	// Slots that were not committed yet can not be queried
+	require.ErrorIs(t, ts.Indexer.Basic(indexer.BasicAsOfSlot(4)).Error, indexer.ErrSlotNotRetained)
+	require.ErrorIs(t, ts.Indexer.Combined(indexer.CombinedAsOfSlot(4)).Error, indexer.ErrSlotNotRetained)
+}
+
+func TestIndexer_History_AsOfSlot_ByID(t *testing.T) {
+	ts := newTestSuite(t, indexer.WithHistoryRetention(0))
+
+	address := iotago_tpkg.RandEd25519Address()
+	accountID := iotago_tpkg.RandAccountID()
+
+	firstOutput := accountOutputWithAddress(address).(*iotago.AccountOutput)
+	firstOutput.AccountID = accountID
+	firstOutputSet := ts.AddOutputOnCommitment(firstOutput, iotago_tpkg.RandOutputID(0)) // Slot 1
+
+	// Transition the account
+	secondOutput := firstOutput.Clone().(*iotago.AccountOutput)
+	secondOutputID := iotago_tpkg.RandOutputID(0)
+	require.NoError(t, ts.Indexer.CommitLedgerUpdate(&indexer.LedgerUpdate{
+		Slot: 2,
+		Consumed: []*indexer.LedgerOutput{
+			{
+				OutputID: firstOutputSet.Outputs[0],
+				Output:   firstOutput,
+				BookedAt: 1,
+				SpentAt:  2,
+			},
+		},
+		Created: []*indexer.LedgerOutput{
+			{
+				OutputID: secondOutputID,
+				Output:   secondOutput,
+				BookedAt: 2,
+			},
+		},
+	}))
+
+	require.Equal(t, iotago.OutputIDs{secondOutputID}, ts.Indexer.AccountByID(accountID).OutputIDs)
+	require.Equal(t, firstOutputSet.Outputs, ts.Indexer.AccountByID(accountID, indexer.AccountAsOfSlot(1)).OutputIDs)
+	require.Equal(t, iotago.OutputIDs{secondOutputID}, ts.Indexer.AccountByID(accountID, indexer.AccountAsOfSlot(2)).OutputIDs)
+	require.ErrorIs(t, ts.Indexer.AccountByID(accountID, indexer.AccountAsOfSlot(3)).Error, indexer.ErrSlotNotRetained)
+}
+
+func TestIndexer_History_AsOfSlot_Disabled(t *testing.T) {
+	ts := newTestSuite(t)
+
+	outputSet := ts.AddOutputOnCommitment(basicOutputWithAddress(iotago_tpkg.RandEd25519Address()), iotago_tpkg.RandOutputID(0)) // Slot 1
+	ts.CommitEmptyLedgerUpdate()                                                                                                 // Slot 2
+
+	// Without history only the latest committed slot can be queried
+	require.Equal(t, outputSet.Outputs, ts.Indexer.Basic(indexer.BasicAsOfSlot(2)).OutputIDs)
+	require.ErrorIs(t, ts.Indexer.Basic(indexer.BasicAsOfSlot(1)).Error, indexer.ErrSlotNotRetained)
+}
@@ -199,24 +199,29 @@ func (p *processor[T]) closeAndWait() {
 }
 
 func (i *Indexer) ImportTransaction(ctx context.Context) *ImportTransaction {
-	return newImportTransaction(ctx, i.db, i.Logger)
+	return newImportTransaction(ctx, i.db, i.apiProvider, i.Logger)
 }
 
 type ImportTransaction struct {
 	log.Logger
 
-	db *gorm.DB
-
-	basic        *processor[*basic]
-	nft          *processor[*nft]
-	account      *processor[*account]
-	anchor       *processor[*anchor]
-	foundry      *processor[*foundry]
-	delegation   *processor[*delegation]
-	multiAddress *processor[*multiaddress]
+	db          *gorm.DB
+	apiProvider iotago.APIProvider
+
+	basic          *processor[*basic]
+	nft            *processor[*nft]
+	account        *processor[*account]
+	anchor         *processor[*anchor]
+	foundry        *processor[*foundry]
+	delegation     *processor[*delegation]
+	multiAddress   *processor[*multiaddress]
+	nativeToken    *processor[*nativeToken]
+	blockIssuerKey *processor[*blockIssuerKey]
+	addressRef     *processor[*addressRef]
+	outputData     *processor[*outputData]
 }
 
-func newImportTransaction(ctx context.Context, db *gorm.DB, logger log.Logger) *ImportTransaction {
+func newImportTransaction(ctx context.Context, db *gorm.DB, apiProvider iotago.APIProvider, logger log.Logger) *ImportTransaction {
 	// use a session without logger and hooks to reduce the amount of work that needs to be done by gorm.
 	dbSession := db.Session(&gorm.Session{
 		SkipHooks:              true,
@@ -225,15 +230,20 @@ func newImportTransaction(ctx context.Context, db *gorm.DB, logger log.Logger) *
 	})
 
 	t := &ImportTransaction{
-		Logger:       logger,
-		db:           dbSession,
-		basic:        newProcessor[*basic](ctx, dbSession, logger),
-		nft:          newProcessor[*nft](ctx, dbSession, logger),
-		account:      newProcessor[*account](ctx, dbSession, logger),
-		anchor:       newProcessor[*anchor](ctx, dbSession, logger),
-		foundry:      newProcessor[*foundry](ctx, dbSession, logger),
-		delegation:   newProcessor[*delegation](ctx, dbSession, logger),
-		multiAddress: newProcessor[*multiaddress](ctx, dbSession, logger),
+		Logger:         logger,
+		db:             dbSession,
+		apiProvider:    apiProvider,
+		basic:          newProcessor[*basic](ctx, dbSession, logger),
+		nft:            newProcessor[*nft](ctx, dbSession, logger),
+		account:        newProcessor[*account](ctx, dbSession, logger),
+		anchor:         newProcessor[*anchor](ctx, dbSession, logger),
+		foundry:        newProcessor[*foundry](ctx, dbSession, logger),
+		delegation:     newProcessor[*delegation](ctx, dbSession, logger),
+		multiAddress:   newProcessor[*multiaddress](ctx, dbSession, logger),
+		nativeToken:    newProcessor[*nativeToken](ctx, dbSession, logger),
+		blockIssuerKey: newProcessor[*blockIssuerKey](ctx, dbSession, logger),
+		addressRef:     newProcessor[*addressRef](ctx, dbSession, logger),
+		outputData:     newProcessor[*outputData](ctx, dbSession, logger),
 	}
 
 	return t
@@ -267,6 +277,33 @@ func (i *ImportTransaction) AddOutput(outputID iotago.OutputID, output iotago.Ou
 
 	i.multiAddress.enqueue(multiAddresses...)
 
+	nativeToken, err := nativeTokenEntryForOutput(output)
+	if err != nil {
+		return err
+	}
+	if nativeToken != nil {
+		i.nativeToken.enqueue(nativeToken)
+	}
+
+	blockIssuerKeys, err := blockIssuerKeysForOutput(outputID, output)
+	if err != nil {
+		return err
+	}
+
+	i.blockIssuerKey.enqueue(blockIssuerKeys...)
+
+	i.addressRef.enqueue(addressRefsForOutput(outputID, output, slotBooked)...)
+
+	data, err := outputDataForOutput(i.apiProvider, outputID, output, slotBooked, true)
+	if err != nil {
+		// The output is still indexed, it is just not returned together with its outputID
+		i.LogWarn(err.Error())
+
+		return nil
+	}
+
+	i.outputData.enqueue(data)
+
 	return nil
 }
 
@@ -279,19 +316,35 @@ func (i *ImportTransaction) Finalize(committedSlot iotago.SlotIndex, networkName
 	i.foundry.closeAndWait()
 	i.delegation.closeAndWait()
 	i.multiAddress.closeAndWait()
+	i.nativeToken.closeAndWait()
+	i.blockIssuerKey.closeAndWait()
+	i.addressRef.closeAndWait()
+	i.outputData.closeAndWait()
 
 	i.LogDebugf("Finished insertion, update committedSlot")
 
 	// Update the indexer status
+	// The imported ledger only contains unspent outputs, so the history starts at the committed slot
+	changeLogID, err := newChangeLogID()
+	if err != nil {
+		return err
+	}
+
 	status := &Status{
-		ID:              1,
-		CommittedSlot:   committedSlot,
-		NetworkName:     networkName,
-		DatabaseVersion: databaseVersion,
+		ID:               1,
+		CommittedSlot:    committedSlot,
+		HistoryStartSlot: committedSlot,
+		NetworkName:      networkName,
+		DatabaseVersion:  databaseVersion,
+		ChangeLogID:      changeLogID,
 	}
-	i.db.Clauses(clause.OnConflict{
+	if err := i.db.Clauses(clause.OnConflict{
 		UpdateAll: true,
-	}).Create(&status)
+	}).Create(status).Error; err != nil {
+		return err
+	}
 
-	return i.db.Error
+	// Collect the statistics of the freshly imported address references, so that the query planner
+	// looks up outputs by their address references instead of scanning the output tables.
+	return i.db.Exec("ANALYZE address_refs").Error
 }
@@ -1,15 +1,19 @@
 package indexer
 
 import (
+	dbsql "database/sql"
 	"sync"
+	"sync/atomic"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"golang.org/x/sync/singleflight"
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 
 	"github.com/iotaledger/hive.go/db"
 	"github.com/iotaledger/hive.go/ierrors"
 	"github.com/iotaledger/hive.go/log"
+	"github.com/iotaledger/hive.go/runtime/options"
 	"github.com/iotaledger/hive.go/sql"
 	iotago "github.com/iotaledger/iota.go/v4"
 )
@@ -21,10 +25,17 @@ var (
 var (
 	ErrStatusNotFound      = ierrors.New("status not found")
 	ErrLedgerUpdateSkipped = ierrors.New("ledger update skipped")
+	ErrSlotNotRetained     = ierrors.New("slot is outside of the retained history")
+	ErrFoundryNotFound     = ierrors.New("foundry not found")
 
 	dbTables = append([]interface{}{
 		&Status{},
 		&multiaddress{},
+		&nativeToken{},
+		&blockIssuerKey{},
+		&addressRef{},
+		&outputData{},
+		&changeLogEntry{},
 	}, outputTables...)
 
 	outputTables = []interface{}{
@@ -35,28 +46,73 @@ var (
 		&anchor{},
 		&delegation{},
 	}
+
+	// outputDependentTables are the tables whose rows live and die with the output row they belong to.
+	outputDependentTables = []interface{}{
+		&addressRef{},
+		&outputData{},
+	}
 )
 
 type Indexer struct {
 	log.Logger
-	db     *gorm.DB
-	engine db.Engine
+	db          *gorm.DB
+	engine      db.Engine
+	apiProvider iotago.APIProvider
 
 	lastCommittedSlot      iotago.SlotIndex
 	lastCommittedSlotMutex sync.RWMutex
+
+	subscriptions      map[*OutputSubscription]struct{}
+	subscriptionsMutex sync.Mutex
+
+	// ledgerVersion is increased with every change of the outputs, it is used to invalidate cached aggregates.
+	ledgerVersion atomic.Uint64
+
+	// nativeTokenTotals caches the holder count and total amount of the queried native tokens at the current ledger version.
+	nativeTokenTotals      map[iotago.NativeTokenID]*nativeTokenTotals
+	nativeTokenTotalsMutex sync.Mutex
+	// nativeTokenTotalsGroup lets concurrent requests for the totals of the same native token share a single scan.
+	nativeTokenTotalsGroup singleflight.Group
+
+	// optsHistoryEnabled defines whether committed spent outputs are kept in the database.
+	optsHistoryEnabled bool
+	// optsHistoryRetention defines for how many slots committed spent outputs are kept (0 = forever).
+	optsHistoryRetention iotago.SlotIndex
+	// optsChangeLogRetention defines for how many slots the entries of the change log are kept (0 = forever).
+	optsChangeLogRetention iotago.SlotIndex
 }
 
-func NewIndexer(dbParams sql.DatabaseParameters, logger log.Logger) (*Indexer, error) {
+func NewIndexer(dbParams sql.DatabaseParameters, apiProvider iotago.APIProvider, logger log.Logger, opts ...options.Option[Indexer]) (*Indexer, error) {
 	db, engine, err := sql.New(logger, dbParams, true, AllowedEngines)
 	if err != nil {
 		return nil, err
 	}
 
-	return &Indexer{
-		Logger: logger,
-		db:     db,
-		engine: engine,
-	}, nil
+	return options.Apply(&Indexer{
+		Logger:            logger,
+		db:                db,
+		engine:            engine,
+		apiProvider:       apiProvider,
+		subscriptions:     make(map[*OutputSubscription]struct{}),
+		nativeTokenTotals: make(map[iotago.NativeTokenID]*nativeTokenTotals),
+	}, opts), nil
+}
+
+// WithHistoryRetention enables keeping committed spent outputs in the database instead of deleting them.
+// Spent outputs are kept for the given amount of slots, a retention of 0 keeps them forever.
+func WithHistoryRetention(retentionSlots iotago.SlotIndex) options.Option[Indexer] {
+	return func(i *Indexer) {
+		i.optsHistoryEnabled = true
+		i.optsHistoryRetention = retentionSlots
+	}
+}
+
+// WithChangeLogRetention sets for how many slots the entries of the change log are kept, a retention of 0 keeps them forever.
+func WithChangeLogRetention(retentionSlots iotago.SlotIndex) options.Option[Indexer] {
+	return func(i *Indexer) {
+		i.optsChangeLogRetention = retentionSlots
+	}
 }
 
 func addressesInOutput(output iotago.Output) []iotago.Address {
@@ -125,11 +181,31 @@ func tableForOutput(output iotago.Output) interface{} {
 	}
 }
 
-func processSpent(output *LedgerOutput, committed bool, tx *gorm.DB) error {
+func (i *Indexer) processSpent(output *LedgerOutput, committed bool, tx *gorm.DB) error {
 	// Properly delete the outputs if they were committed
 	if committed {
-		if err := tx.Where("output_id = ?", output.OutputID[:]).Delete(tableForOutput(output.Output)).Error; err != nil {
-			return err
+		if i.optsHistoryEnabled {
+			// Keep the output, but mark the deletion as committed so that it is not reverted and can be pruned later
+			if err := tx.Model(tableForOutput(output.Output)).Where("output_id = ?", output.OutputID[:]).Updates(map[string]interface{}{
+				"deleted_at_slot": output.SpentAt,
+				"spent_at_slot":   output.SpentAt,
+			}).Error; err != nil {
+				return err
+			}
+		} else {
+			if err := tx.Where("output_id = ?", output.OutputID[:]).Delete(tableForOutput(output.Output)).Error; err != nil {
+				return err
+			}
+
+			if err := tx.Where("output_id = ?", output.OutputID[:]).Delete(&blockIssuerKey{}).Error; err != nil {
+				return err
+			}
+
+			for _, dependentTable := range outputDependentTables {
+				if err := tx.Where("output_id = ?", output.OutputID[:]).Delete(dependentTable).Error; err != nil {
+					return err
+				}
+			}
 		}
 
 		// Delete committed MultiAddress deletions
@@ -145,14 +221,23 @@ func processSpent(output *LedgerOutput, committed bool, tx *gorm.DB) error {
 }
 
 func removeUncommittedChangesUpUntilSlot(committedSlot iotago.SlotIndex, tx *gorm.DB) error {
+	// Remove the block issuer keys of the uncommitted account insertions before the accounts themselves are gone
+	if err := deleteBlockIssuerKeysOfAccounts(tx, "created_at_slot <= ? AND committed = false AND deleted_at_slot <= ?", committedSlot, committedSlot); err != nil {
+		return err
+	}
+
 	for _, table := range outputTables {
+		if err := deleteDependentsOfOutputs(tx, table, "created_at_slot <= ? AND committed = false AND deleted_at_slot <= ?", committedSlot, committedSlot); err != nil {
+			return err
+		}
+
 		// Remove the uncommitted insertions (this does not delete the outputs that were already marked to be deleted at a later point in time)
 		if err := tx.Where("created_at_slot <= ? AND committed = false AND deleted_at_slot <= ?", committedSlot, committedSlot).Delete(table).Error; err != nil {
 			return err
 		}
 
-		// Revert all uncommitted deletions
-		if err := tx.Model(table).Where("deleted_at_slot > 0 AND deleted_at_slot <= ?", committedSlot).Update("deleted_at_slot", 0).Error; err != nil {
+		// Revert all uncommitted deletions (committed deletions that are kept as history have a spent_at_slot set)
+		if err := tx.Model(table).Where("deleted_at_slot > 0 AND deleted_at_slot <= ? AND spent_at_slot = 0", committedSlot).Update("deleted_at_slot", 0).Error; err != nil {
 			return err
 		}
 	}
@@ -161,6 +246,8 @@ func removeUncommittedChangesUpUntilSlot(committedSlot iotago.SlotIndex, tx *gor
 }
 
 func (i *Indexer) RemoveUncommittedChanges() error {
+	defer i.ledgerVersion.Add(1)
+
 	return i.db.Transaction(func(tx *gorm.DB) error {
 		// Remove all MultiAddresses with only pending references
 		if err := deleteMultiAddressesWithOnlyUncommittedReferences(tx); err != nil {
@@ -168,11 +255,27 @@ func (i *Indexer) RemoveUncommittedChanges() error {
 		}
 
 		// Remove all uncommitted outputs
-		return removeUncommittedChangesUpUntilSlot(iotago.MaxSlotIndex, tx)
+		if err := removeUncommittedChangesUpUntilSlot(iotago.MaxSlotIndex, tx); err != nil {
+			return err
+		}
+
+		// Remove the native tokens of foundries that were never committed
+		return removeOrphanedNativeTokens(tx)
 	})
 }
 
-func processOutput(output *LedgerOutput, committed bool, tx *gorm.DB) error {
+// deleteDependentsOfOutputs removes the rows of the dependent tables of all outputs in the given table matching the given conditions.
+func deleteDependentsOfOutputs(tx *gorm.DB, table interface{}, query interface{}, args ...interface{}) error {
+	for _, dependentTable := range outputDependentTables {
+		if err := tx.Where("output_id IN (?)", tx.Model(table).Select("output_id").Where(query, args...)).Delete(dependentTable).Error; err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (i *Indexer) processOutput(output *LedgerOutput, committed bool, tx *gorm.DB) error {
 	entry, err := entryForOutput(output.OutputID, output.Output, output.BookedAt, committed)
 	if err != nil {
 		return err
@@ -194,7 +297,23 @@ func processOutput(output *LedgerOutput, committed bool, tx *gorm.DB) error {
 		return err
 	}
 
-	return insertMultiAddressesFromAddresses(tx, addressesInOutput(output.Output), committed)
+	if err := insertMultiAddressesFromAddresses(tx, addressesInOutput(output.Output), committed); err != nil {
+		return err
+	}
+
+	if err := insertNativeTokenFromOutput(tx, output.Output); err != nil {
+		return err
+	}
+
+	if err := insertAddressRefsFromOutput(tx, output); err != nil {
+		return err
+	}
+
+	if err := i.insertOutputDataFromOutput(tx, output, committed); err != nil {
+		return err
+	}
+
+	return insertBlockIssuerKeysFromOutput(tx, output.OutputID, output.Output)
 }
 
 func entryForOutput(outputID iotago.OutputID, output iotago.Output, slotBooked iotago.SlotIndex, committed bool) (interface{}, error) {
@@ -280,6 +399,22 @@ func entryForOutput(outputID iotago.OutputID, output iotago.Output, slotBooked i
 			acc.Address = address.Address.ID()
 		}
 
+		if blockIssuer := features.BlockIssuer(); blockIssuer != nil {
+			expirySlot := blockIssuer.ExpirySlot
+			acc.BlockIssuerExpirySlot = &expirySlot
+		}
+
+		if staking := features.Staking(); staking != nil {
+			stakedAmount := staking.StakedAmount
+			fixedCost := staking.FixedCost
+			startEpoch := staking.StartEpoch
+			endEpoch := staking.EndEpoch
+			acc.StakedAmount = &stakedAmount
+			acc.FixedCost = &fixedCost
+			acc.StakingStartEpoch = &startEpoch
+			acc.StakingEndEpoch = &endEpoch
+		}
+
 		entry = acc
 
 	case *iotago.AnchorOutput:
@@ -347,6 +482,13 @@ func entryForOutput(outputID iotago.OutputID, output iotago.Output, slotBooked i
 			nft.Issuer = issuerBlock.Address.ID()
 		}
 
+		if metadata := parseIRC27Metadata(immutableFeatures.Metadata()); metadata != nil {
+			nft.MetadataStandard = &metadata.Standard
+			nft.MediaType = metadata.MediaType
+			nft.Name = metadata.Name
+			nft.CollectionName = metadata.CollectionName
+		}
+
 		if senderBlock := features.SenderFeature(); senderBlock != nil {
 			nft.Sender = senderBlock.Address.ID()
 		}
@@ -389,12 +531,22 @@ func entryForOutput(outputID iotago.OutputID, output iotago.Output, slotBooked i
 		foundry := &foundry{
 			Amount:        iotaOutput.Amount,
 			FoundryID:     foundryID[:],
+			SerialNumber:  iotaOutput.SerialNumber,
 			OutputID:      make([]byte, iotago.OutputIDLength),
 			CreatedAtSlot: slotBooked,
 			Committed:     committed,
 		}
 		copy(foundry.OutputID, outputID[:])
 
+		simpleTokenScheme, ok := iotaOutput.TokenScheme.(*iotago.SimpleTokenScheme)
+		if !ok {
+			return nil, ierrors.Errorf("unsupported token scheme: %T", iotaOutput.TokenScheme)
+		}
+		foundry.MintedTokens = hexutil.EncodeBig(simpleTokenScheme.MintedTokens)
+		foundry.MeltedTokens = hexutil.EncodeBig(simpleTokenScheme.MeltedTokens)
+		foundry.MaximumSupply = hexutil.EncodeBig(simpleTokenScheme.MaximumSupply)
+		foundry.HasMintCapacity = simpleTokenScheme.MintedTokens.Cmp(simpleTokenScheme.MaximumSupply) < 0
+
 		if nativeToken := features.NativeToken(); nativeToken != nil {
 			amount := hexutil.EncodeBig(nativeToken.Amount)
 			foundry.NativeTokenAmount = &amount
@@ -416,11 +568,14 @@ func entryForOutput(outputID iotago.OutputID, output iotago.Output, slotBooked i
 		}
 
 		delegation := &delegation{
-			Amount:        iotaOutput.Amount,
-			DelegationID:  make([]byte, iotago.DelegationIDLength),
-			OutputID:      make([]byte, iotago.OutputIDLength),
-			CreatedAtSlot: slotBooked,
-			Committed:     committed,
+			Amount:          iotaOutput.Amount,
+			DelegatedAmount: iotaOutput.DelegatedAmount,
+			StartEpoch:      iotaOutput.StartEpoch,
+			EndEpoch:        iotaOutput.EndEpoch,
+			DelegationID:    make([]byte, iotago.DelegationIDLength),
+			OutputID:        make([]byte, iotago.OutputIDLength),
+			CreatedAtSlot:   slotBooked,
+			Committed:       committed,
 		}
 		copy(delegation.DelegationID, delegationID[:])
 		copy(delegation.OutputID, outputID[:])
@@ -474,7 +629,14 @@ func (i *Indexer) AutoMigrate() error {
 }
 
 func (i *Indexer) AcceptLedgerUpdate(update *LedgerUpdate) error {
-	return i.db.Transaction(func(tx *gorm.DB) error {
+	defer i.ledgerVersion.Add(1)
+
+	events, err := i.matchLedgerUpdate(update, false)
+	if err != nil {
+		return err
+	}
+
+	if err := i.db.Transaction(func(tx *gorm.DB) error {
 		i.lastCommittedSlotMutex.RLock()
 		lastCommitted := i.lastCommittedSlot
 		i.lastCommittedSlotMutex.RUnlock()
@@ -486,7 +648,7 @@ func (i *Indexer) AcceptLedgerUpdate(update *LedgerUpdate) error {
 		spentOutputs := make(map[iotago.OutputID]struct{})
 		for _, output := range update.Consumed {
 			spentOutputs[output.OutputID] = struct{}{}
-			if err := processSpent(output, false, tx); err != nil {
+			if err := i.processSpent(output, false, tx); err != nil {
 				return err
 			}
 		}
@@ -496,16 +658,29 @@ func (i *Indexer) AcceptLedgerUpdate(update *LedgerUpdate) error {
 				// We only care about the end-result of the confirmation, so outputs that were already spent in the same update can be ignored
 				continue
 			}
-			if err := processOutput(output, false, tx); err != nil {
+			if err := i.processOutput(output, false, tx); err != nil {
 				return err
 			}
 		}
 
 		return nil
-	})
+	}); err != nil {
+		return err
+	}
+
+	i.publishOutputEvents(events)
+
+	return nil
 }
 
 func (i *Indexer) CommitLedgerUpdate(update *LedgerUpdate) error {
+	defer i.ledgerVersion.Add(1)
+
+	events, err := i.matchLedgerUpdate(update, true)
+	if err != nil {
+		return err
+	}
+
 	if err := i.db.Transaction(func(tx *gorm.DB) error {
 		// Cleanup uncommitted changes for this update
 		if err := removeUncommittedChangesUpUntilSlot(update.Slot, tx); err != nil {
@@ -515,7 +690,7 @@ func (i *Indexer) CommitLedgerUpdate(update *LedgerUpdate) error {
 		spentOutputs := make(map[iotago.OutputID]struct{})
 		for _, output := range update.Consumed {
 			spentOutputs[output.OutputID] = struct{}{}
-			if err := processSpent(output, true, tx); err != nil {
+			if err := i.processSpent(output, true, tx); err != nil {
 				return err
 			}
 		}
@@ -525,12 +700,23 @@ func (i *Indexer) CommitLedgerUpdate(update *LedgerUpdate) error {
 				// We only care about the end-result of the confirmation, so outputs that were already spent in the same update can be ignored
 				continue
 			}
-			if err := processOutput(output, true, tx); err != nil {
+			if err := i.processOutput(output, true, tx); err != nil {
 				return err
 			}
 		}
 
-		tx.Model(&Status{}).Where("id = ?", 1).Update("committed_slot", update.Slot)
+		if err := i.insertChangeLogEntries(tx, update); err != nil {
+			return err
+		}
+
+		statusUpdate := map[string]interface{}{
+			"committed_slot": update.Slot,
+		}
+		if !i.optsHistoryEnabled {
+			// Without history all outputs spent up to this slot are gone, so historic data is only available from here on
+			statusUpdate["history_start_slot"] = update.Slot
+		}
+		tx.Model(&Status{}).Where("id = ?", 1).Updates(statusUpdate)
 
 		return nil
 	}); err != nil {
@@ -538,18 +724,24 @@ func (i *Indexer) CommitLedgerUpdate(update *LedgerUpdate) error {
 	}
 
 	i.lastCommittedSlotMutex.Lock()
-	defer i.lastCommittedSlotMutex.Unlock()
-
 	if i.lastCommittedSlot < update.Slot {
 		i.lastCommittedSlot = update.Slot
 	}
+	i.lastCommittedSlotMutex.Unlock()
+
+	i.publishOutputEvents(events)
 
 	return nil
 }
 
 func (i *Indexer) Status() (*Status, error) {
+	return i.statusWithDB(i.db)
+}
+
+// statusWithDB reads the status with the given database handle, so that it can be read within a transaction.
+func (i *Indexer) statusWithDB(db *gorm.DB) (*Status, error) {
 	status := &Status{}
-	if err := i.db.Take(&status).Error; err != nil {
+	if err := db.Take(&status).Error; err != nil {
 		if ierrors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, ErrStatusNotFound
 		}
@@ -574,11 +766,66 @@ func (i *Indexer) Status() (*Status, error) {
 	return status, nil
 }
 
+// readTransaction runs the queries of f in a read only transaction, so that all of them see the same state of the ledger.
+func (i *Indexer) readTransaction(f func(tx *gorm.DB) error) error {
+	return i.db.Transaction(f, &dbsql.TxOptions{Isolation: dbsql.LevelRepeatableRead, ReadOnly: true})
+}
+
+// PruneHistory removes all committed spent outputs that are outside the configured history retention window
+// and returns the amount of removed outputs. If the history is disabled, all remaining spent outputs are removed.
+func (i *Indexer) PruneHistory() (int64, error) {
+	status, err := i.Status()
+	if err != nil {
+		return 0, err
+	}
+
+	var pruneUntilSlot iotago.SlotIndex
+	switch {
+	case !i.optsHistoryEnabled:
+		pruneUntilSlot = status.CommittedSlot
+	case i.optsHistoryRetention == 0 || status.CommittedSlot <= i.optsHistoryRetention:
+		// Nothing to prune
+		return 0, nil
+	default:
+		pruneUntilSlot = status.CommittedSlot - i.optsHistoryRetention
+	}
+
+	var pruned int64
+	if err := i.db.Transaction(func(tx *gorm.DB) error {
+		if err := deleteBlockIssuerKeysOfAccounts(tx, "spent_at_slot > 0 AND spent_at_slot <= ?", pruneUntilSlot); err != nil {
+			return err
+		}
+
+		for _, table := range outputTables {
+			if err := deleteDependentsOfOutputs(tx, table, "spent_at_slot > 0 AND spent_at_slot <= ?", pruneUntilSlot); err != nil {
+				return err
+			}
+
+			result := tx.Where("spent_at_slot > 0 AND spent_at_slot <= ?", pruneUntilSlot).Delete(table)
+			if err := result.Error; err != nil {
+				return err
+			}
+			pruned += result.RowsAffected
+		}
+
+		if err := removeOrphanedNativeTokens(tx); err != nil {
+			return err
+		}
+
+		return tx.Model(&Status{}).Where("id = ? AND history_start_slot < ?", 1, pruneUntilSlot).Update("history_start_slot", pruneUntilSlot).Error
+	}); err != nil {
+		return 0, err
+	}
+
+	return pruned, nil
+}
+
 func (i *Indexer) Clear() error {
 	i.lastCommittedSlotMutex.Lock()
 	defer i.lastCommittedSlotMutex.Unlock()
 
 	i.lastCommittedSlot = 0
+	defer i.ledgerVersion.Add(1)
 
 	// Drop all tables
 	if err := i.db.Migrator().DropTable(dbTables...); err != nil {
@@ -5,6 +5,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/iotaledger/inx-indexer/pkg/indexer"
 	iotago "github.com/iotaledger/iota.go/v4"
 	iotago_tpkg "github.com/iotaledger/iota.go/v4/tpkg"
 )
@@ -260,6 +261,61 @@ func (o *outputTest) acceptAddThenAcceptDeleteThenCommitAdd(t *testing.T) {
 	ts.requireNotFound(o.outputID)
 }
 
+func TestIndexer_AmountFilters(t *testing.T) {
+	ts := newTestSuite(t)
+
+	address := iotago_tpkg.RandEd25519Address()
+
+	dustOutput := basicOutputWithAddress(address).(*iotago.BasicOutput)
+	dustOutput.Amount = 500
+	dustOutputID := iotago_tpkg.RandOutputID(0)
+	ts.AddOutputOnCommitment(dustOutput, dustOutputID)
+
+	largeOutput := basicOutputWithAddress(address).(*iotago.BasicOutput)
+	largeOutput.Amount = 1_000_000_000
+	largeOutputID := iotago_tpkg.RandOutputID(0)
+	ts.AddOutputOnCommitment(largeOutput, largeOutputID)
+
+	nftOutputID := iotago_tpkg.RandOutputID(0)
+	ts.AddOutputOnCommitment(nftOutputWithAddressAndSender(address), nftOutputID)
+
+	// Basic
+	require.Equal(t, iotago.OutputIDs{dustOutputID}, ts.Indexer.Basic(indexer.BasicUnlockAddress(address), indexer.BasicMaxAmount(1000)).OutputIDs)
+	require.Equal(t, iotago.OutputIDs{largeOutputID}, ts.Indexer.Basic(indexer.BasicUnlockAddress(address), indexer.BasicMinAmount(1_000_000)).OutputIDs)
+	require.Empty(t, ts.Indexer.Basic(indexer.BasicUnlockAddress(address), indexer.BasicMinAmount(1000), indexer.BasicMaxAmount(1_000_000)).OutputIDs)
+
+	// Combined
+	require.Equal(t, iotago.OutputIDs{dustOutputID}, ts.Indexer.Combined(indexer.CombinedUnlockableByAddress(address), indexer.CombinedMaxAmount(1000)).OutputIDs)
+	require.Equal(t, iotago.OutputIDs{nftOutputID}, ts.Indexer.Combined(indexer.CombinedUnlockableByAddress(address), indexer.CombinedMinAmount(1000), indexer.CombinedMaxAmount(1_000_000)).OutputIDs)
+
+	// All other output types are created with an amount of 100000
+	accountAddress := iotago_tpkg.RandAccountAddress()
+
+	accountOutputSet := ts.AddOutputOnCommitment(accountOutputWithAddress(address), iotago_tpkg.RandOutputID(0))
+	accountOutputSet.requireAccountFound(indexer.AccountMinAmount(100000), indexer.AccountMaxAmount(100000))
+	accountOutputSet.requireAccountNotFound(indexer.AccountMinAmount(100001))
+	accountOutputSet.requireAccountNotFound(indexer.AccountMaxAmount(99999))
+
+	anchorOutputSet := ts.AddOutputOnCommitment(anchorOutputWithAddress(address), iotago_tpkg.RandOutputID(0))
+	anchorOutputSet.requireAnchorFound(indexer.AnchorMinAmount(100000), indexer.AnchorMaxAmount(100000))
+	anchorOutputSet.requireAnchorNotFound(indexer.AnchorMinAmount(100001))
+	anchorOutputSet.requireAnchorNotFound(indexer.AnchorMaxAmount(99999))
+
+	require.Equal(t, iotago.OutputIDs{nftOutputID}, ts.Indexer.NFT(indexer.NFTMinAmount(100000), indexer.NFTMaxAmount(100000)).OutputIDs)
+	require.Empty(t, ts.Indexer.NFT(indexer.NFTMinAmount(100001)).OutputIDs)
+	require.Empty(t, ts.Indexer.NFT(indexer.NFTMaxAmount(99999)).OutputIDs)
+
+	foundryOutputSet := ts.AddOutputOnCommitment(foundryOutputWithAddress(accountAddress), iotago_tpkg.RandOutputID(0))
+	foundryOutputSet.requireFoundryFound(indexer.FoundryMinAmount(100000), indexer.FoundryMaxAmount(100000))
+	foundryOutputSet.requireFoundryNotFound(indexer.FoundryMinAmount(100001))
+	foundryOutputSet.requireFoundryNotFound(indexer.FoundryMaxAmount(99999))
+
+	delegationOutputSet := ts.AddOutputOnCommitment(delegationOutputWithAddress(address), iotago_tpkg.RandOutputID(0))
+	delegationOutputSet.requireDelegationFound(indexer.DelegationMinAmount(100000), indexer.DelegationMaxAmount(100000))
+	delegationOutputSet.requireDelegationNotFound(indexer.DelegationMinAmount(100001))
+	delegationOutputSet.requireDelegationNotFound(indexer.DelegationMaxAmount(99999))
+}
+
 func basicOutputWithAddress(address iotago.Address) iotago.Output {
 	return &iotago.BasicOutput{
 		Amount: 100000,
@@ -0,0 +1,37 @@
+package indexer
+
+import (
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+const (
+	// IRC27Standard is the value of the "standard" key of IRC27 compliant NFT metadata.
+	IRC27Standard = "IRC27"
+
+	irc27KeyType           iotago.MetadataFeatureEntriesKey = "type"
+	irc27KeyName           iotago.MetadataFeatureEntriesKey = "name"
+	irc27KeyCollectionName iotago.MetadataFeatureEntriesKey = "collectionName"
+)
+
+type irc27Metadata struct {
+	Standard       string
+	MediaType      *string
+	Name           *string
+	CollectionName *string
+}
+
+// parseIRC27Metadata extracts the IRC27 keys from the immutable metadata of an NFT.
+// Returns nil if the metadata does not follow the IRC27 standard.
+func parseIRC27Metadata(metadata *iotago.MetadataFeature) *irc27Metadata {
+	entries, ok := metadataStandard(metadata, IRC27Standard)
+	if !ok {
+		return nil
+	}
+
+	return &irc27Metadata{
+		Standard:       IRC27Standard,
+		MediaType:      metadataValue(entries, irc27KeyType),
+		Name:           metadataValue(entries, irc27KeyName),
+		CollectionName: metadataValue(entries, irc27KeyCollectionName),
+	}
+}
@@ -0,0 +1,58 @@
+package indexer
+
+import (
+	"strconv"
+
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+const (
+	// IRC30Standard is the value of the "standard" key of IRC30 compliant native token metadata.
+	IRC30Standard = "IRC30"
+
+	irc30KeyName        iotago.MetadataFeatureEntriesKey = "name"
+	irc30KeySymbol      iotago.MetadataFeatureEntriesKey = "symbol"
+	irc30KeyDecimals    iotago.MetadataFeatureEntriesKey = "decimals"
+	irc30KeyDescription iotago.MetadataFeatureEntriesKey = "description"
+	irc30KeyURL         iotago.MetadataFeatureEntriesKey = "url"
+	irc30KeyLogoURL     iotago.MetadataFeatureEntriesKey = "logoUrl"
+)
+
+type irc30Metadata struct {
+	Name        string
+	Symbol      string
+	Decimals    uint32
+	Description *string
+	URL         *string
+	LogoURL     *string
+}
+
+// parseIRC30Metadata extracts the IRC30 keys from the immutable metadata of a foundry.
+// Returns nil if the metadata does not follow the IRC30 standard or if a mandatory key is missing.
+func parseIRC30Metadata(metadata *iotago.MetadataFeature) *irc30Metadata {
+	entries, ok := metadataStandard(metadata, IRC30Standard)
+	if !ok {
+		return nil
+	}
+
+	name := metadataValue(entries, irc30KeyName)
+	symbol := metadataValue(entries, irc30KeySymbol)
+	decimalsValue := metadataValue(entries, irc30KeyDecimals)
+	if name == nil || symbol == nil || decimalsValue == nil {
+		return nil
+	}
+
+	decimals, err := strconv.ParseUint(*decimalsValue, 10, 32)
+	if err != nil {
+		return nil
+	}
+
+	return &irc30Metadata{
+		Name:        *name,
+		Symbol:      *symbol,
+		Decimals:    uint32(decimals),
+		Description: metadataValue(entries, irc30KeyDescription),
+		URL:         metadataValue(entries, irc30KeyURL),
+		LogoURL:     metadataValue(entries, irc30KeyLogoURL),
+	}
+}
@@ -0,0 +1,46 @@
+package indexer
+
+import (
+	"strings"
+
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+const (
+	// MaxMetadataFieldLength is the maximum length of an indexed metadata value.
+	// Longer values are truncated so that they still fit into the database indexes.
+	MaxMetadataFieldLength = 256
+
+	metadataKeyStandard iotago.MetadataFeatureEntriesKey = "standard"
+)
+
+// metadataValue returns the value of the given key of the metadata as a string, or nil if the key does not exist.
+func metadataValue(entries iotago.MetadataFeatureEntries, key iotago.MetadataFeatureEntriesKey) *string {
+	value, exists := entries[key]
+	if !exists || len(value) == 0 {
+		return nil
+	}
+
+	if len(value) > MaxMetadataFieldLength {
+		value = value[:MaxMetadataFieldLength]
+	}
+
+	// Values are arbitrary bytes, so we need to make sure that we only store valid UTF-8 strings.
+	result := strings.ToValidUTF8(string(value), "")
+
+	return &result
+}
+
+// metadataStandard returns the entries of the metadata if it follows the given standard.
+func metadataStandard(metadata *iotago.MetadataFeature, standard string) (iotago.MetadataFeatureEntries, bool) {
+	if metadata == nil {
+		return nil, false
+	}
+
+	value := metadataValue(metadata.Entries, metadataKeyStandard)
+	if value == nil || *value != standard {
+		return nil, false
+	}
+
+	return metadata.Entries, true
+}
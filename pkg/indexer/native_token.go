@@ -0,0 +1,296 @@
+package indexer
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"gorm.io/gorm"
+
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/hive.go/runtime/options"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+// NativeTokenHolder contains the total amount of a native token held by an address.
+type NativeTokenHolder struct {
+	Address iotago.Address
+	Amount  *big.Int
+}
+
+// NativeTokenHoldersResult contains a page of holders of a native token,
+// together with the amount of holders and the total amount held in unspent outputs.
+type NativeTokenHoldersResult struct {
+	Holders       []*NativeTokenHolder
+	HolderCount   uint64
+	TotalAmount   *big.Int
+	CommittedSlot iotago.SlotIndex
+	PageSize      uint32
+	Cursor        *string
+	Error         error
+}
+
+type NativeTokenHoldersFilterOptions struct {
+	pageSize uint32
+	cursor   *string
+}
+
+func NativeTokenHoldersPageSize(pageSize uint32) options.Option[NativeTokenHoldersFilterOptions] {
+	return func(args *NativeTokenHoldersFilterOptions) {
+		args.pageSize = pageSize
+	}
+}
+
+func NativeTokenHoldersCursor(cursor string) options.Option[NativeTokenHoldersFilterOptions] {
+	return func(args *NativeTokenHoldersFilterOptions) {
+		args.cursor = &cursor
+	}
+}
+
+type nativeTokenHoldingResult struct {
+	Address           []byte
+	NativeTokenAmount string
+}
+
+// addressFromID reconstructs an address from the ID that is stored in the database.
+// MultiAddresses are only stored as a reference, so they are returned as a MultiAddressReference.
+func addressFromID(addressID []byte) (iotago.Address, error) {
+	if len(addressID) == 0 {
+		return nil, ierrors.New("empty address ID")
+	}
+
+	//nolint:exhaustive // all other address types can be decoded directly.
+	switch iotago.AddressType(addressID[0]) {
+	case iotago.AddressMulti:
+		multiAddressRef, _, err := iotago.MultiAddressReferenceFromBytes(addressID)
+		if err != nil {
+			return nil, err
+		}
+
+		return multiAddressRef, nil
+
+	case iotago.AddressRestricted:
+		if len(addressID) > 1 && iotago.AddressType(addressID[1]) == iotago.AddressMulti {
+			multiAddressRef, consumed, err := iotago.MultiAddressReferenceFromBytes(addressID[1:])
+			if err != nil {
+				return nil, err
+			}
+
+			capabilities, _, err := iotago.AddressCapabilitiesBitMaskFromBytes(addressID[1+consumed:])
+			if err != nil {
+				return nil, err
+			}
+
+			return &iotago.RestrictedAddress{
+				Address:             multiAddressRef,
+				AllowedCapabilities: capabilities,
+			}, nil
+		}
+	}
+
+	address, _, err := iotago.AddressFromBytes(addressID)
+	if err != nil {
+		return nil, err
+	}
+
+	return address, nil
+}
+
+// nativeTokenHoldingsQuery returns the address and the hex encoded amount of all unspent outputs holding the given native token.
+// Foundries holding their own native token are attributed to the controlling account.
+func (i *Indexer) nativeTokenHoldingsQuery(nativeTokenID iotago.NativeTokenID) *gorm.DB {
+	hasNativeToken := true
+
+	basicQuery := i.basicQueryWithFilter(&BasicFilterOptions{
+		hasNativeToken: &hasNativeToken,
+		nativeToken:    &nativeTokenID,
+	}).Select("address", "native_token_amount")
+
+	foundryQuery := i.foundryOutputsQueryWithFilter(&FoundryFilterOptions{
+		hasNativeToken: &hasNativeToken,
+		nativeToken:    &nativeTokenID,
+	}).Select("account_address as address", "native_token_amount")
+
+	return i.db.Raw("SELECT address, native_token_amount FROM (?) as basic_holdings UNION ALL SELECT address, native_token_amount FROM (?) as foundry_holdings", basicQuery, foundryQuery)
+}
+
+func sumNativeTokenAmounts(holdings []nativeTokenHoldingResult) (*big.Int, error) {
+	total := new(big.Int)
+	for _, holding := range holdings {
+		amount, err := hexutil.DecodeBig(holding.NativeTokenAmount)
+		if err != nil {
+			return nil, ierrors.Wrapf(err, "invalid native token amount: %s", holding.NativeTokenAmount)
+		}
+		total.Add(total, amount)
+	}
+
+	return total, nil
+}
+
+// nativeTokenTotals contains the holder count and the total amount of a native token at a ledger version.
+type nativeTokenTotals struct {
+	ledgerVersion uint64
+	holderCount   uint64
+	totalAmount   *big.Int
+}
+
+// nativeTokenTotalsOfHoldings returns the holder count and the total amount of the native token at the given ledger version.
+// Calculating them needs to scan all holdings, so they are cached until the outputs change
+// instead of being recalculated for every page of holders.
+func (i *Indexer) nativeTokenTotalsOfHoldings(tx *gorm.DB, ledgerVersion uint64, nativeTokenID iotago.NativeTokenID, holdingsQuery *gorm.DB) (*nativeTokenTotals, error) {
+	i.nativeTokenTotalsMutex.Lock()
+	totals, exists := i.nativeTokenTotals[nativeTokenID]
+	i.nativeTokenTotalsMutex.Unlock()
+
+	if exists && totals.ledgerVersion == ledgerVersion {
+		return totals, nil
+	}
+
+	// the lock is not held during the scan, so that the requests for other native tokens don't have to wait for it
+	result, err, _ := i.nativeTokenTotalsGroup.Do(fmt.Sprintf("%s:%d", nativeTokenID.ToHex(), ledgerVersion), func() (interface{}, error) {
+		totals := &nativeTokenTotals{
+			ledgerVersion: ledgerVersion,
+			totalAmount:   new(big.Int),
+		}
+
+		if err := tx.Table("(?) as holdings", holdingsQuery).Select("COUNT(DISTINCT address)").Scan(&totals.holderCount).Error; err != nil {
+			return nil, err
+		}
+
+		if err := scanRows(tx, tx.Table("(?) as holdings", holdingsQuery).Select("native_token_amount"), func(holding *nativeTokenHoldingResult) error {
+			amount, err := hexutil.DecodeBig(holding.NativeTokenAmount)
+			if err != nil {
+				return ierrors.Wrapf(err, "invalid native token amount: %s", holding.NativeTokenAmount)
+			}
+			totals.totalAmount.Add(totals.totalAmount, amount)
+
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+
+		// totals of a scan that overlapped with a change are outdated right away
+		if i.ledgerVersion.Load() != ledgerVersion {
+			return totals, nil
+		}
+
+		i.nativeTokenTotalsMutex.Lock()
+		defer i.nativeTokenTotalsMutex.Unlock()
+
+		// totals of older versions are outdated and would never be used again
+		for cachedNativeTokenID, cached := range i.nativeTokenTotals {
+			if cached.ledgerVersion != ledgerVersion {
+				delete(i.nativeTokenTotals, cachedNativeTokenID)
+			}
+		}
+		i.nativeTokenTotals[nativeTokenID] = totals
+
+		return totals, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	//nolint:forcetypeassert // the group only returns nativeTokenTotals
+	return result.(*nativeTokenTotals), nil
+}
+
+// NativeTokenHolders returns the addresses holding the given native token in unspent outputs, ordered by address.
+func (i *Indexer) NativeTokenHolders(nativeTokenID iotago.NativeTokenID, filters ...options.Option[NativeTokenHoldersFilterOptions]) *NativeTokenHoldersResult {
+	opts := options.Apply(&NativeTokenHoldersFilterOptions{
+		pageSize: DefaultPageSize,
+	}, filters)
+
+	var cursor []byte
+	if opts.cursor != nil {
+		var err error
+		if cursor, err = hex.DecodeString(*opts.cursor); err != nil || len(cursor) == 0 {
+			return &NativeTokenHoldersResult{Error: ierrors.Errorf("Invalid cursor: %s", *opts.cursor)}
+		}
+	}
+
+	// the version is read before the transaction starts, so totals cached at this version are not older than the transaction
+	ledgerVersion := i.ledgerVersion.Load()
+
+	// the totals and the page are read in a single transaction, so that they match the returned committed slot
+	var status *Status
+	var totals *nativeTokenTotals
+	var nextCursor *string
+	var holders []*NativeTokenHolder
+	if err := i.readTransaction(func(tx *gorm.DB) error {
+		var err error
+		if status, err = i.statusWithDB(tx); err != nil {
+			return err
+		}
+
+		holdingsQuery := i.nativeTokenHoldingsQuery(nativeTokenID)
+
+		if totals, err = i.nativeTokenTotalsOfHoldings(tx, ledgerVersion, nativeTokenID, holdingsQuery); err != nil {
+			return err
+		}
+
+		addressesQuery := tx.Table("(?) as holdings", holdingsQuery).Select("address").Group("address").Order("address asc")
+		if cursor != nil {
+			addressesQuery = addressesQuery.Where("address >= ?", cursor)
+		}
+		if opts.pageSize > 0 {
+			// We use pageSize + 1 to load the next item to use as the cursor
+			addressesQuery = addressesQuery.Limit(int(opts.pageSize + 1))
+		}
+
+		var addressIDs [][]byte
+		if err := addressesQuery.Pluck("address", &addressIDs).Error; err != nil {
+			return err
+		}
+
+		if opts.pageSize > 0 && uint32(len(addressIDs)) > opts.pageSize {
+			c := hex.EncodeToString(addressIDs[len(addressIDs)-1])
+			nextCursor = &c
+			addressIDs = addressIDs[:len(addressIDs)-1]
+		}
+
+		holders = make([]*NativeTokenHolder, 0, len(addressIDs))
+		if len(addressIDs) > 0 {
+			var holdings []nativeTokenHoldingResult
+			if err := tx.Table("(?) as holdings", holdingsQuery).Where("address IN ?", addressIDs).Find(&holdings).Error; err != nil {
+				return err
+			}
+
+			holdingsByAddress := make(map[string][]nativeTokenHoldingResult)
+			for _, holding := range holdings {
+				holdingsByAddress[string(holding.Address)] = append(holdingsByAddress[string(holding.Address)], holding)
+			}
+
+			for _, addressID := range addressIDs {
+				address, err := addressFromID(addressID)
+				if err != nil {
+					return ierrors.Wrapf(err, "invalid address ID: %s", hex.EncodeToString(addressID))
+				}
+
+				amount, err := sumNativeTokenAmounts(holdingsByAddress[string(addressID)])
+				if err != nil {
+					return err
+				}
+
+				holders = append(holders, &NativeTokenHolder{
+					Address: address,
+					Amount:  amount,
+				})
+			}
+		}
+
+		return nil
+	}); err != nil {
+		return &NativeTokenHoldersResult{Error: err}
+	}
+
+	return &NativeTokenHoldersResult{
+		Holders:       holders,
+		HolderCount:   totals.holderCount,
+		TotalAmount:   new(big.Int).Set(totals.totalAmount),
+		CommittedSlot: status.CommittedSlot,
+		PageSize:      opts.pageSize,
+		Cursor:        nextCursor,
+	}
+}
@@ -0,0 +1,116 @@
+package indexer_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/inx-indexer/pkg/indexer"
+	iotago "github.com/iotaledger/iota.go/v4"
+	iotago_tpkg "github.com/iotaledger/iota.go/v4/tpkg"
+)
+
+func basicOutputWithNativeToken(address iotago.Address, nativeTokenID iotago.NativeTokenID, amount int64) iotago.Output {
+	return &iotago.BasicOutput{
+		Amount: 100000,
+		UnlockConditions: iotago.BasicOutputUnlockConditions{
+			&iotago.AddressUnlockCondition{
+				Address: address,
+			},
+		},
+		Features: iotago.BasicOutputFeatures{
+			&iotago.NativeTokenFeature{
+				ID:     nativeTokenID,
+				Amount: big.NewInt(amount),
+			},
+		},
+	}
+}
+
+func TestIndexer_NativeTokenHolders(t *testing.T) {
+	ts := newTestSuite(t)
+
+	accountAddress := iotago_tpkg.RandAccountAddress()
+	nativeTokenID, err := iotago.FoundryIDFromAddressAndSerialNumberAndTokenScheme(accountAddress, 0, iotago.TokenSchemeSimple)
+	require.NoError(t, err)
+
+	address1 := iotago_tpkg.RandEd25519Address()
+	address2 := iotago_tpkg.RandEd25519Address()
+
+	ts.AddOutputOnCommitment(basicOutputWithNativeToken(address1, nativeTokenID, 10), iotago_tpkg.RandOutputID(0))
+	ts.AddOutputOnCommitment(basicOutputWithNativeToken(address1, nativeTokenID, 15), iotago_tpkg.RandOutputID(0))
+	ts.AddOutputOnCommitment(basicOutputWithNativeToken(address2, nativeTokenID, 5), iotago_tpkg.RandOutputID(0))
+
+	// Another native token held by the same address must not be counted
+	ts.AddOutputOnCommitment(basicOutputWithNativeToken(address2, iotago_tpkg.RandNativeTokenID(), 1000), iotago_tpkg.RandOutputID(0))
+
+	// The foundry holding its own native token is attributed to the account
+	ts.AddOutputOnCommitment(&iotago.FoundryOutput{
+		Amount: 100000,
+		TokenScheme: &iotago.SimpleTokenScheme{
+			MintedTokens:  big.NewInt(100),
+			MeltedTokens:  big.NewInt(0),
+			MaximumSupply: big.NewInt(1000),
+		},
+		UnlockConditions: iotago.FoundryOutputUnlockConditions{
+			&iotago.ImmutableAccountUnlockCondition{
+				Address: accountAddress,
+			},
+		},
+		Features: iotago.FoundryOutputFeatures{
+			&iotago.NativeTokenFeature{
+				ID:     nativeTokenID,
+				Amount: big.NewInt(70),
+			},
+		},
+	}, iotago_tpkg.RandOutputID(0))
+
+	result := ts.Indexer.NativeTokenHolders(nativeTokenID)
+	require.NoError(t, result.Error)
+	require.Equal(t, ts.CurrentSlot(), result.CommittedSlot)
+	require.Equal(t, uint64(3), result.HolderCount)
+	require.Equal(t, big.NewInt(100), result.TotalAmount)
+	require.Nil(t, result.Cursor)
+
+	amounts := make(map[string]*big.Int)
+	for _, holder := range result.Holders {
+		amounts[holder.Address.Key()] = holder.Amount
+	}
+	require.Equal(t, map[string]*big.Int{
+		address1.Key():       big.NewInt(25),
+		address2.Key():       big.NewInt(5),
+		accountAddress.Key(): big.NewInt(70),
+	}, amounts)
+
+	// Paginate through the holders
+	var paginated []*indexer.NativeTokenHolder
+	page := ts.Indexer.NativeTokenHolders(nativeTokenID, indexer.NativeTokenHoldersPageSize(2))
+	require.NoError(t, page.Error)
+	require.Len(t, page.Holders, 2)
+	require.NotNil(t, page.Cursor)
+	require.Equal(t, uint64(3), page.HolderCount)
+	paginated = append(paginated, page.Holders...)
+
+	page = ts.Indexer.NativeTokenHolders(nativeTokenID, indexer.NativeTokenHoldersPageSize(2), indexer.NativeTokenHoldersCursor(*page.Cursor))
+	require.NoError(t, page.Error)
+	require.Len(t, page.Holders, 1)
+	require.Nil(t, page.Cursor)
+	paginated = append(paginated, page.Holders...)
+
+	require.Equal(t, result.Holders, paginated)
+
+	// The cached totals are updated by accepted outputs
+	ts.AddOutputOnAcceptance(basicOutputWithNativeToken(iotago_tpkg.RandEd25519Address(), nativeTokenID, 50), iotago_tpkg.RandOutputID(0), ts.CurrentSlot()+1)
+	page = ts.Indexer.NativeTokenHolders(nativeTokenID, indexer.NativeTokenHoldersPageSize(2))
+	require.NoError(t, page.Error)
+	require.Equal(t, uint64(4), page.HolderCount)
+	require.Equal(t, big.NewInt(150), page.TotalAmount)
+
+	// Unknown native token
+	result = ts.Indexer.NativeTokenHolders(iotago_tpkg.RandNativeTokenID())
+	require.NoError(t, result.Error)
+	require.Zero(t, result.HolderCount)
+	require.Zero(t, result.TotalAmount.Sign())
+	require.Empty(t, result.Holders)
+}
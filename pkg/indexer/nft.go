@@ -3,6 +3,7 @@ package indexer
 import (
 	"encoding/hex"
 	"fmt"
+	"strings"
 
 	"gorm.io/gorm"
 
@@ -11,20 +12,25 @@ import (
 )
 
 type nft struct {
-	OutputID                    []byte `gorm:"primaryKey;notnull"`
-	NFTID                       []byte `gorm:"notnull"`
-	Amount                      iotago.BaseToken
-	Issuer                      []byte `gorm:"index:nfts_issuer"`
-	Sender                      []byte `gorm:"index:nfts_sender_tag"`
-	Tag                         []byte `gorm:"index:nfts_sender_tag"`
-	Address                     []byte `gorm:"notnull;index:nfts_address"`
+	OutputID                    []byte           `gorm:"primaryKey;notnull;index:nfts_created_at_slot_output_id,priority:2;index:nfts_amount_output_id,priority:2"`
+	NFTID                       []byte           `gorm:"notnull"`
+	Amount                      iotago.BaseToken `gorm:"index:nfts_amount_output_id,priority:1"`
+	Issuer                      []byte           `gorm:"index:nfts_issuer"`
+	MetadataStandard            *string
+	MediaType                   *string `gorm:"index:nfts_media_type"`
+	Name                        *string `gorm:"index:nfts_name"`
+	CollectionName              *string `gorm:"index:nfts_collection_name"`
+	Sender                      []byte  `gorm:"index:nfts_sender_tag"`
+	Tag                         []byte  `gorm:"index:nfts_sender_tag"`
+	Address                     []byte  `gorm:"notnull;index:nfts_address"`
 	StorageDepositReturn        *uint64
 	StorageDepositReturnAddress []byte `gorm:"index:nfts_storage_deposit_return_address"`
 	TimelockSlot                *iotago.SlotIndex
 	ExpirationSlot              *iotago.SlotIndex
 	ExpirationReturnAddress     []byte           `gorm:"index:nfts_expiration_return_address"`
-	CreatedAtSlot               iotago.SlotIndex `gorm:"notnull;index:nfts_created_at_slot"`
+	CreatedAtSlot               iotago.SlotIndex `gorm:"notnull;index:nfts_created_at_slot_output_id,priority:1"`
 	DeletedAtSlot               iotago.SlotIndex `gorm:"notnull;index:nfts_deleted_at_slot"`
+	SpentAtSlot                 iotago.SlotIndex `gorm:"notnull;index:nfts_spent_at_slot"`
 	Committed                   bool
 }
 
@@ -45,12 +51,20 @@ type NFTFilterOptions struct {
 	timelockedBefore                 *iotago.SlotIndex
 	timelockedAfter                  *iotago.SlotIndex
 	issuer                           iotago.Address
+	collection                       *iotago.NFTID
+	collectionName                   *string
+	mediaType                        *string
+	namePrefix                       *string
 	sender                           iotago.Address
 	tag                              []byte
 	pageSize                         uint32
 	cursor                           *string
+	sortOrder                        SortOrder
 	createdBefore                    *iotago.SlotIndex
 	createdAfter                     *iotago.SlotIndex
+	minAmount                        *iotago.BaseToken
+	maxAmount                        *iotago.BaseToken
+	asOfSlot                         *iotago.SlotIndex
 }
 
 func NFTUnlockableByAddress(address iotago.Address) options.Option[NFTFilterOptions] {
@@ -125,6 +139,34 @@ func NFTIssuer(address iotago.Address) options.Option[NFTFilterOptions] {
 	}
 }
 
+// NFTCollection filters for NFTs that were issued by the NFT with the given NFTID.
+func NFTCollection(nftID iotago.NFTID) options.Option[NFTFilterOptions] {
+	return func(args *NFTFilterOptions) {
+		args.collection = &nftID
+	}
+}
+
+// NFTCollectionName filters for NFTs with the given IRC27 collection name.
+func NFTCollectionName(collectionName string) options.Option[NFTFilterOptions] {
+	return func(args *NFTFilterOptions) {
+		args.collectionName = &collectionName
+	}
+}
+
+// NFTMediaType filters for NFTs with the given IRC27 media type.
+func NFTMediaType(mediaType string) options.Option[NFTFilterOptions] {
+	return func(args *NFTFilterOptions) {
+		args.mediaType = &mediaType
+	}
+}
+
+// NFTNamePrefix filters for NFTs with an IRC27 name starting with the given prefix (case-insensitive).
+func NFTNamePrefix(prefix string) options.Option[NFTFilterOptions] {
+	return func(args *NFTFilterOptions) {
+		args.namePrefix = &prefix
+	}
+}
+
 func NFTSender(address iotago.Address) options.Option[NFTFilterOptions] {
 	return func(args *NFTFilterOptions) {
 		args.sender = address
@@ -137,6 +179,20 @@ func NFTTag(tag []byte) options.Option[NFTFilterOptions] {
 	}
 }
 
+// NFTMinAmount filters for outputs holding at least the given amount of base tokens.
+func NFTMinAmount(amount iotago.BaseToken) options.Option[NFTFilterOptions] {
+	return func(args *NFTFilterOptions) {
+		args.minAmount = &amount
+	}
+}
+
+// NFTMaxAmount filters for outputs holding at most the given amount of base tokens.
+func NFTMaxAmount(amount iotago.BaseToken) options.Option[NFTFilterOptions] {
+	return func(args *NFTFilterOptions) {
+		args.maxAmount = &amount
+	}
+}
+
 func NFTPageSize(pageSize uint32) options.Option[NFTFilterOptions] {
 	return func(args *NFTFilterOptions) {
 		args.pageSize = pageSize
@@ -149,6 +205,13 @@ func NFTCursor(cursor string) options.Option[NFTFilterOptions] {
 	}
 }
 
+// NFTSortOrder defines the order in which the outputIDs are returned.
+func NFTSortOrder(sortOrder SortOrder) options.Option[NFTFilterOptions] {
+	return func(args *NFTFilterOptions) {
+		args.sortOrder = sortOrder
+	}
+}
+
 func NFTCreatedBefore(slot iotago.SlotIndex) options.Option[NFTFilterOptions] {
 	return func(args *NFTFilterOptions) {
 		args.createdBefore = &slot
@@ -161,24 +224,35 @@ func NFTCreatedAfter(slot iotago.SlotIndex) options.Option[NFTFilterOptions] {
 	}
 }
 
-func (i *Indexer) NFTByID(nftID iotago.NFTID) *IndexerResult {
-	query := i.db.Model(&nft{}).
+func NFTAsOfSlot(slot iotago.SlotIndex) options.Option[NFTFilterOptions] {
+	return func(args *NFTFilterOptions) {
+		args.asOfSlot = &slot
+	}
+}
+
+// NFTByID returns the output of the given NFTID. Only the NFTAsOfSlot filter is taken into account.
+func (i *Indexer) NFTByID(nftID iotago.NFTID, filters ...options.Option[NFTFilterOptions]) *IndexerResult {
+	opts := options.Apply(&NFTFilterOptions{}, filters)
+	if err := i.checkAsOfSlot(opts.asOfSlot); err != nil {
+		return errorResult(err)
+	}
+
+	query := chainOutputAtSlotQuery(i.db.Model(&nft{}), opts.asOfSlot).
 		Where("nft_id = ?", nftID[:]).
 		Limit(1)
 
-	return i.combineOutputIDFilteredQuery(query, 0, nil)
+	return i.combineOutputIDFilteredQuery(query, 0, nil, SortCreatedAscending)
 }
 
 func (i *Indexer) nftQueryWithFilter(opts *NFTFilterOptions) *gorm.DB {
-	query := i.db.Model(&nft{}).Where("deleted_at_slot = 0")
+	query := unspentAtSlotQuery(i.db.Model(&nft{}), opts.asOfSlot)
 
 	if opts.unlockableByAddress != nil {
-		addrID := opts.unlockableByAddress.ID()
-		query = query.Where("(address = ? OR expiration_return_address = ? OR storage_deposit_return_address = ?)", addrID, addrID, addrID)
+		query = query.Where("output_id IN (?)", i.addressRefQuery(opts.unlockableByAddress, iotago.OutputNFT, addressRolesUnlockable...))
 	}
 
 	if opts.address != nil {
-		query = query.Where("address = ?", opts.address.ID())
+		query = query.Where("output_id IN (?)", i.addressRefQuery(opts.address, iotago.OutputNFT, addressRoleUnlock))
 	}
 
 	if opts.hasStorageDepositReturnCondition != nil {
@@ -233,6 +307,22 @@ func (i *Indexer) nftQueryWithFilter(opts *NFTFilterOptions) *gorm.DB {
 		query = query.Where("issuer = ?", opts.issuer.ID())
 	}
 
+	if opts.collection != nil {
+		query = query.Where("issuer = ?", opts.collection.ToAddress().ID())
+	}
+
+	if opts.collectionName != nil {
+		query = query.Where("collection_name = ?", *opts.collectionName)
+	}
+
+	if opts.mediaType != nil {
+		query = query.Where("media_type = ?", *opts.mediaType)
+	}
+
+	if opts.namePrefix != nil {
+		query = i.prefixQuery(query, "name", *opts.namePrefix)
+	}
+
 	if opts.sender != nil {
 		query = query.Where("sender = ?", opts.sender.ID())
 	}
@@ -241,6 +331,14 @@ func (i *Indexer) nftQueryWithFilter(opts *NFTFilterOptions) *gorm.DB {
 		query = query.Where("tag = ?", opts.tag)
 	}
 
+	if opts.minAmount != nil {
+		query = query.Where("amount >= ?", *opts.minAmount)
+	}
+
+	if opts.maxAmount != nil {
+		query = query.Where("amount <= ?", *opts.maxAmount)
+	}
+
 	if opts.createdBefore != nil {
 		query = query.Where("created_at_slot < ?", *opts.createdBefore)
 	}
@@ -256,7 +354,63 @@ func (i *Indexer) NFT(filters ...options.Option[NFTFilterOptions]) *IndexerResul
 	opts := options.Apply(&NFTFilterOptions{
 		pageSize: DefaultPageSize,
 	}, filters)
+	if err := i.checkAsOfSlot(opts.asOfSlot); err != nil {
+		return errorResult(err)
+	}
+
 	query := i.nftQueryWithFilter(opts)
 
-	return i.combineOutputIDFilteredQuery(query, opts.pageSize, opts.cursor)
+	return i.combineOutputIDFilteredQuery(query, opts.pageSize, opts.cursor, opts.sortOrder)
+}
+
+// NFTCount returns the amount of outputs matching the filters. The page size, cursor and sort order are ignored.
+func (i *Indexer) NFTCount(filters ...options.Option[NFTFilterOptions]) *CountResult {
+	opts := options.Apply(&NFTFilterOptions{}, filters)
+	if err := i.checkAsOfSlot(opts.asOfSlot); err != nil {
+		return errorCountResult(err)
+	}
+
+	return i.countQueries([]*gorm.DB{i.nftQueryWithFilter(opts)})
+}
+
+// NFTSubscription subscribes to the events of the NFT outputs matching the filters. The page size, cursor, sort order and asOfSlot are ignored.
+func (i *Indexer) NFTSubscription(filters ...options.Option[NFTFilterOptions]) *OutputSubscription {
+	opts := options.Apply(&NFTFilterOptions{}, filters)
+
+	return i.subscribe(opts.matchesOutput)
+}
+
+// matchesOutput matches the filters against the rows of an output in the same way nftQueryWithFilter does.
+func (o *NFTFilterOptions) matchesOutput(rows *outputRows) bool {
+	entry, isNFT := rows.entry.(*nft)
+	if !isNFT {
+		return false
+	}
+
+	if o.collection != nil && !matchesAddress(entry.Issuer, o.collection.ToAddress()) {
+		return false
+	}
+
+	// The name prefix is matched case-insensitive like in prefixQuery
+	if o.namePrefix != nil && (entry.Name == nil || !strings.HasPrefix(strings.ToLower(*entry.Name), strings.ToLower(*o.namePrefix))) {
+		return false
+	}
+
+	return rows.referencedBy(o.unlockableByAddress, addressRolesUnlockable...) &&
+		rows.referencedBy(o.address, addressRoleUnlock) &&
+		matchesPresence(o.hasStorageDepositReturnCondition, entry.StorageDepositReturn != nil) &&
+		matchesAddress(entry.StorageDepositReturnAddress, o.storageDepositReturnAddress) &&
+		matchesPresence(o.hasExpirationCondition, entry.ExpirationReturnAddress != nil) &&
+		matchesAddress(entry.ExpirationReturnAddress, o.expirationReturnAddress) &&
+		matchesLess(entry.ExpirationSlot, o.expiresBefore) &&
+		matchesGreater(entry.ExpirationSlot, o.expiresAfter) &&
+		matchesPresence(o.hasTimelockCondition, entry.TimelockSlot != nil) &&
+		matchesLess(entry.TimelockSlot, o.timelockedBefore) &&
+		matchesGreater(entry.TimelockSlot, o.timelockedAfter) &&
+		matchesAddress(entry.Issuer, o.issuer) &&
+		matchesEqual(entry.CollectionName, o.collectionName) &&
+		matchesEqual(entry.MediaType, o.mediaType) &&
+		matchesAddress(entry.Sender, o.sender) &&
+		matchesBytes(entry.Tag, o.tag) &&
+		matchesCommonFilters(entry.Amount, entry.CreatedAtSlot, o.minAmount, o.maxAmount, o.createdBefore, o.createdAfter)
 }
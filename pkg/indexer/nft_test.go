@@ -146,3 +146,87 @@ func TestIndexer_NFTOutput(t *testing.T) {
 		outputSet.requireNFTNotFound(indexer.NFTUnlockableByAddress(addr))
 	}
 }
+
+func TestIndexer_NFTOutput_IRC27(t *testing.T) {
+	ts := newTestSuite(t)
+
+	collectionNFTID := iotago_tpkg.RandNFTAddress().NFTID()
+
+	output := &iotago.NFTOutput{
+		Amount: 100000,
+		UnlockConditions: iotago.NFTOutputUnlockConditions{
+			&iotago.AddressUnlockCondition{
+				Address: iotago_tpkg.RandEd25519Address(),
+			},
+		},
+		ImmutableFeatures: iotago.NFTOutputImmFeatures{
+			&iotago.IssuerFeature{
+				Address: collectionNFTID.ToAddress(),
+			},
+			&iotago.MetadataFeature{
+				Entries: iotago.MetadataFeatureEntries{
+					"standard":       []byte("IRC27"),
+					"version":        []byte("v1.0"),
+					"type":           []byte("image/png"),
+					"uri":            []byte("https://example.com/nft.png"),
+					"name":           []byte("Shimmer_Dragon #42"),
+					"collectionName": []byte("Dragons"),
+				},
+			},
+		},
+	}
+
+	outputSet := ts.AddOutputOnCommitment(output, iotago_tpkg.RandOutputID(0))
+
+	// Collection
+	outputSet.requireNFTFound(indexer.NFTCollection(collectionNFTID))
+	outputSet.requireNFTNotFound(indexer.NFTCollection(iotago_tpkg.RandNFTAddress().NFTID()))
+
+	outputSet.requireNFTFound(indexer.NFTCollectionName("Dragons"))
+	outputSet.requireNFTNotFound(indexer.NFTCollectionName("Unicorns"))
+
+	// Media type
+	outputSet.requireNFTFound(indexer.NFTMediaType("image/png"))
+	outputSet.requireNFTNotFound(indexer.NFTMediaType("image/jpeg"))
+
+	// Name prefix
+	outputSet.requireNFTFound(indexer.NFTNamePrefix("Shimmer"))
+	outputSet.requireNFTFound(indexer.NFTNamePrefix("shimmer_dragon"))
+	outputSet.requireNFTFound(indexer.NFTNamePrefix("Shimmer_Dragon #42"))
+	outputSet.requireNFTNotFound(indexer.NFTNamePrefix("Dragon"))
+	// Wildcards in the prefix are matched literally
+	outputSet.requireNFTNotFound(indexer.NFTNamePrefix("%Dragon"))
+	outputSet.requireNFTNotFound(indexer.NFTNamePrefix("Shimmer%Dragon"))
+
+	// Combined
+	outputSet.requireNFTFound(indexer.NFTCollection(collectionNFTID), indexer.NFTMediaType("image/png"), indexer.NFTNamePrefix("Shim"))
+	outputSet.requireNFTNotFound(indexer.NFTCollection(collectionNFTID), indexer.NFTMediaType("image/gif"))
+}
+
+func TestIndexer_NFTOutput_NonIRC27Metadata(t *testing.T) {
+	ts := newTestSuite(t)
+
+	output := &iotago.NFTOutput{
+		Amount: 100000,
+		UnlockConditions: iotago.NFTOutputUnlockConditions{
+			&iotago.AddressUnlockCondition{
+				Address: iotago_tpkg.RandEd25519Address(),
+			},
+		},
+		ImmutableFeatures: iotago.NFTOutputImmFeatures{
+			&iotago.MetadataFeature{
+				Entries: iotago.MetadataFeatureEntries{
+					"type": []byte("image/png"),
+					"name": []byte("Not a standard NFT"),
+				},
+			},
+		},
+	}
+
+	outputSet := ts.AddOutputOnCommitment(output, iotago_tpkg.RandOutputID(0))
+
+	// Metadata is only indexed if it follows the IRC27 standard
+	outputSet.requireNFTFound()
+	outputSet.requireNFTNotFound(indexer.NFTMediaType("image/png"))
+	outputSet.requireNFTNotFound(indexer.NFTNamePrefix("Not"))
+}
@@ -0,0 +1,126 @@
+package indexer
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/iotaledger/hive.go/ierrors"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+var (
+	ErrOutputNotFound = ierrors.New("output not found")
+)
+
+// outputData contains the serialized output, so that clients do not need to fetch it from the node.
+// The data lives and dies with the output row it belongs to.
+type outputData struct {
+	OutputID      []byte           `gorm:"primaryKey;notnull"`
+	Data          []byte           `gorm:"notnull"`
+	CreatedAtSlot iotago.SlotIndex `gorm:"notnull"`
+	Committed     bool
+}
+
+func (d *outputData) String() string {
+	return fmt.Sprintf("output data => OutputID: %s", hex.EncodeToString(d.OutputID))
+}
+
+// StoredOutput is an output as it was stored by the indexer.
+type StoredOutput struct {
+	OutputID      iotago.OutputID
+	Output        iotago.Output
+	CreatedAtSlot iotago.SlotIndex
+	Committed     bool
+	// Missing is set if the output is not (or no longer) stored, e.g. because it was spent after its outputID was queried.
+	// All other fields except the OutputID are empty then.
+	Missing bool
+}
+
+// outputDataForOutput serializes the output with the API of the slot it was booked in.
+func outputDataForOutput(apiProvider iotago.APIProvider, outputID iotago.OutputID, output iotago.Output, slotBooked iotago.SlotIndex, committed bool) (*outputData, error) {
+	data, err := apiProvider.APIForSlot(slotBooked).Encode(output)
+	if err != nil {
+		return nil, ierrors.Wrapf(err, "failed to serialize output %s", outputID.ToHex())
+	}
+
+	return &outputData{
+		OutputID:      outputID[:],
+		Data:          data,
+		CreatedAtSlot: slotBooked,
+		Committed:     committed,
+	}, nil
+}
+
+func (i *Indexer) insertOutputDataFromOutput(tx *gorm.DB, output *LedgerOutput, committed bool) error {
+	entry, err := outputDataForOutput(i.apiProvider, output.OutputID, output.Output, output.BookedAt, committed)
+	if err != nil {
+		// The output is still indexed, it is just not returned together with its outputID
+		i.LogWarn(err.Error())
+
+		return nil
+	}
+
+	if !committed {
+		return tx.Create(entry).Error
+	}
+
+	// The data might still be in the database from a previous uncommitted state, so we will only update the committed flag
+	return tx.Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "output_id"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{
+			"committed": true,
+		})}).Create(entry).Error
+}
+
+// StoredOutputs returns one stored output for each of the given outputIDs in the same order.
+// Outputs that are not (or no longer) stored are marked as missing.
+func (i *Indexer) StoredOutputs(outputIDs iotago.OutputIDs) ([]*StoredOutput, error) {
+	if len(outputIDs) == 0 {
+		return []*StoredOutput{}, nil
+	}
+
+	ids := make([][]byte, 0, len(outputIDs))
+	for _, outputID := range outputIDs {
+		ids = append(ids, outputID[:])
+	}
+
+	var entries []*outputData
+	if err := i.db.Where("output_id IN ?", ids).Find(&entries).Error; err != nil {
+		return nil, err
+	}
+
+	entriesByID := make(map[iotago.OutputID]*outputData, len(entries))
+	for _, entry := range entries {
+		entriesByID[iotago.OutputID(entry.OutputID)] = entry
+	}
+
+	storedOutputs := make([]*StoredOutput, 0, len(outputIDs))
+	for _, outputID := range outputIDs {
+		entry, exists := entriesByID[outputID]
+		if !exists {
+			storedOutputs = append(storedOutputs, &StoredOutput{
+				OutputID: outputID,
+				Missing:  true,
+			})
+
+			continue
+		}
+
+		var output iotago.TxEssenceOutput
+		if _, err := i.apiProvider.APIForSlot(entry.CreatedAtSlot).Decode(entry.Data, &output); err != nil {
+			return nil, ierrors.Wrapf(err, "failed to deserialize output %s", outputID.ToHex())
+		}
+
+		storedOutputs = append(storedOutputs, &StoredOutput{
+			OutputID:      outputID,
+			Output:        output,
+			CreatedAtSlot: entry.CreatedAtSlot,
+			Committed:     entry.Committed,
+		})
+	}
+
+	return storedOutputs, nil
+}
@@ -0,0 +1,99 @@
+package indexer_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	iotago "github.com/iotaledger/iota.go/v4"
+	iotago_tpkg "github.com/iotaledger/iota.go/v4/tpkg"
+)
+
+func TestIndexer_StoredOutputs(t *testing.T) {
+	ts := newTestSuite(t)
+
+	committedOutput := basicOutputWithAddress(iotago_tpkg.RandEd25519Address())
+	committedOutputID := iotago_tpkg.RandOutputID(0)
+	ts.AddOutputOnCommitment(committedOutput, committedOutputID) // Slot 1
+
+	acceptedOutput := nftOutputWithAddressAndSender(iotago_tpkg.RandEd25519Address())
+	acceptedOutputID := iotago_tpkg.RandOutputID(0)
+	ts.AddOutputOnAcceptance(acceptedOutput, acceptedOutputID, 2)
+
+	// The outputs are returned in the requested order, unknown outputs are marked as missing
+	unknownOutputID := iotago_tpkg.RandOutputID(0)
+	storedOutputs, err := ts.Indexer.StoredOutputs(iotago.OutputIDs{acceptedOutputID, unknownOutputID, committedOutputID})
+	require.NoError(t, err)
+	require.Len(t, storedOutputs, 3)
+
+	require.Equal(t, acceptedOutputID, storedOutputs[0].OutputID)
+	require.True(t, acceptedOutput.Equal(storedOutputs[0].Output))
+	require.Equal(t, iotago.SlotIndex(2), storedOutputs[0].CreatedAtSlot)
+	require.False(t, storedOutputs[0].Committed)
+	require.False(t, storedOutputs[0].Missing)
+
+	require.Equal(t, unknownOutputID, storedOutputs[1].OutputID)
+	require.True(t, storedOutputs[1].Missing)
+	require.Nil(t, storedOutputs[1].Output)
+
+	require.Equal(t, committedOutputID, storedOutputs[2].OutputID)
+	require.True(t, committedOutput.Equal(storedOutputs[2].Output))
+	require.Equal(t, iotago.SlotIndex(1), storedOutputs[2].CreatedAtSlot)
+	require.True(t, storedOutputs[2].Committed)
+
+	// The data of uncommitted outputs is removed together with the outputs
+	require.NoError(t, ts.Indexer.RemoveUncommittedChanges())
+
+	storedOutputs, err = ts.Indexer.StoredOutputs(iotago.OutputIDs{acceptedOutputID})
+	require.NoError(t, err)
+	require.Len(t, storedOutputs, 1)
+	require.True(t, storedOutputs[0].Missing)
+
+	// The data of spent outputs is removed together with the outputs
+	ts.DeleteOutputOnCommitment(committedOutputID)
+
+	storedOutputs, err = ts.Indexer.StoredOutputs(iotago.OutputIDs{committedOutputID})
+	require.NoError(t, err)
+	require.Len(t, storedOutputs, 1)
+	require.True(t, storedOutputs[0].Missing)
+}
+
+func TestIndexer_StoredOutputs_Import(t *testing.T) {
+	ts := newTestSuite(t)
+
+	output := accountOutputWithAddress(iotago_tpkg.RandEd25519Address())
+	outputID := iotago_tpkg.RandOutputID(0)
+
+	tx := ts.Indexer.ImportTransaction(context.Background())
+	require.NoError(t, tx.AddOutput(outputID, output, 1))
+	require.NoError(t, tx.Finalize(1, t.Name(), 1))
+
+	storedOutputs, err := ts.Indexer.StoredOutputs(iotago.OutputIDs{outputID})
+	require.NoError(t, err)
+	require.Len(t, storedOutputs, 1)
+	require.True(t, output.Equal(storedOutputs[0].Output))
+	require.True(t, storedOutputs[0].Committed)
+}
+
+func TestIndexer_StoredOutputs_InvalidOutput(t *testing.T) {
+	ts := newTestSuite(t)
+
+	// Anchor outputs don't support the sender feature, so the output can't be serialized
+	output := anchorOutputWithAddress(iotago_tpkg.RandEd25519Address()).(*iotago.AnchorOutput)
+	output.Features = iotago.AnchorOutputFeatures{
+		&iotago.SenderFeature{
+			Address: iotago_tpkg.RandEd25519Address(),
+		},
+	}
+	outputID := iotago_tpkg.RandOutputID(0)
+
+	// The output is still indexed, only its stored data is skipped
+	ts.AddOutputOnCommitment(output, outputID)
+	ts.requireFound(outputID)
+
+	storedOutputs, err := ts.Indexer.StoredOutputs(iotago.OutputIDs{outputID})
+	require.NoError(t, err)
+	require.Len(t, storedOutputs, 1)
+	require.True(t, storedOutputs[0].Missing)
+}
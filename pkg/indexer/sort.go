@@ -0,0 +1,129 @@
+package indexer
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/iotaledger/hive.go/ierrors"
+)
+
+var (
+	ErrInvalidSortOrder = ierrors.New("invalid sort order")
+	ErrInvalidCursor    = ierrors.New("invalid cursor")
+)
+
+// SortOrder defines the order in which the outputIDs of a query are returned.
+type SortOrder uint8
+
+const (
+	// SortCreatedAscending returns the oldest outputs first. This is the default.
+	SortCreatedAscending SortOrder = iota
+	// SortCreatedDescending returns the newest outputs first.
+	SortCreatedDescending
+	// SortAmountAscending returns the outputs holding the least base tokens first.
+	SortAmountAscending
+	// SortAmountDescending returns the outputs holding the most base tokens first.
+	SortAmountDescending
+)
+
+var sortOrderNames = map[SortOrder]string{
+	SortCreatedAscending:  "createdAsc",
+	SortCreatedDescending: "createdDesc",
+	SortAmountAscending:   "amountAsc",
+	SortAmountDescending:  "amountDesc",
+}
+
+func (o SortOrder) String() string {
+	if name, exists := sortOrderNames[o]; exists {
+		return name
+	}
+
+	return fmt.Sprintf("unknown(%d)", o)
+}
+
+// ParseSortOrder returns the SortOrder with the given name.
+func ParseSortOrder(name string) (SortOrder, error) {
+	for order, orderName := range sortOrderNames {
+		if orderName == name {
+			return order, nil
+		}
+	}
+
+	return 0, ierrors.Wrapf(ErrInvalidSortOrder, "unknown sort order: %s", name)
+}
+
+// sortColumn returns the column the outputs are primarily sorted by, ties are broken by the output_id.
+func (o SortOrder) sortColumn() string {
+	switch o {
+	case SortAmountAscending, SortAmountDescending:
+		return "amount"
+	default:
+		return "created_at_slot"
+	}
+}
+
+func (o SortOrder) descending() bool {
+	return o == SortCreatedDescending || o == SortAmountDescending
+}
+
+func (o SortOrder) orderClause() string {
+	direction := "asc"
+	if o.descending() {
+		direction = "desc"
+	}
+
+	return fmt.Sprintf("%s %s, output_id %s", o.sortColumn(), direction, direction)
+}
+
+// cursorPrefix returns the prefix that marks the cursors of the sort order.
+// Cursors of the default sort order have no prefix to stay compatible with existing clients.
+func (o SortOrder) cursorPrefix() string {
+	if o == SortCreatedAscending {
+		return ""
+	}
+
+	return o.String() + ":"
+}
+
+// sortValue returns the value of the sort column of the given result.
+func (o SortOrder) sortValue(result queryResult) uint64 {
+	switch o {
+	case SortAmountAscending, SortAmountDescending:
+		return uint64(result.Amount)
+	default:
+		return uint64(result.CreatedAtSlot)
+	}
+}
+
+// cursorFromResult returns the cursor pointing to the given result.
+// The cursor contains the sort value padded to 16 hex characters followed by the hex encoded outputID.
+func (o SortOrder) cursorFromResult(result queryResult) string {
+	return fmt.Sprintf("%s%016x%s", o.cursorPrefix(), o.sortValue(result), hex.EncodeToString(result.OutputID))
+}
+
+// parseCursor checks that the cursor was created for the sort order and returns the contained sort value and outputID.
+func (o SortOrder) parseCursor(cursor string) (uint64, []byte, error) {
+	prefix := o.cursorPrefix()
+	if !strings.HasPrefix(cursor, prefix) || (prefix == "" && strings.Contains(cursor, ":")) {
+		return 0, nil, ierrors.Wrapf(ErrInvalidCursor, "cursor does not match the sort order %s", o)
+	}
+
+	key := strings.TrimPrefix(cursor, prefix)
+	if len(key) != CursorLength {
+		return 0, nil, ierrors.Wrapf(ErrInvalidCursor, "invalid cursor length: %d", len(key))
+	}
+
+	sortValue, err := strconv.ParseUint(key[:16], 16, 64)
+	if err != nil {
+		return 0, nil, ierrors.Wrapf(ErrInvalidCursor, "invalid sort value: %s", err)
+	}
+
+	outputID, err := hex.DecodeString(key[16:])
+	if err != nil {
+		return 0, nil, ierrors.Wrapf(ErrInvalidCursor, "invalid outputID: %s", err)
+	}
+
+	return sortValue, outputID, nil
+}
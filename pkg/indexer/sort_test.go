@@ -0,0 +1,121 @@
+package indexer_test
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/hive.go/runtime/options"
+	"github.com/iotaledger/inx-indexer/pkg/indexer"
+	iotago "github.com/iotaledger/iota.go/v4"
+	iotago_tpkg "github.com/iotaledger/iota.go/v4/tpkg"
+)
+
+func TestIndexer_SortOrder(t *testing.T) {
+	ts := newTestSuite(t)
+
+	address := iotago_tpkg.RandEd25519Address()
+
+	addBasicOutput := func(amount iotago.BaseToken) iotago.OutputID {
+		output := basicOutputWithAddress(address).(*iotago.BasicOutput)
+		output.Amount = amount
+		outputID := iotago_tpkg.RandOutputID(0)
+		ts.AddOutputOnCommitment(output, outputID)
+
+		return outputID
+	}
+
+	// Every output is created in its own slot
+	first := addBasicOutput(300)
+	second := addBasicOutput(100)
+	third := addBasicOutput(200)
+
+	basicOutputIDs := func(filters ...options.Option[indexer.BasicFilterOptions]) iotago.OutputIDs {
+		result := ts.Indexer.Basic(append(filters, indexer.BasicUnlockAddress(address))...)
+		require.NoError(t, result.Error)
+
+		return result.OutputIDs
+	}
+
+	require.Equal(t, iotago.OutputIDs{first, second, third}, basicOutputIDs())
+	require.Equal(t, iotago.OutputIDs{first, second, third}, basicOutputIDs(indexer.BasicSortOrder(indexer.SortCreatedAscending)))
+	require.Equal(t, iotago.OutputIDs{third, second, first}, basicOutputIDs(indexer.BasicSortOrder(indexer.SortCreatedDescending)))
+	require.Equal(t, iotago.OutputIDs{second, third, first}, basicOutputIDs(indexer.BasicSortOrder(indexer.SortAmountAscending)))
+	require.Equal(t, iotago.OutputIDs{first, third, second}, basicOutputIDs(indexer.BasicSortOrder(indexer.SortAmountDescending)))
+
+	combined := ts.Indexer.Combined(indexer.CombinedUnlockableByAddress(address), indexer.CombinedSortOrder(indexer.SortCreatedDescending))
+	require.NoError(t, combined.Error)
+	require.Equal(t, iotago.OutputIDs{third, second, first}, combined.OutputIDs)
+
+	// The combined lookup of an address is paginated on the sort columns of the address references
+	for _, sortOrder := range []indexer.SortOrder{indexer.SortCreatedAscending, indexer.SortAmountAscending, indexer.SortAmountDescending} {
+		var paged iotago.OutputIDs
+		filters := []options.Option[indexer.CombinedFilterOptions]{indexer.CombinedUnlockableByAddress(address), indexer.CombinedSortOrder(sortOrder), indexer.CombinedPageSize(2)}
+		result := ts.Indexer.Combined(filters...)
+		for {
+			require.NoError(t, result.Error)
+			paged = append(paged, result.OutputIDs...)
+			if result.Cursor == nil {
+				break
+			}
+			result = ts.Indexer.Combined(append(filters, indexer.CombinedCursor(*result.Cursor))...)
+		}
+
+		require.Equal(t, basicOutputIDs(indexer.BasicSortOrder(sortOrder)), paged, "sort order %s", sortOrder)
+	}
+
+	// Pagination keeps the sort order
+	for _, sortOrder := range []indexer.SortOrder{indexer.SortCreatedDescending, indexer.SortAmountAscending, indexer.SortAmountDescending} {
+		expected := basicOutputIDs(indexer.BasicSortOrder(sortOrder))
+
+		var paged iotago.OutputIDs
+		filters := []options.Option[indexer.BasicFilterOptions]{indexer.BasicUnlockAddress(address), indexer.BasicSortOrder(sortOrder), indexer.BasicPageSize(1)}
+		result := ts.Indexer.Basic(filters...)
+		for {
+			require.NoError(t, result.Error)
+			paged = append(paged, result.OutputIDs...)
+			if result.Cursor == nil {
+				break
+			}
+			result = ts.Indexer.Basic(append(filters, indexer.BasicCursor(*result.Cursor))...)
+		}
+
+		require.Equal(t, expected, paged, "sort order %s", sortOrder)
+	}
+
+	// The cursor of the default sort order consists of the creation slot and the outputID of the next result
+	page := ts.Indexer.Basic(indexer.BasicUnlockAddress(address), indexer.BasicPageSize(1))
+	require.NoError(t, page.Error)
+	require.NotNil(t, page.Cursor)
+	require.Equal(t, fmt.Sprintf("%016x%s", 2, hex.EncodeToString(second[:])), *page.Cursor)
+
+	// Upper case cursors are accepted as well
+	nextPage := ts.Indexer.Basic(indexer.BasicUnlockAddress(address), indexer.BasicPageSize(1), indexer.BasicCursor(strings.ToUpper(*page.Cursor)))
+	require.NoError(t, nextPage.Error)
+	require.Equal(t, iotago.OutputIDs{second}, nextPage.OutputIDs)
+
+	// Cursors can only be used with the sort order they were created for
+	descendingPage := ts.Indexer.Basic(indexer.BasicUnlockAddress(address), indexer.BasicSortOrder(indexer.SortCreatedDescending), indexer.BasicPageSize(1))
+	require.NoError(t, descendingPage.Error)
+	require.NotNil(t, descendingPage.Cursor)
+
+	result := ts.Indexer.Basic(indexer.BasicUnlockAddress(address), indexer.BasicPageSize(1), indexer.BasicCursor(*descendingPage.Cursor))
+	require.ErrorIs(t, result.Error, indexer.ErrInvalidCursor)
+
+	result = ts.Indexer.Basic(indexer.BasicUnlockAddress(address), indexer.BasicSortOrder(indexer.SortAmountDescending), indexer.BasicPageSize(1), indexer.BasicCursor(*descendingPage.Cursor))
+	require.ErrorIs(t, result.Error, indexer.ErrInvalidCursor)
+}
+
+func TestIndexer_ParseSortOrder(t *testing.T) {
+	for _, sortOrder := range []indexer.SortOrder{indexer.SortCreatedAscending, indexer.SortCreatedDescending, indexer.SortAmountAscending, indexer.SortAmountDescending} {
+		parsed, err := indexer.ParseSortOrder(sortOrder.String())
+		require.NoError(t, err)
+		require.Equal(t, sortOrder, parsed)
+	}
+
+	_, err := indexer.ParseSortOrder("newest")
+	require.ErrorIs(t, err, indexer.ErrInvalidSortOrder)
+}
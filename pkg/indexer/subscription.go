@@ -0,0 +1,291 @@
+package indexer
+
+import (
+	"bytes"
+	"cmp"
+
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+// outputSubscriptionBufferSize is the amount of events that are buffered for a subscription before it is cancelled for not keeping up.
+const outputSubscriptionBufferSize = 1000
+
+// OutputEvent is sent to the subscriptions whose filters match an output that was created or spent by a ledger update.
+type OutputEvent struct {
+	OutputID iotago.OutputID
+	// Slot is the slot of the ledger update that created or spent the output.
+	Slot  iotago.SlotIndex
+	Spent bool
+	// Committed is true if the event was caused by a committed ledger update and false if it was caused by an accepted one.
+	Committed bool
+}
+
+// OutputSubscription receives the events of the outputs matching its filters.
+type OutputSubscription struct {
+	// matches reports whether the rows of an output match the filters of the subscription.
+	matches func(rows *outputRows) bool
+	events  chan *OutputEvent
+}
+
+// Events returns the channel the events are sent on.
+// The channel is closed once the subscription is cancelled, either by Unsubscribe or because the receiver did not keep up with the events.
+func (s *OutputSubscription) Events() <-chan *OutputEvent {
+	return s.events
+}
+
+// outputEvents collects the events of a ledger update per subscription until the update is written to the database.
+type outputEvents map[*OutputSubscription][]*OutputEvent
+
+func (i *Indexer) subscribe(matches func(rows *outputRows) bool) *OutputSubscription {
+	subscription := &OutputSubscription{
+		matches: matches,
+		events:  make(chan *OutputEvent, outputSubscriptionBufferSize),
+	}
+
+	i.subscriptionsMutex.Lock()
+	defer i.subscriptionsMutex.Unlock()
+
+	i.subscriptions[subscription] = struct{}{}
+
+	return subscription
+}
+
+// Unsubscribe cancels the subscription and closes its events channel.
+func (i *Indexer) Unsubscribe(subscription *OutputSubscription) {
+	i.subscriptionsMutex.Lock()
+	defer i.subscriptionsMutex.Unlock()
+
+	i.cancelSubscription(subscription)
+}
+
+// UnsubscribeAll cancels all subscriptions, so that their receivers stop waiting for events.
+func (i *Indexer) UnsubscribeAll() {
+	i.subscriptionsMutex.Lock()
+	defer i.subscriptionsMutex.Unlock()
+
+	for subscription := range i.subscriptions {
+		i.cancelSubscription(subscription)
+	}
+}
+
+// SubscriptionCount returns the amount of active subscriptions.
+func (i *Indexer) SubscriptionCount() int {
+	i.subscriptionsMutex.Lock()
+	defer i.subscriptionsMutex.Unlock()
+
+	return len(i.subscriptions)
+}
+
+// cancelSubscription removes the subscription and closes its events channel. The subscriptionsMutex must be held by the caller.
+func (i *Indexer) cancelSubscription(subscription *OutputSubscription) {
+	if _, exists := i.subscriptions[subscription]; !exists {
+		return
+	}
+
+	delete(i.subscriptions, subscription)
+	close(subscription.events)
+}
+
+func (i *Indexer) activeSubscriptions() []*OutputSubscription {
+	i.subscriptionsMutex.Lock()
+	defer i.subscriptionsMutex.Unlock()
+
+	subscriptions := make([]*OutputSubscription, 0, len(i.subscriptions))
+	for subscription := range i.subscriptions {
+		subscriptions = append(subscriptions, subscription)
+	}
+
+	return subscriptions
+}
+
+// outputRows are the rows of an output as they are written to the database.
+// The filters of the subscriptions are matched against them in memory, in the same way the filter queries match them in the database.
+type outputRows struct {
+	entry           interface{}
+	addressRefs     []*addressRef
+	blockIssuerKeys []*blockIssuerKey
+}
+
+func outputRowsForOutput(output *LedgerOutput) (*outputRows, error) {
+	entry, err := entryForOutput(output.OutputID, output.Output, output.BookedAt, false)
+	if err != nil {
+		return nil, err
+	}
+
+	blockIssuerKeys, err := blockIssuerKeysForOutput(output.OutputID, output.Output)
+	if err != nil {
+		return nil, err
+	}
+
+	return &outputRows{
+		entry:           entry,
+		addressRefs:     addressRefsForOutput(output.OutputID, output.Output, output.BookedAt),
+		blockIssuerKeys: blockIssuerKeys,
+	}, nil
+}
+
+// referencedBy mirrors the addressRefQuery subquery, an unset address matches all outputs.
+func (r *outputRows) referencedBy(address iotago.Address, roles ...addressRole) bool {
+	if address == nil {
+		return true
+	}
+
+	return r.referencedByAny([]iotago.Address{address}, roles...)
+}
+
+// referencedByAny mirrors the addressRefsQuery subquery.
+func (r *outputRows) referencedByAny(addresses []iotago.Address, roles ...addressRole) bool {
+	for _, ref := range r.addressRefs {
+		for _, role := range roles {
+			if ref.Role != role {
+				continue
+			}
+
+			for _, address := range addresses {
+				if bytes.Equal(ref.AddressID, address.ID()) {
+					return true
+				}
+			}
+		}
+	}
+
+	return false
+}
+
+// hasBlockIssuerKey mirrors the blockIssuerKey subquery of the account filters.
+func (r *outputRows) hasBlockIssuerKey(key []byte) bool {
+	for _, blockIssuerKey := range r.blockIssuerKeys {
+		if bytes.Equal(blockIssuerKey.BlockIssuerKey, key) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// The following helpers mirror the conditions of the filter queries, an unset filter matches all outputs.
+// Like in SQL, a NULL column doesn't match any comparison.
+
+func matchesPresence(filter *bool, present bool) bool {
+	return filter == nil || *filter == present
+}
+
+func matchesAddress(column []byte, address iotago.Address) bool {
+	return address == nil || bytes.Equal(column, address.ID())
+}
+
+func matchesBytes(column []byte, value []byte) bool {
+	return len(value) == 0 || bytes.Equal(column, value)
+}
+
+func matchesEqual[T comparable](column *T, value *T) bool {
+	return value == nil || (column != nil && *column == *value)
+}
+
+func matchesLess[T cmp.Ordered](column *T, bound *T) bool {
+	return bound == nil || (column != nil && *column < *bound)
+}
+
+func matchesGreater[T cmp.Ordered](column *T, bound *T) bool {
+	return bound == nil || (column != nil && *column > *bound)
+}
+
+func matchesAtLeast[T cmp.Ordered](column T, bound *T) bool {
+	return bound == nil || column >= *bound
+}
+
+func matchesAtMost[T cmp.Ordered](column T, bound *T) bool {
+	return bound == nil || column <= *bound
+}
+
+// matchesCommonFilters mirrors the amount and creation slot filters that are supported by all output types.
+func matchesCommonFilters(amount iotago.BaseToken, createdAtSlot iotago.SlotIndex, minAmount *iotago.BaseToken, maxAmount *iotago.BaseToken, createdBefore *iotago.SlotIndex, createdAfter *iotago.SlotIndex) bool {
+	return matchesAtLeast(amount, minAmount) &&
+		matchesAtMost(amount, maxAmount) &&
+		matchesLess(&createdAtSlot, createdBefore) &&
+		matchesGreater(&createdAtSlot, createdAfter)
+}
+
+// matchLedgerUpdate returns the events of the outputs created and spent by the ledger update that match the filters of the subscriptions.
+// The outputs are matched in memory, so that the subscriptions don't add any queries to the ledger update transaction.
+func (i *Indexer) matchLedgerUpdate(update *LedgerUpdate, committed bool) (outputEvents, error) {
+	events := make(outputEvents)
+
+	subscriptions := i.activeSubscriptions()
+	if len(subscriptions) == 0 {
+		return events, nil
+	}
+
+	createdOutputs := make(map[iotago.OutputID]struct{}, len(update.Created))
+	for _, output := range update.Created {
+		createdOutputs[output.OutputID] = struct{}{}
+	}
+
+	spentOutputs := make(map[iotago.OutputID]struct{}, len(update.Consumed))
+	for _, output := range update.Consumed {
+		spentOutputs[output.OutputID] = struct{}{}
+	}
+
+	matchOutputs := func(outputs []*LedgerOutput, spent bool, skipped map[iotago.OutputID]struct{}) error {
+		for _, output := range outputs {
+			if _, isSkipped := skipped[output.OutputID]; isSkipped {
+				// Outputs that were created and spent in the same update are never written to the database
+				continue
+			}
+
+			rows, err := outputRowsForOutput(output)
+			if err != nil {
+				return err
+			}
+
+			// Keep the order of the ledger update
+			for _, subscription := range subscriptions {
+				if !subscription.matches(rows) {
+					continue
+				}
+
+				events[subscription] = append(events[subscription], &OutputEvent{
+					OutputID:  output.OutputID,
+					Slot:      update.Slot,
+					Spent:     spent,
+					Committed: committed,
+				})
+			}
+		}
+
+		return nil
+	}
+
+	if err := matchOutputs(update.Consumed, true, createdOutputs); err != nil {
+		return nil, err
+	}
+
+	if err := matchOutputs(update.Created, false, spentOutputs); err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
+// publishOutputEvents sends the events to their subscriptions once the ledger update was written to the database.
+func (i *Indexer) publishOutputEvents(events outputEvents) {
+	i.subscriptionsMutex.Lock()
+	defer i.subscriptionsMutex.Unlock()
+
+	for subscription, subscriptionEvents := range events {
+		for _, event := range subscriptionEvents {
+			if _, exists := i.subscriptions[subscription]; !exists {
+				// The subscription was cancelled in the meantime
+				break
+			}
+
+			select {
+			case subscription.events <- event:
+			default:
+				// We do not block the ledger updates for receivers that do not keep up with the events
+				i.LogWarnf("cancelled subscription after %d unreceived events", outputSubscriptionBufferSize)
+				i.cancelSubscription(subscription)
+			}
+		}
+	}
+}
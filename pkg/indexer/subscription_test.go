@@ -0,0 +1,155 @@
+package indexer_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/inx-indexer/pkg/indexer"
+	iotago "github.com/iotaledger/iota.go/v4"
+	iotago_tpkg "github.com/iotaledger/iota.go/v4/tpkg"
+)
+
+func requireOutputEvent(t *testing.T, subscription *indexer.OutputSubscription, expected *indexer.OutputEvent) {
+	select {
+	case event, ok := <-subscription.Events():
+		require.True(t, ok, "subscription was cancelled")
+		require.Equal(t, expected, event)
+	default:
+		require.Fail(t, "no event received", "expected event for output %s", expected.OutputID.ToHex())
+	}
+}
+
+func requireNoOutputEvent(t *testing.T, subscription *indexer.OutputSubscription) {
+	select {
+	case event := <-subscription.Events():
+		require.Fail(t, "unexpected event", "received event %v", event)
+	default:
+	}
+}
+
+func TestIndexer_Subscription(t *testing.T) {
+	ts := newTestSuite(t)
+
+	address := iotago_tpkg.RandEd25519Address()
+	basicSubscription := ts.Indexer.BasicSubscription(indexer.BasicUnlockAddress(address))
+	combinedSubscription := ts.Indexer.CombinedSubscription(indexer.CombinedUnlockableByAddress(address))
+	require.Equal(t, 2, ts.Indexer.SubscriptionCount())
+
+	ts.CommitEmptyLedgerUpdate() // Slot 1
+
+	// Outputs of other addresses are not sent
+	ts.AddOutputOnCommitment(basicOutputWithAddress(iotago_tpkg.RandEd25519Address()), iotago_tpkg.RandOutputID(0)) // Slot 2
+	requireNoOutputEvent(t, basicSubscription)
+	requireNoOutputEvent(t, combinedSubscription)
+
+	// The output is sent once it is accepted and again once it is committed
+	outputID := iotago_tpkg.RandOutputID(0)
+	ts.AddOutputOnAcceptance(basicOutputWithAddress(address), outputID, 3)
+	acceptedEvent := &indexer.OutputEvent{OutputID: outputID, Slot: 3}
+	requireOutputEvent(t, basicSubscription, acceptedEvent)
+	requireOutputEvent(t, combinedSubscription, acceptedEvent)
+
+	ts.AddOutputOnCommitment(basicOutputWithAddress(address), outputID) // Slot 3
+	committedEvent := &indexer.OutputEvent{OutputID: outputID, Slot: 3, Committed: true}
+	requireOutputEvent(t, basicSubscription, committedEvent)
+	requireOutputEvent(t, combinedSubscription, committedEvent)
+
+	// Only the combined subscription matches the NFT output
+	nftOutputID := iotago_tpkg.RandOutputID(0)
+	ts.AddOutputOnCommitment(nftOutputWithAddressAndSender(address), nftOutputID) // Slot 4
+	requireNoOutputEvent(t, basicSubscription)
+	requireOutputEvent(t, combinedSubscription, &indexer.OutputEvent{OutputID: nftOutputID, Slot: 4, Committed: true})
+
+	// Spent outputs are matched before they are deleted
+	ts.DeleteOutputOnAcceptance(outputID, 5)
+	spentEvent := &indexer.OutputEvent{OutputID: outputID, Slot: 5, Spent: true}
+	requireOutputEvent(t, basicSubscription, spentEvent)
+	requireOutputEvent(t, combinedSubscription, spentEvent)
+
+	ts.DeleteOutputOnCommitment(outputID) // Slot 5
+	spentEvent = &indexer.OutputEvent{OutputID: outputID, Slot: 5, Spent: true, Committed: true}
+	requireOutputEvent(t, basicSubscription, spentEvent)
+	requireOutputEvent(t, combinedSubscription, spentEvent)
+
+	// Cancelled subscriptions do not receive any further events
+	ts.Indexer.Unsubscribe(basicSubscription)
+	_, ok := <-basicSubscription.Events()
+	require.False(t, ok)
+	require.Equal(t, 1, ts.Indexer.SubscriptionCount())
+
+	lastOutputID := iotago_tpkg.RandOutputID(0)
+	ts.AddOutputOnCommitment(basicOutputWithAddress(address), lastOutputID) // Slot 6
+	requireOutputEvent(t, combinedSubscription, &indexer.OutputEvent{OutputID: lastOutputID, Slot: 6, Committed: true})
+
+	ts.Indexer.UnsubscribeAll()
+	require.Zero(t, ts.Indexer.SubscriptionCount())
+}
+
+func TestIndexer_Subscription_History(t *testing.T) {
+	ts := newTestSuite(t, indexer.WithHistoryRetention(0))
+
+	address := iotago_tpkg.RandEd25519Address()
+	outputID := iotago_tpkg.RandOutputID(0)
+	ts.AddOutputOnCommitment(basicOutputWithAddress(address), outputID) // Slot 1
+
+	subscription := ts.Indexer.BasicSubscription(indexer.BasicUnlockAddress(address))
+
+	ts.DeleteOutputOnCommitment(outputID) // Slot 2
+	requireOutputEvent(t, subscription, &indexer.OutputEvent{OutputID: outputID, Slot: 2, Spent: true, Committed: true})
+
+	// Outputs spent in the same slot they are created in are not sent
+	spentOutputID := iotago_tpkg.RandOutputID(0)
+	output := basicOutputWithAddress(address)
+	require.NoError(t, ts.Indexer.CommitLedgerUpdate(&indexer.LedgerUpdate{
+		Slot:     3,
+		Created:  []*indexer.LedgerOutput{{OutputID: spentOutputID, Output: output, BookedAt: 3}},
+		Consumed: []*indexer.LedgerOutput{{OutputID: spentOutputID, Output: output, SpentAt: 3}},
+	}))
+	requireNoOutputEvent(t, subscription)
+}
+
+func TestIndexer_Subscription_MatchesQueries(t *testing.T) {
+	ts := newTestSuite(t)
+
+	address := iotago_tpkg.RandEd25519Address()
+	otherAddress := iotago_tpkg.RandEd25519Address()
+
+	// The subscriptions match the outputs in memory, so they need to receive exactly the outputs the queries return
+	subscriptions := map[*indexer.OutputSubscription]func() *indexer.IndexerResult{
+		ts.Indexer.BasicSubscription(indexer.BasicUnlockableByAddress(address)): func() *indexer.IndexerResult {
+			return ts.Indexer.Basic(indexer.BasicUnlockableByAddress(address))
+		},
+		ts.Indexer.BasicSubscription(indexer.BasicHasExpirationCondition(true), indexer.BasicExpiresAfter(5)): func() *indexer.IndexerResult {
+			return ts.Indexer.Basic(indexer.BasicHasExpirationCondition(true), indexer.BasicExpiresAfter(5))
+		},
+		ts.Indexer.NFTSubscription(indexer.NFTSender(address)): func() *indexer.IndexerResult {
+			return ts.Indexer.NFT(indexer.NFTSender(address))
+		},
+		ts.Indexer.AccountSubscription(indexer.AccountUnlockAddress(otherAddress)): func() *indexer.IndexerResult {
+			return ts.Indexer.Account(indexer.AccountUnlockAddress(otherAddress))
+		},
+		ts.Indexer.CombinedSubscription(indexer.CombinedUnlockableByAnyAddress(address, otherAddress), indexer.CombinedMinAmount(100000)): func() *indexer.IndexerResult {
+			return ts.Indexer.Combined(indexer.CombinedUnlockableByAnyAddress(address, otherAddress), indexer.CombinedMinAmount(100000))
+		},
+	}
+
+	ts.AddOutputOnCommitment(basicOutputWithAddress(address), iotago_tpkg.RandOutputID(0))                          // Slot 1
+	ts.AddOutputOnCommitment(basicOutputWithExpiration(otherAddress, address), iotago_tpkg.RandOutputID(0))         // Slot 2
+	ts.AddOutputOnCommitment(nftOutputWithAddressAndSender(address), iotago_tpkg.RandOutputID(0))                   // Slot 3
+	ts.AddOutputOnCommitment(nftOutputWithAddressAndSender(otherAddress), iotago_tpkg.RandOutputID(0))              // Slot 4
+	ts.AddOutputOnCommitment(accountOutputWithAddress(otherAddress), iotago_tpkg.RandOutputID(0))                   // Slot 5
+	ts.AddOutputOnCommitment(basicOutputWithAddress(iotago_tpkg.RandEd25519Address()), iotago_tpkg.RandOutputID(0)) // Slot 6
+
+	for subscription, query := range subscriptions {
+		result := query()
+		require.NoError(t, result.Error)
+
+		var received iotago.OutputIDs
+		for len(subscription.Events()) > 0 {
+			received = append(received, (<-subscription.Events()).OutputID)
+		}
+		require.NotEmpty(t, received)
+		require.ElementsMatch(t, result.OutputIDs, received)
+	}
+}
@@ -18,7 +18,7 @@ import (
 )
 
 type indexerTestsuite struct {
-	T       *testing.T
+	T       testing.TB
 	Indexer *indexer.Indexer
 
 	committedOutputs *shrinkingmap.ShrinkingMap[iotago.OutputID, iotago.Output]
@@ -29,7 +29,7 @@ type indexerOutputSet struct {
 	Outputs iotago.OutputIDs
 }
 
-func newTestSuite(t *testing.T) *indexerTestsuite {
+func newTestSuite(t testing.TB, opts ...options.Option[indexer.Indexer]) *indexerTestsuite {
 	dbParams := sql.DatabaseParameters{
 		Engine:   db.EngineSQLite,
 		Path:     t.TempDir(),
@@ -38,7 +38,7 @@ func newTestSuite(t *testing.T) *indexerTestsuite {
 
 	rootLogger := log.NewLogger()
 
-	idx, err := indexer.NewIndexer(dbParams, rootLogger.NewChildLogger(t.Name()))
+	idx, err := indexer.NewIndexer(dbParams, iotago.SingleVersionProvider(iotago_tpkg.ZeroCostTestAPI), rootLogger.NewChildLogger(t.Name()), opts...)
 	require.NoError(t, err)
 
 	require.NoError(t, idx.CreateTables())
@@ -62,6 +62,13 @@ func (ts *indexerTestsuite) CurrentSlot() iotago.SlotIndex {
 	return status.CommittedSlot
 }
 
+func (ts *indexerTestsuite) HistoryStartSlot() iotago.SlotIndex {
+	status, err := ts.Indexer.Status()
+	require.NoError(ts.T, err)
+
+	return status.HistoryStartSlot
+}
+
 func (ts *indexerTestsuite) CommitEmptyLedgerUpdate() {
 	committedSlot := ts.CurrentSlot() + 1
 
@@ -0,0 +1,220 @@
+package indexer
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/hive.go/runtime/options"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+var (
+	ErrNativeTokenNotFound = ierrors.New("native token not found")
+)
+
+// nativeToken contains the IRC30 metadata of a native token.
+// The metadata is immutable, so there is only one entry per FoundryID, no matter how many outputs the foundry had.
+type nativeToken struct {
+	FoundryID   []byte `gorm:"primaryKey;notnull"`
+	Name        string `gorm:"notnull;index:native_tokens_name"`
+	Symbol      string `gorm:"notnull;index:native_tokens_symbol"`
+	Decimals    uint32 `gorm:"notnull"`
+	Description *string
+	URL         *string
+	LogoURL     *string
+}
+
+func (t *nativeToken) String() string {
+	return fmt.Sprintf("native token => FoundryID: %s, Symbol: %s", hex.EncodeToString(t.FoundryID), t.Symbol)
+}
+
+// nativeTokenEntryForOutput returns the registry entry for the native token controlled by the given output,
+// or nil if the output is not a foundry or does not carry IRC30 metadata.
+func nativeTokenEntryForOutput(output iotago.Output) (*nativeToken, error) {
+	foundryOutput, isFoundry := output.(*iotago.FoundryOutput)
+	if !isFoundry {
+		return nil, nil
+	}
+
+	metadata := parseIRC30Metadata(foundryOutput.ImmutableFeatureSet().Metadata())
+	if metadata == nil {
+		return nil, nil
+	}
+
+	foundryID, err := foundryOutput.FoundryID()
+	if err != nil {
+		return nil, err
+	}
+
+	return &nativeToken{
+		FoundryID:   foundryID[:],
+		Name:        metadata.Name,
+		Symbol:      metadata.Symbol,
+		Decimals:    metadata.Decimals,
+		Description: metadata.Description,
+		URL:         metadata.URL,
+		LogoURL:     metadata.LogoURL,
+	}, nil
+}
+
+func insertNativeTokenFromOutput(tx *gorm.DB, output iotago.Output) error {
+	entry, err := nativeTokenEntryForOutput(output)
+	if err != nil || entry == nil {
+		return err
+	}
+
+	// The metadata of a foundry is immutable, so we keep the existing entry if the foundry was already known
+	return tx.Clauses(clause.OnConflict{DoNothing: true}).Create(entry).Error
+}
+
+// removeOrphanedNativeTokens removes the registry entries of all native tokens whose foundry is no longer in the database.
+func removeOrphanedNativeTokens(tx *gorm.DB) error {
+	return tx.Where("foundry_id NOT IN (?)", tx.Model(&foundry{}).Select("foundry_id")).Delete(&nativeToken{}).Error
+}
+
+// NativeTokenMetadata contains the IRC30 metadata of a native token.
+type NativeTokenMetadata struct {
+	FoundryID   iotago.FoundryID
+	Name        string
+	Symbol      string
+	Decimals    uint32
+	Description *string
+	URL         *string
+	LogoURL     *string
+}
+
+func (t *nativeToken) metadata() *NativeTokenMetadata {
+	return &NativeTokenMetadata{
+		FoundryID:   iotago.FoundryID(t.FoundryID),
+		Name:        t.Name,
+		Symbol:      t.Symbol,
+		Decimals:    t.Decimals,
+		Description: t.Description,
+		URL:         t.URL,
+		LogoURL:     t.LogoURL,
+	}
+}
+
+// NativeTokensResult contains a page of native tokens found in the registry.
+type NativeTokensResult struct {
+	NativeTokens  []*NativeTokenMetadata
+	CommittedSlot iotago.SlotIndex
+	PageSize      uint32
+	Cursor        *string
+	Error         error
+}
+
+type NativeTokenFilterOptions struct {
+	symbolPrefix *string
+	namePrefix   *string
+	pageSize     uint32
+	cursor       *string
+}
+
+// NativeTokenSymbolPrefix filters for native tokens with a symbol starting with the given prefix (case-insensitive).
+func NativeTokenSymbolPrefix(prefix string) options.Option[NativeTokenFilterOptions] {
+	return func(args *NativeTokenFilterOptions) {
+		args.symbolPrefix = &prefix
+	}
+}
+
+// NativeTokenNamePrefix filters for native tokens with a name starting with the given prefix (case-insensitive).
+func NativeTokenNamePrefix(prefix string) options.Option[NativeTokenFilterOptions] {
+	return func(args *NativeTokenFilterOptions) {
+		args.namePrefix = &prefix
+	}
+}
+
+func NativeTokenPageSize(pageSize uint32) options.Option[NativeTokenFilterOptions] {
+	return func(args *NativeTokenFilterOptions) {
+		args.pageSize = pageSize
+	}
+}
+
+func NativeTokenCursor(cursor string) options.Option[NativeTokenFilterOptions] {
+	return func(args *NativeTokenFilterOptions) {
+		args.cursor = &cursor
+	}
+}
+
+// nativeTokenQuery returns the registry entries of all native tokens whose foundry is unspent.
+func (i *Indexer) nativeTokenQuery() *gorm.DB {
+	return i.db.Model(&nativeToken{}).Where("foundry_id IN (?)", unspentAtSlotQuery(i.db.Model(&foundry{}), nil).Select("foundry_id"))
+}
+
+// NativeTokenByID returns the IRC30 metadata of the native token controlled by the given foundry.
+func (i *Indexer) NativeTokenByID(foundryID iotago.FoundryID) (*NativeTokenMetadata, error) {
+	var results []*nativeToken
+	if err := i.nativeTokenQuery().Where("foundry_id = ?", foundryID[:]).Limit(1).Find(&results).Error; err != nil {
+		return nil, err
+	}
+
+	if len(results) == 0 {
+		return nil, ErrNativeTokenNotFound
+	}
+
+	return results[0].metadata(), nil
+}
+
+// NativeTokens searches the registry for native tokens with IRC30 metadata, ordered by FoundryID.
+func (i *Indexer) NativeTokens(filters ...options.Option[NativeTokenFilterOptions]) *NativeTokensResult {
+	opts := options.Apply(&NativeTokenFilterOptions{
+		pageSize: DefaultPageSize,
+	}, filters)
+
+	query := i.nativeTokenQuery().Order("foundry_id asc")
+
+	if opts.symbolPrefix != nil {
+		query = i.prefixQuery(query, "symbol", *opts.symbolPrefix)
+	}
+
+	if opts.namePrefix != nil {
+		query = i.prefixQuery(query, "name", *opts.namePrefix)
+	}
+
+	if opts.cursor != nil {
+		cursor, err := hex.DecodeString(*opts.cursor)
+		if err != nil || len(cursor) != iotago.FoundryIDLength {
+			return &NativeTokensResult{Error: ierrors.Errorf("Invalid cursor: %s", *opts.cursor)}
+		}
+		query = query.Where("foundry_id >= ?", cursor)
+	}
+
+	if opts.pageSize > 0 {
+		// We use pageSize + 1 to load the next item to use as the cursor
+		query = query.Limit(int(opts.pageSize + 1))
+	}
+
+	status, err := i.Status()
+	if err != nil {
+		return &NativeTokensResult{Error: err}
+	}
+
+	var results []*nativeToken
+	if err := query.Find(&results).Error; err != nil {
+		return &NativeTokensResult{Error: err}
+	}
+
+	var nextCursor *string
+	if opts.pageSize > 0 && uint32(len(results)) > opts.pageSize {
+		c := hex.EncodeToString(results[len(results)-1].FoundryID)
+		nextCursor = &c
+		results = results[:len(results)-1]
+	}
+
+	nativeTokens := make([]*NativeTokenMetadata, 0, len(results))
+	for _, result := range results {
+		nativeTokens = append(nativeTokens, result.metadata())
+	}
+
+	return &NativeTokensResult{
+		NativeTokens:  nativeTokens,
+		CommittedSlot: status.CommittedSlot,
+		PageSize:      opts.pageSize,
+		Cursor:        nextCursor,
+	}
+}
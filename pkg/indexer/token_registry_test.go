@@ -0,0 +1,142 @@
+package indexer_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/inx-indexer/pkg/indexer"
+	iotago "github.com/iotaledger/iota.go/v4"
+	iotago_tpkg "github.com/iotaledger/iota.go/v4/tpkg"
+)
+
+func foundryOutputWithIRC30Metadata(accountAddress *iotago.AccountAddress, serialNumber uint32, entries iotago.MetadataFeatureEntries) *iotago.FoundryOutput {
+	return &iotago.FoundryOutput{
+		Amount:       100000,
+		SerialNumber: serialNumber,
+		TokenScheme: &iotago.SimpleTokenScheme{
+			MintedTokens:  big.NewInt(100),
+			MeltedTokens:  big.NewInt(0),
+			MaximumSupply: big.NewInt(1000),
+		},
+		UnlockConditions: iotago.FoundryOutputUnlockConditions{
+			&iotago.ImmutableAccountUnlockCondition{
+				Address: accountAddress,
+			},
+		},
+		ImmutableFeatures: iotago.FoundryOutputImmFeatures{
+			&iotago.MetadataFeature{
+				Entries: entries,
+			},
+		},
+	}
+}
+
+func TestIndexer_NativeTokenRegistry(t *testing.T) {
+	ts := newTestSuite(t)
+
+	accountAddress := iotago_tpkg.RandAccountAddress()
+
+	shimmerFoundry := foundryOutputWithIRC30Metadata(accountAddress, 1, iotago.MetadataFeatureEntries{
+		"standard":    []byte("IRC30"),
+		"name":        []byte("Shimmer Token"),
+		"symbol":      []byte("SMRT"),
+		"decimals":    []byte("6"),
+		"description": []byte("A test token"),
+	})
+	shimmerFoundryID, err := shimmerFoundry.FoundryID()
+	require.NoError(t, err)
+
+	dragonFoundry := foundryOutputWithIRC30Metadata(accountAddress, 2, iotago.MetadataFeatureEntries{
+		"standard": []byte("IRC30"),
+		"name":     []byte("Dragon Coin"),
+		"symbol":   []byte("DRGN"),
+		"decimals": []byte("0"),
+		"logoUrl":  []byte("https://example.com/logo.png"),
+	})
+	dragonFoundryID, err := dragonFoundry.FoundryID()
+	require.NoError(t, err)
+
+	// Metadata without the mandatory keys is not added to the registry
+	invalidFoundry := foundryOutputWithIRC30Metadata(accountAddress, 3, iotago.MetadataFeatureEntries{
+		"standard": []byte("IRC30"),
+		"name":     []byte("No Symbol"),
+	})
+	invalidFoundryID, err := invalidFoundry.FoundryID()
+	require.NoError(t, err)
+
+	ts.AddOutputOnCommitment(shimmerFoundry, iotago_tpkg.RandOutputID(0))
+	ts.AddOutputOnCommitment(dragonFoundry, iotago_tpkg.RandOutputID(0))
+	ts.AddOutputOnCommitment(invalidFoundry, iotago_tpkg.RandOutputID(0))
+
+	// By ID
+	metadata, err := ts.Indexer.NativeTokenByID(shimmerFoundryID)
+	require.NoError(t, err)
+	require.Equal(t, shimmerFoundryID, metadata.FoundryID)
+	require.Equal(t, "Shimmer Token", metadata.Name)
+	require.Equal(t, "SMRT", metadata.Symbol)
+	require.Equal(t, uint32(6), metadata.Decimals)
+	require.Equal(t, "A test token", *metadata.Description)
+	require.Nil(t, metadata.URL)
+	require.Nil(t, metadata.LogoURL)
+
+	_, err = ts.Indexer.NativeTokenByID(invalidFoundryID)
+	require.ErrorIs(t, err, indexer.ErrNativeTokenNotFound)
+
+	foundryIDs := func(result *indexer.NativeTokensResult) []iotago.FoundryID {
+		require.NoError(t, result.Error)
+
+		ids := make([]iotago.FoundryID, 0, len(result.NativeTokens))
+		for _, nativeToken := range result.NativeTokens {
+			ids = append(ids, nativeToken.FoundryID)
+		}
+
+		return ids
+	}
+
+	// Search
+	require.ElementsMatch(t, []iotago.FoundryID{shimmerFoundryID, dragonFoundryID}, foundryIDs(ts.Indexer.NativeTokens()))
+	require.Equal(t, []iotago.FoundryID{shimmerFoundryID}, foundryIDs(ts.Indexer.NativeTokens(indexer.NativeTokenSymbolPrefix("sm"))))
+	require.Equal(t, []iotago.FoundryID{dragonFoundryID}, foundryIDs(ts.Indexer.NativeTokens(indexer.NativeTokenNamePrefix("Dragon"))))
+	require.Empty(t, foundryIDs(ts.Indexer.NativeTokens(indexer.NativeTokenNamePrefix("Coin"))))
+	require.Empty(t, foundryIDs(ts.Indexer.NativeTokens(indexer.NativeTokenSymbolPrefix("SMRT"), indexer.NativeTokenNamePrefix("Dragon"))))
+
+	// Pagination
+	page := ts.Indexer.NativeTokens(indexer.NativeTokenPageSize(1))
+	require.Len(t, foundryIDs(page), 1)
+	require.NotNil(t, page.Cursor)
+
+	nextPage := ts.Indexer.NativeTokens(indexer.NativeTokenPageSize(1), indexer.NativeTokenCursor(*page.Cursor))
+	require.Len(t, foundryIDs(nextPage), 1)
+	require.Nil(t, nextPage.Cursor)
+	require.NotEqual(t, foundryIDs(page), foundryIDs(nextPage))
+}
+
+func TestIndexer_NativeTokenRegistry_Uncommitted(t *testing.T) {
+	ts := newTestSuite(t)
+
+	foundryOutput := foundryOutputWithIRC30Metadata(iotago_tpkg.RandAccountAddress(), 1, iotago.MetadataFeatureEntries{
+		"standard": []byte("IRC30"),
+		"name":     []byte("Shimmer Token"),
+		"symbol":   []byte("SMRT"),
+		"decimals": []byte("6"),
+	})
+	foundryID, err := foundryOutput.FoundryID()
+	require.NoError(t, err)
+
+	ts.CommitEmptyLedgerUpdate() // Slot 1
+
+	// Accepted foundries are found in the registry
+	ts.AddOutputOnAcceptance(foundryOutput, iotago_tpkg.RandOutputID(0), 2)
+
+	_, err = ts.Indexer.NativeTokenByID(foundryID)
+	require.NoError(t, err)
+
+	// The foundry was never committed, so the native token is removed from the registry
+	require.NoError(t, ts.Indexer.RemoveUncommittedChanges())
+
+	_, err = ts.Indexer.NativeTokenByID(foundryID)
+	require.ErrorIs(t, err, indexer.ErrNativeTokenNotFound)
+	require.Empty(t, ts.Indexer.NativeTokens().NativeTokens)
+}
@@ -1,6 +1,7 @@
 package indexer
 
 import (
+	"database/sql/driver"
 	"fmt"
 	"strings"
 
@@ -12,7 +13,8 @@ import (
 )
 
 const (
-	CursorLength    = 84
+	// CursorLength is the length of a cursor key: the hex encoded sort column padded to 16 characters followed by the hex encoded outputID.
+	CursorLength    = 16 + 2*iotago.OutputIDLength
 	DefaultPageSize = 100
 )
 
@@ -30,15 +32,21 @@ type LedgerOutput struct {
 }
 
 type Status struct {
-	ID              uint `gorm:"primaryKey;notnull"`
-	CommittedSlot   iotago.SlotIndex
-	NetworkName     string
-	DatabaseVersion uint32
+	ID               uint `gorm:"primaryKey;notnull"`
+	CommittedSlot    iotago.SlotIndex
+	HistoryStartSlot iotago.SlotIndex
+	NetworkName      string
+	DatabaseVersion  uint32
+	// ChangeLogID identifies the change log, a new ID is generated whenever the ledger is (re-)imported and the change log starts empty.
+	ChangeLogID string
+	// ChangeLogStartSequenceNumber is the first sequence number of the change log that was not pruned.
+	ChangeLogStartSequenceNumber uint64
 }
 
 type queryResult struct {
 	OutputID      []byte
-	Cursor        string
+	CreatedAtSlot iotago.SlotIndex
+	Amount        iotago.BaseToken
 	CommittedSlot iotago.SlotIndex
 }
 
@@ -68,80 +76,145 @@ func errorResult(err error) *IndexerResult {
 	}
 }
 
-func (i *Indexer) filteredQuery(query *gorm.DB, pageSize uint32, cursor *string) (*gorm.DB, error) {
-	query = query.Select("output_id", "created_at_slot").Order("created_at_slot asc, output_id asc")
-	if pageSize > 0 {
-		var cursorQuery string
-		//nolint:exhaustive // we have a default case.
-		switch i.engine {
-		case db.EngineSQLite:
-			cursorQuery = "printf('%016X', created_at_slot) || hex(output_id) as cursor"
-		case db.EnginePostgreSQL:
-			cursorQuery = "lpad(to_hex(created_at_slot), 16, '0') || encode(output_id, 'hex') as cursor"
-		default:
-			i.LogFatalf("Unsupported db engine pagination queries: %s", i.engine)
-		}
+// CountResult contains the amount of outputs matching a query.
+type CountResult struct {
+	Count         uint64
+	CommittedSlot iotago.SlotIndex
+	Error         error
+}
+
+func errorCountResult(err error) *CountResult {
+	return &CountResult{
+		Error: err,
+	}
+}
+
+// bytesValue passes a byte slice to the database as a single value.
+// Plain byte slices in an IN list are expanded into their single bytes once the query is nested as a subquery.
+type bytesValue []byte
+
+func (v bytesValue) Value() (driver.Value, error) {
+	return []byte(v), nil
+}
+
+// unspentAtSlotQuery filters the query for outputs that were unspent at the given slot.
+// If no slot is given, only the currently unspent outputs are returned.
+func unspentAtSlotQuery(query *gorm.DB, asOfSlot *iotago.SlotIndex) *gorm.DB {
+	if asOfSlot == nil {
+		return query.Where("deleted_at_slot = 0")
+	}
+
+	return query.Where("created_at_slot <= ? AND (deleted_at_slot = 0 OR deleted_at_slot > ?)", *asOfSlot, *asOfSlot)
+}
+
+// chainOutputAtSlotQuery filters the query for the output of a chain that was unspent at the given slot.
+// If no slot is given, the latest output of the chain is returned, even if it was spent in a not yet committed slot.
+func chainOutputAtSlotQuery(query *gorm.DB, asOfSlot *iotago.SlotIndex) *gorm.DB {
+	if asOfSlot == nil {
+		return query.Where("spent_at_slot = 0")
+	}
+
+	return unspentAtSlotQuery(query, asOfSlot)
+}
+
+// likePrefixPattern returns a pattern for a LIKE query that matches all strings starting with the given prefix.
+// The wildcard characters of the prefix are escaped with a backslash.
+func likePrefixPattern(prefix string) string {
+	replacer := strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+	return replacer.Replace(prefix) + "%"
+}
+
+// prefixQuery filters the query for rows where the given column starts with the given prefix (case-insensitive).
+func (i *Indexer) prefixQuery(query *gorm.DB, column string, prefix string) *gorm.DB {
+	// LIKE is case-insensitive in SQLite, but not in PostgreSQL
+	//nolint:exhaustive // we have a default case.
+	switch i.engine {
+	case db.EnginePostgreSQL:
+		return query.Where(fmt.Sprintf("%s ILIKE ? ESCAPE '\\'", column), likePrefixPattern(prefix))
+	default:
+		return query.Where(fmt.Sprintf("%s LIKE ? ESCAPE '\\'", column), likePrefixPattern(prefix))
+	}
+}
 
+// checkAsOfSlot checks whether the state at the given slot can be reconstructed from the retained history.
+func (i *Indexer) checkAsOfSlot(asOfSlot *iotago.SlotIndex) error {
+	if asOfSlot == nil {
+		return nil
+	}
+
+	status, err := i.Status()
+	if err != nil {
+		return err
+	}
+
+	if *asOfSlot < status.HistoryStartSlot || *asOfSlot > status.CommittedSlot {
+		return ierrors.Wrapf(ErrSlotNotRetained, "slot %d is not within the retained range [%d, %d]", *asOfSlot, status.HistoryStartSlot, status.CommittedSlot)
+	}
+
+	return nil
+}
+
+func (i *Indexer) filteredQuery(query *gorm.DB, pageSize uint32, cursor *string, sortOrder SortOrder) (*gorm.DB, error) {
+	query = query.Select("output_id", "created_at_slot", "amount").Order(sortOrder.orderClause())
+	if pageSize > 0 {
 		// We use pageSize + 1 to load the next item to use as the cursor
-		query = query.Select("output_id", "created_at_slot", cursorQuery).Limit(int(pageSize + 1))
+		query = query.Limit(int(pageSize + 1))
 
 		if cursor != nil {
-			if len(*cursor) != CursorLength {
-				return nil, ierrors.Errorf("Invalid cursor length: %d", len(*cursor))
+			sortValue, outputID, err := sortOrder.parseCursor(*cursor)
+			if err != nil {
+				return nil, err
 			}
-			//nolint:exhaustive // we have a default case.
-			switch i.engine {
-			case db.EngineSQLite:
-				query = query.Where("cursor >= ?", strings.ToUpper(*cursor))
-			case db.EnginePostgreSQL:
-				query = query.Where("lpad(to_hex(created_at_slot), 16, '0') || encode(output_id, 'hex') >= ?", *cursor)
-			default:
-				i.LogFatalf("Unsupported db engine pagination queries: %s", i.engine)
+
+			comparison := ">="
+			if sortOrder.descending() {
+				comparison = "<="
 			}
+
+			// The tuple comparison allows the database to seek in the (sort column, output_id) index instead of scanning all rows
+			query = query.Where(fmt.Sprintf("(%s, output_id) %s (?, ?)", sortOrder.sortColumn(), comparison), sortValue, outputID)
 		}
 	}
 
 	return query, nil
 }
 
-func (i *Indexer) combineOutputIDFilteredQuery(query *gorm.DB, pageSize uint32, cursor *string) *IndexerResult {
+func (i *Indexer) combineOutputIDFilteredQuery(query *gorm.DB, pageSize uint32, cursor *string, sortOrder SortOrder) *IndexerResult {
 	var err error
-	query, err = i.filteredQuery(query, pageSize, cursor)
+	query, err = i.filteredQuery(query, pageSize, cursor, sortOrder)
 	if err != nil {
 		return errorResult(err)
 	}
 
-	return i.resultsForQuery(query, pageSize)
+	return i.resultsForQuery(query, pageSize, sortOrder)
 }
 
-func (i *Indexer) combineOutputIDFilteredQueries(queries []*gorm.DB, pageSize uint32, cursor *string) *IndexerResult {
+func (i *Indexer) combineOutputIDFilteredQueries(queries []*gorm.DB, pageSize uint32, cursor *string, sortOrder SortOrder) *IndexerResult {
 	// Cast to []interface{} so that we can pass them to i.db.Raw as parameters
 	filteredQueries := make([]interface{}, len(queries))
 	for q, query := range queries {
-		filtered, err := i.filteredQuery(query, pageSize, cursor)
+		filtered, err := i.filteredQuery(query, pageSize, cursor, sortOrder)
 		if err != nil {
 			return errorResult(err)
 		}
 		filteredQueries[q] = filtered
 	}
 
-	unionQueryItem := "SELECT output_id, created_at_slot FROM (?) as temp;"
-	if pageSize > 0 {
-		unionQueryItem = "SELECT output_id, created_at_slot, cursor FROM (?) as temp;"
-	}
+	unionQueryItem := "SELECT output_id, created_at_slot, amount FROM (?) as temp;"
 	repeatedUnionQueryItem := strings.Split(strings.Repeat(unionQueryItem, len(queries)), ";")
 	unionQuery := strings.Join(repeatedUnionQueryItem[:len(repeatedUnionQueryItem)-1], " UNION ")
 
 	// We use pageSize + 1 to load the next item to use as the cursor
-	unionQuery = fmt.Sprintf("%s ORDER BY created_at_slot asc, output_id asc LIMIT %d", unionQuery, pageSize+1)
+	unionQuery = fmt.Sprintf("%s ORDER BY %s LIMIT %d", unionQuery, sortOrder.orderClause(), pageSize+1)
 
 	rawQuery := i.db.Raw(unionQuery, filteredQueries...)
-	rawQuery = rawQuery.Order("created_at_slot asc, output_id asc")
+	rawQuery = rawQuery.Order(sortOrder.orderClause())
 
-	return i.resultsForQuery(rawQuery, pageSize)
+	return i.resultsForQuery(rawQuery, pageSize, sortOrder)
 }
 
-func (i *Indexer) resultsForQuery(query *gorm.DB, pageSize uint32) *IndexerResult {
+func (i *Indexer) resultsForQuery(query *gorm.DB, pageSize uint32, sortOrder SortOrder) *IndexerResult {
 	// This combines the query with a second query that checks for the current committed_slot.
 	// This way we do not need to lock anything and we know the index matches the results.
 	committedSlotQuery := i.db.Model(&Status{}).Select("committed_slot")
@@ -168,7 +241,7 @@ func (i *Indexer) resultsForQuery(query *gorm.DB, pageSize uint32) *IndexerResul
 	if pageSize > 0 && uint32(len(results)) > pageSize {
 		lastResult := results[len(results)-1]
 		results = results[:len(results)-1]
-		c := strings.ToLower(lastResult.Cursor)
+		c := sortOrder.cursorFromResult(lastResult)
 		nextCursor = &c
 	}
 
@@ -180,3 +253,31 @@ func (i *Indexer) resultsForQuery(query *gorm.DB, pageSize uint32) *IndexerResul
 		Error:         nil,
 	}
 }
+
+// countQueries returns the amount of distinct outputs matched by the union of the given queries.
+func (i *Indexer) countQueries(queries []*gorm.DB) *CountResult {
+	// Cast to []interface{} so that we can pass them to i.db.Raw as parameters
+	// The committed slot is queried together with the count, so we know the count matches the committed slot.
+	queryArgs := make([]interface{}, 0, len(queries)+1)
+	queryArgs = append(queryArgs, i.db.Model(&Status{}).Select("committed_slot"))
+	for _, query := range queries {
+		queryArgs = append(queryArgs, query.Select("output_id"))
+	}
+
+	unionQueryItem := "SELECT output_id FROM (?) as temp;"
+	repeatedUnionQueryItem := strings.Split(strings.Repeat(unionQueryItem, len(queries)), ";")
+	unionQuery := strings.Join(repeatedUnionQueryItem[:len(repeatedUnionQueryItem)-1], " UNION ")
+
+	var result struct {
+		Count         uint64
+		CommittedSlot iotago.SlotIndex
+	}
+	if err := i.db.Raw(fmt.Sprintf("SELECT COUNT(*) as count, (?) as committed_slot FROM (%s) as results", unionQuery), queryArgs...).Scan(&result).Error; err != nil {
+		return errorCountResult(err)
+	}
+
+	return &CountResult{
+		Count:         result.Count,
+		CommittedSlot: result.CommittedSlot,
+	}
+}
@@ -0,0 +1,154 @@
+package indexer
+
+import (
+	"encoding/hex"
+
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/hive.go/runtime/options"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+// Validator contains the staking data of an account with a StakingFeature and the delegations pointing to it.
+type Validator struct {
+	AccountID    iotago.AccountID
+	OutputID     iotago.OutputID
+	StakedAmount iotago.BaseToken
+	FixedCost    iotago.Mana
+	StartEpoch   iotago.EpochIndex
+	EndEpoch     iotago.EpochIndex
+	// DelegatedAmount is the sum of the amounts of all unspent delegation outputs pointing to the validator.
+	DelegatedAmount iotago.BaseToken
+	DelegationCount uint64
+}
+
+// ValidatorsResult contains a page of validators.
+type ValidatorsResult struct {
+	Validators    []*Validator
+	CommittedSlot iotago.SlotIndex
+	PageSize      uint32
+	Cursor        *string
+	Error         error
+}
+
+type ValidatorFilterOptions struct {
+	pageSize uint32
+	cursor   *string
+}
+
+func ValidatorPageSize(pageSize uint32) options.Option[ValidatorFilterOptions] {
+	return func(args *ValidatorFilterOptions) {
+		args.pageSize = pageSize
+	}
+}
+
+func ValidatorCursor(cursor string) options.Option[ValidatorFilterOptions] {
+	return func(args *ValidatorFilterOptions) {
+		args.cursor = &cursor
+	}
+}
+
+type delegationSumQueryResult struct {
+	Validator []byte
+	Count     uint64
+	Amount    iotago.BaseToken
+}
+
+// delegationSums returns the count and the sum of the amounts of the unspent delegation outputs per validator address ID.
+func (i *Indexer) delegationSums(validatorAddressIDs [][]byte) (map[string]*delegationSumQueryResult, error) {
+	sums := make(map[string]*delegationSumQueryResult, len(validatorAddressIDs))
+	if len(validatorAddressIDs) == 0 {
+		return sums, nil
+	}
+
+	var results []*delegationSumQueryResult
+	if err := unspentAtSlotQuery(i.db.Model(&delegation{}), nil).
+		Select("validator, COUNT(*) as count, COALESCE(SUM(amount), 0) as amount").
+		Where("validator IN (?)", validatorAddressIDs).
+		Group("validator").
+		Scan(&results).Error; err != nil {
+		return nil, err
+	}
+
+	for _, result := range results {
+		sums[string(result.Validator)] = result
+	}
+
+	return sums, nil
+}
+
+// Validators returns the unspent accounts with a StakingFeature, ordered by AccountID.
+func (i *Indexer) Validators(filters ...options.Option[ValidatorFilterOptions]) *ValidatorsResult {
+	opts := options.Apply(&ValidatorFilterOptions{
+		pageSize: DefaultPageSize,
+	}, filters)
+
+	query := unspentAtSlotQuery(i.db.Model(&account{}), nil).
+		Where("staked_amount IS NOT NULL").
+		Order("account_id asc")
+
+	if opts.cursor != nil {
+		cursor, err := hex.DecodeString(*opts.cursor)
+		if err != nil || len(cursor) != iotago.AccountIDLength {
+			return &ValidatorsResult{Error: ierrors.Errorf("Invalid cursor: %s", *opts.cursor)}
+		}
+		query = query.Where("account_id >= ?", cursor)
+	}
+
+	if opts.pageSize > 0 {
+		// We use pageSize + 1 to load the next item to use as the cursor
+		query = query.Limit(int(opts.pageSize + 1))
+	}
+
+	status, err := i.Status()
+	if err != nil {
+		return &ValidatorsResult{Error: err}
+	}
+
+	var results []*account
+	if err := query.Find(&results).Error; err != nil {
+		return &ValidatorsResult{Error: err}
+	}
+
+	var nextCursor *string
+	if opts.pageSize > 0 && uint32(len(results)) > opts.pageSize {
+		c := hex.EncodeToString(results[len(results)-1].AccountID)
+		nextCursor = &c
+		results = results[:len(results)-1]
+	}
+
+	validatorAddressIDs := make([][]byte, 0, len(results))
+	for _, result := range results {
+		validatorAddressIDs = append(validatorAddressIDs, iotago.AccountID(result.AccountID).ToAddress().ID())
+	}
+
+	sums, err := i.delegationSums(validatorAddressIDs)
+	if err != nil {
+		return &ValidatorsResult{Error: err}
+	}
+
+	validators := make([]*Validator, 0, len(results))
+	for idx, result := range results {
+		validator := &Validator{
+			AccountID:    iotago.AccountID(result.AccountID),
+			OutputID:     iotago.OutputID(result.OutputID),
+			StakedAmount: *result.StakedAmount,
+			FixedCost:    *result.FixedCost,
+			StartEpoch:   *result.StakingStartEpoch,
+			EndEpoch:     *result.StakingEndEpoch,
+		}
+
+		if sum, exists := sums[string(validatorAddressIDs[idx])]; exists {
+			validator.DelegatedAmount = sum.Amount
+			validator.DelegationCount = sum.Count
+		}
+
+		validators = append(validators, validator)
+	}
+
+	return &ValidatorsResult{
+		Validators:    validators,
+		CommittedSlot: status.CommittedSlot,
+		PageSize:      opts.pageSize,
+		Cursor:        nextCursor,
+	}
+}
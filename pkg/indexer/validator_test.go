@@ -0,0 +1,90 @@
+package indexer_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/inx-indexer/pkg/indexer"
+	iotago "github.com/iotaledger/iota.go/v4"
+	iotago_tpkg "github.com/iotaledger/iota.go/v4/tpkg"
+)
+
+func validatorOutput(accountID iotago.AccountID, stakedAmount iotago.BaseToken) *iotago.AccountOutput {
+	output := accountOutputWithAddress(iotago_tpkg.RandEd25519Address()).(*iotago.AccountOutput)
+	output.AccountID = accountID
+	output.Features = iotago.AccountOutputFeatures{
+		&iotago.StakingFeature{
+			StakedAmount: stakedAmount,
+			FixedCost:    10,
+			StartEpoch:   1,
+			EndEpoch:     iotago.MaxEpochIndex,
+		},
+	}
+
+	return output
+}
+
+func delegationOutputToValidator(validator iotago.AccountID, amount iotago.BaseToken) *iotago.DelegationOutput {
+	output := delegationOutputWithAddress(iotago_tpkg.RandEd25519Address()).(*iotago.DelegationOutput)
+	output.ValidatorAddress = validator.ToAddress().(*iotago.AccountAddress)
+	output.Amount = amount
+
+	return output
+}
+
+func TestIndexer_Validators(t *testing.T) {
+	ts := newTestSuite(t)
+
+	firstValidatorID := iotago_tpkg.RandAccountAddress().AccountID()
+	secondValidatorID := iotago_tpkg.RandAccountAddress().AccountID()
+	if bytes.Compare(firstValidatorID[:], secondValidatorID[:]) > 0 {
+		firstValidatorID, secondValidatorID = secondValidatorID, firstValidatorID
+	}
+
+	ts.AddOutputOnCommitment(validatorOutput(firstValidatorID, 5000), iotago_tpkg.RandOutputID(0))
+	ts.AddOutputOnCommitment(validatorOutput(secondValidatorID, 7000), iotago_tpkg.RandOutputID(0))
+
+	// Accounts without a StakingFeature are no validators
+	ts.AddOutputOnCommitment(accountOutputWithAddress(iotago_tpkg.RandEd25519Address()), iotago_tpkg.RandOutputID(0))
+
+	ts.AddOutputOnCommitment(delegationOutputToValidator(firstValidatorID, 1000), iotago_tpkg.RandOutputID(0))
+	ts.AddOutputOnCommitment(delegationOutputToValidator(firstValidatorID, 2000), iotago_tpkg.RandOutputID(0))
+	spentDelegationID := iotago_tpkg.RandOutputID(0)
+	ts.AddOutputOnCommitment(delegationOutputToValidator(firstValidatorID, 4000), spentDelegationID)
+	ts.DeleteOutputOnCommitment(spentDelegationID)
+
+	result := ts.Indexer.Validators()
+	require.NoError(t, result.Error)
+	require.Len(t, result.Validators, 2)
+	require.Nil(t, result.Cursor)
+
+	first := result.Validators[0]
+	require.Equal(t, firstValidatorID, first.AccountID)
+	require.Equal(t, iotago.BaseToken(5000), first.StakedAmount)
+	require.Equal(t, iotago.Mana(10), first.FixedCost)
+	require.Equal(t, iotago.EpochIndex(1), first.StartEpoch)
+	require.Equal(t, iotago.MaxEpochIndex, first.EndEpoch)
+	require.Equal(t, iotago.BaseToken(3000), first.DelegatedAmount)
+	require.Equal(t, uint64(2), first.DelegationCount)
+
+	second := result.Validators[1]
+	require.Equal(t, secondValidatorID, second.AccountID)
+	require.Equal(t, iotago.BaseToken(7000), second.StakedAmount)
+	require.Zero(t, second.DelegatedAmount)
+	require.Zero(t, second.DelegationCount)
+
+	// Pagination
+	page := ts.Indexer.Validators(indexer.ValidatorPageSize(1))
+	require.NoError(t, page.Error)
+	require.Len(t, page.Validators, 1)
+	require.Equal(t, firstValidatorID, page.Validators[0].AccountID)
+	require.NotNil(t, page.Cursor)
+
+	nextPage := ts.Indexer.Validators(indexer.ValidatorPageSize(1), indexer.ValidatorCursor(*page.Cursor))
+	require.NoError(t, nextPage.Error)
+	require.Len(t, nextPage.Validators, 1)
+	require.Equal(t, secondValidatorID, nextPage.Validators[0].AccountID)
+	require.Nil(t, nextPage.Cursor)
+}
@@ -0,0 +1,700 @@
+package server
+
+import (
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/hive.go/serializer/v2/serix"
+	"github.com/iotaledger/inx-app/pkg/httpserver"
+	"github.com/iotaledger/inx-indexer/pkg/apitypes"
+	"github.com/iotaledger/inx-indexer/pkg/indexer"
+	"github.com/iotaledger/iota.go/v4/api"
+)
+
+const (
+	// OpenAPIVersion is the version of the OpenAPI specification the document is generated for.
+	OpenAPIVersion = "3.0.3"
+
+	openAPITitle = "IOTA Indexer REST API"
+)
+
+// openAPIRoute describes a route registered in configureRoutes.
+type openAPIRoute struct {
+	method  string
+	path    string
+	summary string
+	tag     string
+	// The names of the query parameters parsed by the handler.
+	queryParameters []string
+	// The type of the request body, nil if the route takes no body.
+	request interface{}
+	// The status code of a successful call.
+	status int
+	// The possible types of the response, no content is returned if there are none.
+	responses []interface{}
+	// Whether the route returns a stream of server-sent events instead of a single response.
+	events bool
+}
+
+// openAPIParameterDescription describes a path or query parameter.
+type openAPIParameterDescription struct {
+	description string
+	schema      *openAPISchema
+}
+
+var (
+	stringSchema  = &openAPISchema{Type: "string"}
+	integerSchema = &openAPISchema{Type: "integer", Format: "int64"}
+	booleanSchema = &openAPISchema{Type: "boolean"}
+	amountSchema  = &openAPISchema{Type: "string", Description: "decimal encoded amount of base tokens"}
+	hexSchema     = &openAPISchema{Type: "string", Description: "hex encoded"}
+	bech32Schema  = &openAPISchema{Type: "string", Description: "bech32 encoded address"}
+)
+
+// openAPIParameters describes the path and query parameters of the routes.
+var openAPIParameters = map[string]openAPIParameterDescription{
+	api.ParameterBech32Address:                         {"The bech32 encoded address.", bech32Schema},
+	api.ParameterFoundryID:                             {"The ID of the foundry.", hexSchema},
+	api.ParameterDelegationID:                          {"The ID of the delegation.", hexSchema},
+	apitypes.ParameterNativeTokenID:                    {"The ID of the native token.", hexSchema},
+	apitypes.ParameterBlockIssuerKey:                   {"The serialized block issuer key, including its type prefix.", hexSchema},
+	apitypes.ParameterWebhookID:                        {"The ID of the webhook.", integerSchema},
+	apitypes.QueryParameterUnlockableByAddress:         {"Filter for outputs that can be unlocked by the address, regardless of the unlock condition.", bech32Schema},
+	apitypes.QueryParameterAddress:                     {"Filter for outputs with the address in their address unlock condition.", bech32Schema},
+	apitypes.QueryParameterAccount:                     {"Filter for foundries controlled by the account.", bech32Schema},
+	apitypes.QueryParameterIssuer:                      {"Filter for outputs with the address in their issuer feature.", bech32Schema},
+	apitypes.QueryParameterCollection:                  {"Filter for NFTs issued by the NFT with the ID.", hexSchema},
+	apitypes.QueryParameterCollectionName:              {"Filter for NFTs with the IRC27 collection name.", stringSchema},
+	apitypes.QueryParameterMediaType:                   {"Filter for NFTs with the IRC27 media type.", stringSchema},
+	apitypes.QueryParameterNamePrefix:                  {"Filter for NFTs or native tokens with a name starting with the prefix.", stringSchema},
+	apitypes.QueryParameterSymbolPrefix:                {"Filter for native tokens with an IRC30 symbol starting with the prefix.", stringSchema},
+	apitypes.QueryParameterSender:                      {"Filter for outputs with the address in their sender feature.", bech32Schema},
+	apitypes.QueryParameterTag:                         {"Filter for outputs with the tag in their tag feature.", hexSchema},
+	apitypes.QueryParameterValidator:                   {"Filter for delegations to the validator.", bech32Schema},
+	apitypes.QueryParameterHasStorageDepositReturn:     {"Filter for outputs with or without a storage deposit return unlock condition.", booleanSchema},
+	apitypes.QueryParameterStorageDepositReturnAddress: {"Filter for outputs with the storage deposit return address.", bech32Schema},
+	apitypes.QueryParameterHasExpiration:               {"Filter for outputs with or without an expiration unlock condition.", booleanSchema},
+	apitypes.QueryParameterExpiresBefore:               {"Filter for outputs that expire before the slot.", integerSchema},
+	apitypes.QueryParameterExpiresAfter:                {"Filter for outputs that expire after the slot.", integerSchema},
+	apitypes.QueryParameterExpirationReturnAddress:     {"Filter for outputs with the expiration return address.", bech32Schema},
+	apitypes.QueryParameterHasTimelock:                 {"Filter for outputs with or without a timelock unlock condition.", booleanSchema},
+	apitypes.QueryParameterTimelockedBefore:            {"Filter for outputs that are timelocked before the slot.", integerSchema},
+	apitypes.QueryParameterTimelockedAfter:             {"Filter for outputs that are timelocked after the slot.", integerSchema},
+	apitypes.QueryParameterStateController:             {"Filter for anchors with the state controller address.", bech32Schema},
+	apitypes.QueryParameterGovernor:                    {"Filter for anchors with the governor address.", bech32Schema},
+	apitypes.QueryParameterSerialNumber:                {"Filter for foundries with the serial number.", integerSchema},
+	apitypes.QueryParameterHasMintCapacity:             {"Filter for foundries that can or cannot mint new tokens.", booleanSchema},
+	apitypes.QueryParameterIsBlockIssuer:               {"Filter for accounts with or without a block issuer feature.", booleanSchema},
+	apitypes.QueryParameterBlockIssuerExpiresBefore:    {"Filter for accounts whose block issuer feature expires before the slot.", integerSchema},
+	apitypes.QueryParameterBlockIssuerExpiresAfter:     {"Filter for accounts whose block issuer feature expires after the slot.", integerSchema},
+	apitypes.QueryParameterMinAmount:                   {"Filter for outputs holding at least the amount of base tokens.", amountSchema},
+	apitypes.QueryParameterMaxAmount:                   {"Filter for outputs holding at most the amount of base tokens.", amountSchema},
+	apitypes.QueryParameterMinDelegatedAmount:          {"Filter for delegations with a delegated amount greater than or equal to the amount.", amountSchema},
+	apitypes.QueryParameterMaxDelegatedAmount:          {"Filter for delegations with a delegated amount less than or equal to the amount.", amountSchema},
+	apitypes.QueryParameterStartEpochBefore:            {"Filter for delegations that started before the epoch.", integerSchema},
+	apitypes.QueryParameterStartEpochAfter:             {"Filter for delegations that started after the epoch.", integerSchema},
+	apitypes.QueryParameterHasEndEpoch:                 {"Filter for delegations that were or were not ended yet.", booleanSchema},
+	apitypes.QueryParameterPageSize:                    {"The maximum amount of items returned in one call, limited by the configured maximum.", integerSchema},
+	apitypes.QueryParameterSort:                        {"The order of the results.", &openAPISchema{Type: "string", Enum: sortOrderNames()}},
+	apitypes.QueryParameterCursor:                      {"The cursor returned by the previous call to continue with the next results.", stringSchema},
+	apitypes.QueryParameterCreatedBefore:               {"Filter for outputs that were created before the slot.", integerSchema},
+	apitypes.QueryParameterCreatedAfter:                {"Filter for outputs that were created after the slot.", integerSchema},
+	apitypes.QueryParameterAsOfSlot:                    {"Query the outputs that were unspent at the slot, it has to be within the retained history.", integerSchema},
+	apitypes.QueryParameterHasNativeToken:              {"Filter for outputs with or without a native token.", booleanSchema},
+	apitypes.QueryParameterNativeToken:                 {"Filter for outputs holding the native token.", hexSchema},
+	apitypes.QueryParameterFromSequenceNumber:          {"The sequence number of the first returned change log entry.", integerSchema},
+	apitypes.QueryParameterChangeLogID:                 {"The ID of the change log the sequence number belongs to. Returns 410 if the change log was replaced by a re-import.", stringSchema},
+	apitypes.QueryParameterInclude:                     {"Return the outputs and/or their metadata together with the outputIDs (\"" + apitypes.IncludeOutputs + "\", \"" + apitypes.IncludeMetadata + "\" or \"" + apitypes.IncludeOutputs + "," + apitypes.IncludeMetadata + "\").", stringSchema},
+}
+
+var (
+	paginationQueryParameters = []string{apitypes.QueryParameterPageSize, apitypes.QueryParameterCursor, apitypes.QueryParameterSort}
+
+	combinedFilterQueryParameters = []string{
+		apitypes.QueryParameterHasNativeToken, apitypes.QueryParameterNativeToken, apitypes.QueryParameterUnlockableByAddress,
+		apitypes.QueryParameterMinAmount, apitypes.QueryParameterMaxAmount,
+		apitypes.QueryParameterCreatedBefore, apitypes.QueryParameterCreatedAfter, apitypes.QueryParameterAsOfSlot,
+	}
+
+	basicFilterQueryParameters = []string{
+		apitypes.QueryParameterHasNativeToken, apitypes.QueryParameterNativeToken, apitypes.QueryParameterAddress, apitypes.QueryParameterUnlockableByAddress,
+		apitypes.QueryParameterHasStorageDepositReturn, apitypes.QueryParameterStorageDepositReturnAddress,
+		apitypes.QueryParameterHasExpiration, apitypes.QueryParameterExpiresBefore, apitypes.QueryParameterExpiresAfter, apitypes.QueryParameterExpirationReturnAddress,
+		apitypes.QueryParameterHasTimelock, apitypes.QueryParameterTimelockedBefore, apitypes.QueryParameterTimelockedAfter,
+		apitypes.QueryParameterSender, apitypes.QueryParameterTag, apitypes.QueryParameterMinAmount, apitypes.QueryParameterMaxAmount,
+		apitypes.QueryParameterCreatedBefore, apitypes.QueryParameterCreatedAfter, apitypes.QueryParameterAsOfSlot,
+	}
+
+	accountFilterQueryParameters = []string{
+		apitypes.QueryParameterAddress, apitypes.QueryParameterIssuer, apitypes.QueryParameterSender,
+		apitypes.QueryParameterIsBlockIssuer, apitypes.QueryParameterBlockIssuerExpiresBefore, apitypes.QueryParameterBlockIssuerExpiresAfter,
+		apitypes.QueryParameterMinAmount, apitypes.QueryParameterMaxAmount,
+		apitypes.QueryParameterCreatedBefore, apitypes.QueryParameterCreatedAfter, apitypes.QueryParameterAsOfSlot,
+	}
+
+	anchorFilterQueryParameters = []string{
+		apitypes.QueryParameterUnlockableByAddress, apitypes.QueryParameterStateController, apitypes.QueryParameterGovernor,
+		apitypes.QueryParameterIssuer, apitypes.QueryParameterSender, apitypes.QueryParameterMinAmount, apitypes.QueryParameterMaxAmount,
+		apitypes.QueryParameterCreatedBefore, apitypes.QueryParameterCreatedAfter, apitypes.QueryParameterAsOfSlot,
+	}
+
+	foundryFilterQueryParameters = []string{
+		apitypes.QueryParameterHasNativeToken, apitypes.QueryParameterNativeToken, apitypes.QueryParameterAccount,
+		apitypes.QueryParameterSerialNumber, apitypes.QueryParameterHasMintCapacity, apitypes.QueryParameterMinAmount, apitypes.QueryParameterMaxAmount,
+		apitypes.QueryParameterCreatedBefore, apitypes.QueryParameterCreatedAfter, apitypes.QueryParameterAsOfSlot,
+	}
+
+	nftFilterQueryParameters = []string{
+		apitypes.QueryParameterAddress, apitypes.QueryParameterUnlockableByAddress,
+		apitypes.QueryParameterHasStorageDepositReturn, apitypes.QueryParameterStorageDepositReturnAddress,
+		apitypes.QueryParameterHasExpiration, apitypes.QueryParameterExpiresBefore, apitypes.QueryParameterExpiresAfter, apitypes.QueryParameterExpirationReturnAddress,
+		apitypes.QueryParameterHasTimelock, apitypes.QueryParameterTimelockedBefore, apitypes.QueryParameterTimelockedAfter,
+		apitypes.QueryParameterIssuer, apitypes.QueryParameterSender, apitypes.QueryParameterTag,
+		apitypes.QueryParameterCollection, apitypes.QueryParameterCollectionName, apitypes.QueryParameterMediaType, apitypes.QueryParameterNamePrefix,
+		apitypes.QueryParameterMinAmount, apitypes.QueryParameterMaxAmount,
+		apitypes.QueryParameterCreatedBefore, apitypes.QueryParameterCreatedAfter, apitypes.QueryParameterAsOfSlot,
+	}
+
+	delegationFilterQueryParameters = []string{
+		apitypes.QueryParameterAddress, apitypes.QueryParameterValidator,
+		apitypes.QueryParameterMinDelegatedAmount, apitypes.QueryParameterMaxDelegatedAmount,
+		apitypes.QueryParameterStartEpochBefore, apitypes.QueryParameterStartEpochAfter, apitypes.QueryParameterHasEndEpoch,
+		apitypes.QueryParameterMinAmount, apitypes.QueryParameterMaxAmount,
+		apitypes.QueryParameterCreatedBefore, apitypes.QueryParameterCreatedAfter, apitypes.QueryParameterAsOfSlot,
+	}
+
+	// indexerResponses are the possible responses of the routes sent with sendIndexerResponse.
+	indexerResponses = []interface{}{&api.IndexerResponse{}, &apitypes.OutputsResponse{}}
+)
+
+// openAPIRoutes returns the descriptions of the routes registered in configureRoutes and configureWebhookRoutes.
+func openAPIRoutes() []*openAPIRoute {
+	routes := []*openAPIRoute{
+		{method: http.MethodGet, path: api.RouteHealth, summary: "Returns whether the indexer is synced with the node.", tag: "health"},
+		{method: http.MethodGet, path: apitypes.EndpointOpenAPI, summary: "Returns the OpenAPI document of the REST API.", tag: "health", responses: []interface{}{map[string]interface{}{}}},
+	}
+
+	routes = append(routes, outputListRoutes(api.IndexerEndpointOutputs, "outputs of all types", combinedFilterQueryParameters)...)
+	routes = append(routes,
+		&openAPIRoute{
+			method:          http.MethodPost,
+			path:            apitypes.EndpointOutputsUnlockableByAddresses,
+			summary:         "Returns the outputs of all types unlockable by any of the addresses.",
+			tag:             "outputs",
+			queryParameters: concatQueryParameters(combinedFilterQueryParameters, paginationQueryParameters, []string{apitypes.QueryParameterInclude}),
+			request:         &apitypes.AddressesRequest{},
+			responses:       indexerResponses,
+		},
+		&openAPIRoute{
+			method:          http.MethodPost,
+			path:            apitypes.EndpointOutputsUnlockableByAddresses + apitypes.EndpointSuffixCount,
+			summary:         "Counts the outputs of all types unlockable by any of the addresses.",
+			tag:             "outputs",
+			queryParameters: combinedFilterQueryParameters,
+			request:         &apitypes.AddressesRequest{},
+			responses:       []interface{}{&apitypes.CountResponse{}},
+		},
+	)
+
+	routes = append(routes, outputListRoutes(api.IndexerEndpointOutputsBasic, "basic outputs", basicFilterQueryParameters)...)
+	routes = append(routes, outputListRoutes(api.IndexerEndpointOutputsAccounts, "account outputs", accountFilterQueryParameters)...)
+	routes = append(routes,
+		outputLookupRoute(api.IndexerEndpointOutputsAccountByAddress, "Returns the current account output of the account address."),
+		&openAPIRoute{
+			method:          http.MethodGet,
+			path:            apitypes.EndpointAccountsByBlockIssuerKey,
+			summary:         "Returns the account outputs containing the block issuer key in their block issuer feature.",
+			tag:             "outputs",
+			queryParameters: concatQueryParameters(paginationQueryParameters, []string{apitypes.QueryParameterAsOfSlot, apitypes.QueryParameterInclude}),
+			responses:       indexerResponses,
+		},
+	)
+
+	routes = append(routes, outputListRoutes(api.IndexerEndpointOutputsAnchors, "anchor outputs", anchorFilterQueryParameters)...)
+	routes = append(routes, outputLookupRoute(api.IndexerEndpointOutputsAnchorByAddress, "Returns the current anchor output of the anchor address."))
+
+	routes = append(routes, outputListRoutes(api.IndexerEndpointOutputsFoundries, "foundry outputs", foundryFilterQueryParameters)...)
+	routes = append(routes,
+		outputLookupRoute(api.IndexerEndpointOutputsFoundryByID, "Returns the current output of the foundry."),
+		&openAPIRoute{
+			method:          http.MethodGet,
+			path:            apitypes.EndpointFoundryTokenSupplyByID,
+			summary:         "Returns the token supply of the foundry.",
+			tag:             "native tokens",
+			queryParameters: []string{apitypes.QueryParameterAsOfSlot},
+			responses:       []interface{}{&apitypes.FoundryTokenSupplyResponse{}},
+		},
+	)
+
+	routes = append(routes, outputListRoutes(api.IndexerEndpointOutputsNFTs, "NFT outputs", nftFilterQueryParameters)...)
+	routes = append(routes, outputLookupRoute(api.IndexerEndpointOutputsNFTByAddress, "Returns the current NFT output of the NFT address."))
+
+	routes = append(routes, outputListRoutes(api.IndexerEndpointOutputsDelegations, "delegation outputs", delegationFilterQueryParameters)...)
+	routes = append(routes, outputLookupRoute(api.IndexerEndpointOutputsDelegationByID, "Returns the current output of the delegation."))
+
+	routes = append(routes,
+		&openAPIRoute{
+			method:    http.MethodGet,
+			path:      api.IndexerEndpointMultiAddressByAddress,
+			summary:   "Returns the multi address referenced by the address.",
+			tag:       "addresses",
+			responses: []interface{}{map[string]interface{}{}},
+		},
+		&openAPIRoute{
+			method:    http.MethodGet,
+			path:      apitypes.EndpointBalanceByAddress,
+			summary:   "Returns the aggregated balance of the outputs owned by and unlockable by the address.",
+			tag:       "addresses",
+			responses: []interface{}{&apitypes.BalanceResponse{}},
+		},
+		&openAPIRoute{
+			method:          http.MethodGet,
+			path:            apitypes.EndpointValidators,
+			summary:         "Returns the staking data of the validators and the sum of the delegations pointing to them.",
+			tag:             "validators",
+			queryParameters: []string{apitypes.QueryParameterPageSize, apitypes.QueryParameterCursor},
+			responses:       []interface{}{&apitypes.ValidatorsResponse{}},
+		},
+		&openAPIRoute{
+			method:          http.MethodGet,
+			path:            apitypes.EndpointNativeTokens,
+			summary:         "Returns the native tokens matching the IRC30 metadata filters.",
+			tag:             "native tokens",
+			queryParameters: []string{apitypes.QueryParameterSymbolPrefix, apitypes.QueryParameterNamePrefix, apitypes.QueryParameterPageSize, apitypes.QueryParameterCursor},
+			responses:       []interface{}{&apitypes.NativeTokensResponse{}},
+		},
+		&openAPIRoute{
+			method:    http.MethodGet,
+			path:      apitypes.EndpointNativeTokenByID,
+			summary:   "Returns the IRC30 metadata of the native token.",
+			tag:       "native tokens",
+			responses: []interface{}{&apitypes.NativeTokenResponse{}},
+		},
+		&openAPIRoute{
+			method:          http.MethodGet,
+			path:            apitypes.EndpointNativeTokenHolders,
+			summary:         "Returns the addresses holding the native token with their balances.",
+			tag:             "native tokens",
+			queryParameters: []string{apitypes.QueryParameterPageSize, apitypes.QueryParameterCursor},
+			responses:       []interface{}{&apitypes.NativeTokenHoldersResponse{}},
+		},
+		&openAPIRoute{
+			method:          http.MethodGet,
+			path:            apitypes.EndpointChanges,
+			summary:         "Returns the created and spent outputs in the order they were committed.",
+			tag:             "changes",
+			queryParameters: []string{apitypes.QueryParameterFromSequenceNumber, apitypes.QueryParameterChangeLogID, apitypes.QueryParameterPageSize},
+			responses:       []interface{}{&apitypes.ChangesResponse{}},
+		},
+		&openAPIRoute{
+			method:    http.MethodGet,
+			path:      apitypes.EndpointWebhooks,
+			summary:   "Returns the registered webhooks. Only available if webhooks are enabled and an admin key is configured, which needs to be sent in the X-Admin-Key header.",
+			tag:       "webhooks",
+			responses: []interface{}{&apitypes.WebhooksResponse{}},
+		},
+		&openAPIRoute{
+			method:    http.MethodPost,
+			path:      apitypes.EndpointWebhooks,
+			summary:   "Registers a webhook and returns it together with its secret. Only available if webhooks are enabled and an admin key is configured, which needs to be sent in the X-Admin-Key header.",
+			tag:       "webhooks",
+			request:   &apitypes.WebhookRequest{},
+			status:    http.StatusCreated,
+			responses: []interface{}{&apitypes.WebhookResponse{}},
+		},
+		&openAPIRoute{
+			method:  http.MethodDelete,
+			path:    apitypes.EndpointWebhookByID,
+			summary: "Removes the webhook together with its delivery log. Only available if webhooks are enabled and an admin key is configured, which needs to be sent in the X-Admin-Key header.",
+			tag:     "webhooks",
+			status:  http.StatusNoContent,
+		},
+		&openAPIRoute{
+			method:          http.MethodGet,
+			path:            apitypes.EndpointWebhookDeliveries,
+			summary:         "Returns the latest deliveries of the webhook, newest first. Only available if webhooks are enabled and an admin key is configured, which needs to be sent in the X-Admin-Key header.",
+			tag:             "webhooks",
+			queryParameters: []string{apitypes.QueryParameterPageSize},
+			responses:       []interface{}{&apitypes.WebhookDeliveriesResponse{}},
+		},
+	)
+
+	return routes
+}
+
+// outputListRoutes returns the list, count and events routes of an output list endpoint.
+func outputListRoutes(path string, outputs string, filterQueryParameters []string) []*openAPIRoute {
+	// the events of the subscriptions are not bound to a slot
+	eventFilterQueryParameters := make([]string, 0, len(filterQueryParameters))
+	for _, name := range filterQueryParameters {
+		if name != apitypes.QueryParameterAsOfSlot {
+			eventFilterQueryParameters = append(eventFilterQueryParameters, name)
+		}
+	}
+
+	return []*openAPIRoute{
+		{
+			method:          http.MethodGet,
+			path:            path,
+			summary:         "Returns the " + outputs + " matching the filters.",
+			tag:             "outputs",
+			queryParameters: concatQueryParameters(filterQueryParameters, paginationQueryParameters, []string{apitypes.QueryParameterInclude}),
+			responses:       indexerResponses,
+		},
+		{
+			method:          http.MethodGet,
+			path:            path + apitypes.EndpointSuffixCount,
+			summary:         "Counts the " + outputs + " matching the filters.",
+			tag:             "outputs",
+			queryParameters: filterQueryParameters,
+			responses:       []interface{}{&apitypes.CountResponse{}},
+		},
+		{
+			method:          http.MethodGet,
+			path:            path + apitypes.EndpointSuffixEvents,
+			summary:         "Streams the " + outputs + " matching the filters when they are created or spent.",
+			tag:             "events",
+			queryParameters: eventFilterQueryParameters,
+			responses:       []interface{}{&apitypes.OutputEventResponse{}},
+			events:          true,
+		},
+	}
+}
+
+// outputLookupRoute returns the route of an endpoint returning a single output by its address or ID.
+func outputLookupRoute(path string, summary string) *openAPIRoute {
+	return &openAPIRoute{
+		method:          http.MethodGet,
+		path:            path,
+		summary:         summary,
+		tag:             "outputs",
+		queryParameters: []string{apitypes.QueryParameterAsOfSlot, apitypes.QueryParameterInclude},
+		responses:       indexerResponses,
+	}
+}
+
+func concatQueryParameters(lists ...[]string) []string {
+	var result []string
+	for _, list := range lists {
+		result = append(result, list...)
+	}
+
+	return result
+}
+
+func sortOrderNames() []string {
+	return []string{
+		indexer.SortCreatedAscending.String(),
+		indexer.SortCreatedDescending.String(),
+		indexer.SortAmountAscending.String(),
+		indexer.SortAmountDescending.String(),
+	}
+}
+
+type openAPIDocument struct {
+	OpenAPI    string                                  `json:"openapi"`
+	Info       *openAPIInfo                            `json:"info"`
+	Servers    []*openAPIServer                        `json:"servers"`
+	Paths      map[string]map[string]*openAPIOperation `json:"paths"`
+	Components *openAPIComponents                      `json:"components"`
+}
+
+type openAPIInfo struct {
+	Title   string `json:"title"`
+	Version string `json:"version"`
+}
+
+type openAPIServer struct {
+	URL string `json:"url"`
+}
+
+type openAPIComponents struct {
+	Schemas map[string]*openAPISchema `json:"schemas"`
+}
+
+type openAPIOperation struct {
+	OperationID string                      `json:"operationId"`
+	Summary     string                      `json:"summary"`
+	Tags        []string                    `json:"tags"`
+	Parameters  []*openAPIParameter         `json:"parameters,omitempty"`
+	RequestBody *openAPIRequestBody         `json:"requestBody,omitempty"`
+	Responses   map[string]*openAPIResponse `json:"responses"`
+}
+
+type openAPIParameter struct {
+	Name        string         `json:"name"`
+	In          string         `json:"in"`
+	Description string         `json:"description"`
+	Required    bool           `json:"required,omitempty"`
+	Schema      *openAPISchema `json:"schema"`
+}
+
+type openAPIRequestBody struct {
+	Required bool                         `json:"required"`
+	Content  map[string]*openAPIMediaType `json:"content"`
+}
+
+type openAPIResponse struct {
+	Description string                       `json:"description"`
+	Content     map[string]*openAPIMediaType `json:"content,omitempty"`
+}
+
+type openAPIMediaType struct {
+	Schema *openAPISchema `json:"schema"`
+}
+
+type openAPISchema struct {
+	Ref                  string                    `json:"$ref,omitempty"`
+	Type                 string                    `json:"type,omitempty"`
+	Format               string                    `json:"format,omitempty"`
+	Description          string                    `json:"description,omitempty"`
+	Enum                 []string                  `json:"enum,omitempty"`
+	Items                *openAPISchema            `json:"items,omitempty"`
+	Properties           map[string]*openAPISchema `json:"properties,omitempty"`
+	Required             []string                  `json:"required,omitempty"`
+	AdditionalProperties bool                      `json:"additionalProperties,omitempty"`
+	OneOf                []*openAPISchema          `json:"oneOf,omitempty"`
+}
+
+var (
+	pathParameterRegex = regexp.MustCompile(`{([^}]+)}`)
+
+	bigIntType = reflect.TypeOf(big.Int{})
+)
+
+// OpenAPISpec returns the OpenAPI document of the REST API as indented JSON.
+// The routes are described by the same endpoint and query parameter constants the handlers use,
+// the schemas of the requests and responses are derived from their types.
+func OpenAPISpec(version string) ([]byte, error) {
+	schemas := make(map[string]*openAPISchema)
+	doc := &openAPIDocument{
+		OpenAPI:    OpenAPIVersion,
+		Info:       &openAPIInfo{Title: openAPITitle, Version: version},
+		Servers:    []*openAPIServer{{URL: apitypes.APIRoute}},
+		Paths:      make(map[string]map[string]*openAPIOperation),
+		Components: &openAPIComponents{Schemas: schemas},
+	}
+
+	errorSchema := schemaOfType(reflect.TypeOf(httpserver.HTTPErrorResponseEnvelope{}), schemas)
+
+	for _, route := range openAPIRoutes() {
+		operation := &openAPIOperation{
+			OperationID: operationID(route.method, route.path),
+			Summary:     route.summary,
+			Tags:        []string{route.tag},
+			Responses: map[string]*openAPIResponse{
+				"default": {
+					Description: "The error of the failed call.",
+					Content:     map[string]*openAPIMediaType{echo.MIMEApplicationJSON: {Schema: errorSchema}},
+				},
+			},
+		}
+
+		for _, match := range pathParameterRegex.FindAllStringSubmatch(route.path, -1) {
+			parameter, err := openAPIParameterByName(match[1], "path")
+			if err != nil {
+				return nil, err
+			}
+			operation.Parameters = append(operation.Parameters, parameter)
+		}
+
+		for _, name := range route.queryParameters {
+			parameter, err := openAPIParameterByName(name, "query")
+			if err != nil {
+				return nil, err
+			}
+			operation.Parameters = append(operation.Parameters, parameter)
+		}
+
+		if route.request != nil {
+			operation.RequestBody = &openAPIRequestBody{
+				Required: true,
+				Content:  map[string]*openAPIMediaType{echo.MIMEApplicationJSON: {Schema: schemaOfType(reflect.TypeOf(route.request), schemas)}},
+			}
+		}
+
+		status := route.status
+		if status == 0 {
+			status = http.StatusOK
+		}
+		operation.Responses[strconv.Itoa(status)] = openAPIResponseOf(route, status, schemas)
+
+		if doc.Paths[route.path] == nil {
+			doc.Paths[route.path] = make(map[string]*openAPIOperation)
+		}
+		doc.Paths[route.path][strings.ToLower(route.method)] = operation
+	}
+
+	return json.MarshalIndent(doc, "", "  ")
+}
+
+func openAPIParameterByName(name string, in string) (*openAPIParameter, error) {
+	description, exists := openAPIParameters[name]
+	if !exists {
+		return nil, ierrors.Errorf("no description for %s parameter %s", in, name)
+	}
+
+	return &openAPIParameter{
+		Name:        name,
+		In:          in,
+		Description: description.description,
+		Required:    in == "path",
+		Schema:      description.schema,
+	}, nil
+}
+
+func openAPIResponseOf(route *openAPIRoute, status int, schemas map[string]*openAPISchema) *openAPIResponse {
+	if len(route.responses) == 0 {
+		return &openAPIResponse{Description: http.StatusText(status)}
+	}
+
+	responseSchemas := make([]*openAPISchema, 0, len(route.responses))
+	for _, response := range route.responses {
+		responseSchemas = append(responseSchemas, schemaOfType(reflect.TypeOf(response), schemas))
+	}
+
+	schema := responseSchemas[0]
+	if len(responseSchemas) > 1 {
+		schema = &openAPISchema{OneOf: responseSchemas}
+	}
+
+	if route.events {
+		return &openAPIResponse{
+			Description: "A stream of server-sent events named \"" + apitypes.OutputEventName + "\", the data of each event is encoded as " + strings.TrimPrefix(schema.Ref, "#/components/schemas/") + ".",
+			Content:     map[string]*openAPIMediaType{apitypes.MIMETextEventStream: {Schema: stringSchema}},
+		}
+	}
+
+	return &openAPIResponse{
+		Description: "Successful operation.",
+		Content: map[string]*openAPIMediaType{
+			echo.MIMEApplicationJSON:                  {Schema: schema},
+			api.MIMEApplicationVendorIOTASerializerV2: {Schema: &openAPISchema{Type: "string", Format: "binary"}},
+		},
+	}
+}
+
+// operationID derives a unique ID of the operation from the method and the path, e.g. "getOutputsAccountByBech32Address".
+func operationID(method string, path string) string {
+	var builder strings.Builder
+	builder.WriteString(strings.ToLower(method))
+
+	for _, segment := range strings.FieldsFunc(path, func(r rune) bool { return r == '/' || r == '-' }) {
+		if strings.HasPrefix(segment, "{") {
+			builder.WriteString("By")
+			segment = strings.Trim(segment, "{}")
+		}
+		builder.WriteString(strings.ToUpper(segment[:1]) + segment[1:])
+	}
+
+	return builder.String()
+}
+
+// schemaOfType returns the schema of the JSON encoding of the type.
+// Named structs are added to the schemas and referenced.
+func schemaOfType(t reflect.Type, schemas map[string]*openAPISchema) *openAPISchema {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	switch {
+	case t == bigIntType:
+		return &openAPISchema{Type: "string", Description: "hex encoded uint256"}
+
+	case t.Kind() == reflect.Array && t.Elem().Kind() == reflect.Uint8:
+		return &openAPISchema{Type: "string", Description: "hex encoded " + t.Name()}
+	}
+
+	//nolint:exhaustive // all other kinds are encoded as objects
+	switch t.Kind() {
+	case reflect.Bool:
+		return booleanSchema
+
+	case reflect.String:
+		return stringSchema
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		return &openAPISchema{Type: "integer"}
+
+	case reflect.Int64, reflect.Uint64:
+		// 64 bit numbers are encoded as strings to not lose precision in JavaScript
+		return &openAPISchema{Type: "string", Description: "decimal encoded " + t.Kind().String()}
+
+	case reflect.Slice, reflect.Array:
+		return &openAPISchema{Type: "array", Items: schemaOfType(t.Elem(), schemas)}
+
+	case reflect.Struct:
+		if _, exists := schemas[t.Name()]; !exists {
+			// register the name first to stop the recursion of self-referencing types
+			schemas[t.Name()] = nil
+			schemas[t.Name()] = schemaOfStruct(t, schemas)
+		}
+
+		return &openAPISchema{Ref: "#/components/schemas/" + t.Name()}
+
+	default:
+		// interfaces like outputs or addresses are encoded by their own JSON schema
+		return &openAPISchema{Type: "object", AdditionalProperties: true}
+	}
+}
+
+func schemaOfStruct(t reflect.Type, schemas map[string]*openAPISchema) *openAPISchema {
+	schema := &openAPISchema{Type: "object", Properties: make(map[string]*openAPISchema)}
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		tag, isJSON := field.Tag.Lookup("json")
+		if !isJSON {
+			tag = field.Tag.Get("serix")
+		}
+
+		settings := strings.Split(tag, ",")
+		name := settings[0]
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = serix.FieldKeyString(field.Name)
+			if isJSON {
+				name = field.Name
+			}
+		}
+
+		schema.Properties[name] = schemaOfType(field.Type, schemas)
+
+		optional := false
+		for _, setting := range settings[1:] {
+			if setting == "omitempty" || setting == "optional" {
+				optional = true
+			}
+		}
+		if !optional {
+			schema.Required = append(schema.Required, name)
+		}
+	}
+	sort.Strings(schema.Required)
+
+	return schema
+}
+
+func (s *IndexerServer) openAPISpec(c echo.Context) error {
+	spec, err := OpenAPISpec(s.AppVersion)
+	if err != nil {
+		return ierrors.WithMessagef(echo.ErrInternalServerError, "generating OpenAPI document failed: %s", err)
+	}
+
+	return c.JSONBlob(http.StatusOK, spec)
+}
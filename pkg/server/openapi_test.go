@@ -0,0 +1,104 @@
+package server
+
+import (
+	"encoding/json"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/inx-indexer/pkg/apitypes"
+	"github.com/iotaledger/inx-indexer/pkg/indexer"
+	"github.com/iotaledger/inx-indexer/pkg/webhook"
+)
+
+var echoParameterRegex = regexp.MustCompile(`:([^/]+)`)
+
+// registeredRoutes returns the routes registered by configureRoutes in the format "METHOD /path/{parameter}".
+func registeredRoutes(t *testing.T) []string {
+	e := echo.New()
+
+	// The webhook routes are only registered if webhooks are enabled and an admin key is configured
+	s := &IndexerServer{
+		Webhooks:         &webhook.Manager{},
+		WebhooksAdminKey: "admin",
+	}
+	s.configureRoutes(e.Group(apitypes.APIRoute))
+
+	var routes []string
+	for _, route := range e.Routes() {
+		if route.Method == echo.RouteNotFound {
+			continue
+		}
+
+		path, found := strings.CutPrefix(route.Path, apitypes.APIRoute)
+		require.True(t, found, "route %s is not part of the API", route.Path)
+
+		routes = append(routes, route.Method+" "+echoParameterRegex.ReplaceAllString(path, "{$1}"))
+	}
+	sort.Strings(routes)
+
+	return routes
+}
+
+// documentedRoutes returns the routes of the OpenAPI document in the format "METHOD /path/{parameter}".
+func documentedRoutes(t *testing.T, spec []byte) []string {
+	var doc struct {
+		Paths map[string]map[string]json.RawMessage `json:"paths"`
+	}
+	require.NoError(t, json.Unmarshal(spec, &doc))
+
+	var routes []string
+	for path, operations := range doc.Paths {
+		for method := range operations {
+			routes = append(routes, strings.ToUpper(method)+" "+path)
+		}
+	}
+	sort.Strings(routes)
+
+	return routes
+}
+
+func TestOpenAPISpec_Routes(t *testing.T) {
+	spec, err := OpenAPISpec("test")
+	require.NoError(t, err)
+
+	routes := registeredRoutes(t)
+	require.NotEmpty(t, routes)
+	require.Equal(t, routes, documentedRoutes(t, spec), "the routes of the OpenAPI document don't match the registered routes, update openAPIRoutes")
+}
+
+func TestOpenAPISpec_UpToDate(t *testing.T) {
+	checkedIn, err := os.ReadFile("../../openapi.json")
+	require.NoError(t, err)
+
+	// The version is set by the build, so the document is regenerated with the version of the checked-in one
+	var doc struct {
+		Info struct {
+			Version string `json:"version"`
+		} `json:"info"`
+	}
+	require.NoError(t, json.Unmarshal(checkedIn, &doc))
+
+	spec, err := OpenAPISpec(doc.Info.Version)
+	require.NoError(t, err)
+
+	require.Equal(t, string(checkedIn), string(spec)+"\n", "openapi.json is outdated, regenerate it with scripts/gendoc.sh")
+}
+
+func TestSortOrders(t *testing.T) {
+	for _, sortOrder := range []apitypes.SortOrder{
+		apitypes.SortCreatedAscending,
+		apitypes.SortCreatedDescending,
+		apitypes.SortAmountAscending,
+		apitypes.SortAmountDescending,
+	} {
+		parsed, err := indexer.ParseSortOrder(string(sortOrder))
+		require.NoError(t, err)
+		require.Equal(t, string(sortOrder), parsed.String())
+	}
+}
@@ -0,0 +1,68 @@
+package server
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/iotaledger/inx-indexer/pkg/apitypes"
+	"github.com/iotaledger/inx-indexer/pkg/indexer"
+)
+
+func outputEventResponseFromEvent(event *indexer.OutputEvent) *apitypes.OutputEventResponse {
+	return &apitypes.OutputEventResponse{
+		OutputID:  event.OutputID,
+		Slot:      event.Slot,
+		Spent:     event.Spent,
+		Committed: event.Committed,
+	}
+}
+
+func balanceEntryFromBalance(balance *indexer.Balance) *apitypes.BalanceEntry {
+	nativeTokens := make([]*apitypes.NativeTokenBalance, 0, len(balance.NativeTokens))
+	for nativeTokenID, amount := range balance.NativeTokens {
+		nativeTokens = append(nativeTokens, &apitypes.NativeTokenBalance{
+			ID:     nativeTokenID,
+			Amount: amount,
+		})
+	}
+	sort.Slice(nativeTokens, func(i, j int) bool {
+		return bytes.Compare(nativeTokens[i].ID[:], nativeTokens[j].ID[:]) < 0
+	})
+
+	outputCounts := make([]*apitypes.OutputTypeCount, 0, len(balance.OutputCounts))
+	for outputType, count := range balance.OutputCounts {
+		outputCounts = append(outputCounts, &apitypes.OutputTypeCount{
+			Type:  outputType,
+			Count: count,
+		})
+	}
+	sort.Slice(outputCounts, func(i, j int) bool {
+		return outputCounts[i].Type < outputCounts[j].Type
+	})
+
+	return &apitypes.BalanceEntry{
+		BaseTokens:   balance.BaseTokens,
+		NativeTokens: nativeTokens,
+		OutputCounts: outputCounts,
+	}
+}
+
+func nativeTokenResponseFromMetadata(metadata *indexer.NativeTokenMetadata) *apitypes.NativeTokenResponse {
+	resp := &apitypes.NativeTokenResponse{
+		FoundryID: metadata.FoundryID,
+		Name:      metadata.Name,
+		Symbol:    metadata.Symbol,
+		Decimals:  metadata.Decimals,
+	}
+	if metadata.Description != nil {
+		resp.Description = *metadata.Description
+	}
+	if metadata.URL != nil {
+		resp.URL = *metadata.URL
+	}
+	if metadata.LogoURL != nil {
+		resp.LogoURL = *metadata.LogoURL
+	}
+
+	return resp
+}
@@ -1,25 +1,31 @@
 package server
 
 import (
+	"encoding/hex"
 	"fmt"
 	"net/http"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/labstack/echo/v4"
 
 	"github.com/iotaledger/hive.go/ierrors"
 	"github.com/iotaledger/hive.go/runtime/options"
 	"github.com/iotaledger/inx-app/pkg/httpserver"
+	"github.com/iotaledger/inx-indexer/pkg/apitypes"
+	"github.com/iotaledger/inx-indexer/pkg/auth"
 	"github.com/iotaledger/inx-indexer/pkg/indexer"
 	iotago "github.com/iotaledger/iota.go/v4"
 	"github.com/iotaledger/iota.go/v4/api"
+	"github.com/iotaledger/iota.go/v4/hexutil"
 )
 
 const (
-	MaxTagLength = 64
-
 	isNodeAlmostSyncedThreshold = 2
+
+	// outputEventsKeepAliveInterval is the interval in which a comment is sent on idle event streams, so that proxies do not close them.
+	outputEventsKeepAliveInterval = 30 * time.Second
 )
 
 func (s *IndexerServer) configureRoutes(routeGroup *echo.Group) {
@@ -50,7 +56,16 @@ func (s *IndexerServer) configureRoutes(routeGroup *echo.Group) {
 			return err
 		}
 
-		return httpserver.SendResponseByHeader(c, s.APIProvider.CommittedAPI(), resp)
+		return s.sendIndexerResponse(c, resp)
+	})
+
+	routeGroup.POST(apitypes.EndpointOutputsUnlockableByAddresses, func(c echo.Context) error {
+		resp, err := s.outputsUnlockableByAddresses(c)
+		if err != nil {
+			return err
+		}
+
+		return s.sendIndexerResponse(c, resp)
 	})
 
 	routeGroup.GET(api.IndexerEndpointOutputsBasic, func(c echo.Context) error {
@@ -59,7 +74,7 @@ func (s *IndexerServer) configureRoutes(routeGroup *echo.Group) {
 			return err
 		}
 
-		return httpserver.SendResponseByHeader(c, s.APIProvider.CommittedAPI(), resp)
+		return s.sendIndexerResponse(c, resp)
 	})
 
 	routeGroup.GET(api.IndexerEndpointOutputsAccounts, func(c echo.Context) error {
@@ -68,7 +83,7 @@ func (s *IndexerServer) configureRoutes(routeGroup *echo.Group) {
 			return err
 		}
 
-		return httpserver.SendResponseByHeader(c, s.APIProvider.CommittedAPI(), resp)
+		return s.sendIndexerResponse(c, resp)
 	})
 
 	routeGroup.GET(api.EndpointWithEchoParameters(api.IndexerEndpointOutputsAccountByAddress), func(c echo.Context) error {
@@ -77,7 +92,16 @@ func (s *IndexerServer) configureRoutes(routeGroup *echo.Group) {
 			return err
 		}
 
-		return httpserver.SendResponseByHeader(c, s.APIProvider.CommittedAPI(), resp)
+		return s.sendIndexerResponse(c, resp)
+	})
+
+	routeGroup.GET(api.EndpointWithEchoParameters(apitypes.EndpointAccountsByBlockIssuerKey), func(c echo.Context) error {
+		resp, err := s.accountsByBlockIssuerKey(c)
+		if err != nil {
+			return err
+		}
+
+		return s.sendIndexerResponse(c, resp)
 	})
 
 	routeGroup.GET(api.IndexerEndpointOutputsAnchors, func(c echo.Context) error {
@@ -86,7 +110,7 @@ func (s *IndexerServer) configureRoutes(routeGroup *echo.Group) {
 			return err
 		}
 
-		return httpserver.SendResponseByHeader(c, s.APIProvider.CommittedAPI(), resp)
+		return s.sendIndexerResponse(c, resp)
 	})
 
 	routeGroup.GET(api.EndpointWithEchoParameters(api.IndexerEndpointOutputsAnchorByAddress), func(c echo.Context) error {
@@ -95,7 +119,7 @@ func (s *IndexerServer) configureRoutes(routeGroup *echo.Group) {
 			return err
 		}
 
-		return httpserver.SendResponseByHeader(c, s.APIProvider.CommittedAPI(), resp)
+		return s.sendIndexerResponse(c, resp)
 	})
 
 	routeGroup.GET(api.IndexerEndpointOutputsFoundries, func(c echo.Context) error {
@@ -104,7 +128,7 @@ func (s *IndexerServer) configureRoutes(routeGroup *echo.Group) {
 			return err
 		}
 
-		return httpserver.SendResponseByHeader(c, s.APIProvider.CommittedAPI(), resp)
+		return s.sendIndexerResponse(c, resp)
 	})
 
 	routeGroup.GET(api.EndpointWithEchoParameters(api.IndexerEndpointOutputsFoundryByID), func(c echo.Context) error {
@@ -113,6 +137,15 @@ func (s *IndexerServer) configureRoutes(routeGroup *echo.Group) {
 			return err
 		}
 
+		return s.sendIndexerResponse(c, resp)
+	})
+
+	routeGroup.GET(api.EndpointWithEchoParameters(apitypes.EndpointFoundryTokenSupplyByID), func(c echo.Context) error {
+		resp, err := s.foundryTokenSupplyByID(c)
+		if err != nil {
+			return err
+		}
+
 		return httpserver.SendResponseByHeader(c, s.APIProvider.CommittedAPI(), resp)
 	})
 
@@ -122,7 +155,7 @@ func (s *IndexerServer) configureRoutes(routeGroup *echo.Group) {
 			return err
 		}
 
-		return httpserver.SendResponseByHeader(c, s.APIProvider.CommittedAPI(), resp)
+		return s.sendIndexerResponse(c, resp)
 	})
 
 	routeGroup.GET(api.EndpointWithEchoParameters(api.IndexerEndpointOutputsNFTByAddress), func(c echo.Context) error {
@@ -131,7 +164,7 @@ func (s *IndexerServer) configureRoutes(routeGroup *echo.Group) {
 			return err
 		}
 
-		return httpserver.SendResponseByHeader(c, s.APIProvider.CommittedAPI(), resp)
+		return s.sendIndexerResponse(c, resp)
 	})
 
 	routeGroup.GET(api.IndexerEndpointOutputsDelegations, func(c echo.Context) error {
@@ -140,7 +173,7 @@ func (s *IndexerServer) configureRoutes(routeGroup *echo.Group) {
 			return err
 		}
 
-		return httpserver.SendResponseByHeader(c, s.APIProvider.CommittedAPI(), resp)
+		return s.sendIndexerResponse(c, resp)
 	})
 
 	routeGroup.GET(api.EndpointWithEchoParameters(api.IndexerEndpointOutputsDelegationByID), func(c echo.Context) error {
@@ -149,699 +182,1583 @@ func (s *IndexerServer) configureRoutes(routeGroup *echo.Group) {
 			return err
 		}
 
-		return httpserver.SendResponseByHeader(c, s.APIProvider.CommittedAPI(), resp)
+		return s.sendIndexerResponse(c, resp)
 	})
 
-	routeGroup.GET(api.EndpointWithEchoParameters(api.IndexerEndpointMultiAddressByAddress), s.multiAddressByAddress)
-}
-
-func (s *IndexerServer) combinedOutputsWithFilter(c echo.Context) (*api.IndexerResponse, error) {
-	filters := []options.Option[indexer.CombinedFilterOptions]{indexer.CombinedPageSize(s.pageSizeFromContext(c))}
-
-	if len(c.QueryParam(QueryParameterHasNativeToken)) > 0 {
-		value, err := httpserver.ParseBoolQueryParam(c, QueryParameterHasNativeToken)
+	routeGroup.GET(api.IndexerEndpointOutputs+apitypes.EndpointSuffixCount, func(c echo.Context) error {
+		resp, err := s.combinedOutputsCount(c)
 		if err != nil {
-			return nil, err
+			return err
 		}
-		filters = append(filters, indexer.CombinedHasNativeToken(value))
-	}
 
-	if len(c.QueryParam(QueryParameterNativeToken)) > 0 {
-		value, err := httpserver.ParseHexQueryParam(c, QueryParameterNativeToken, iotago.NativeTokenIDLength)
-		if err != nil {
-			return nil, err
-		}
-		filters = append(filters, indexer.CombinedNativeToken(iotago.NativeTokenID(value)))
-	}
+		return httpserver.SendResponseByHeader(c, s.APIProvider.CommittedAPI(), resp)
+	})
 
-	if len(c.QueryParam(QueryParameterUnlockableByAddress)) > 0 {
-		addr, err := httpserver.ParseBech32AddressQueryParam(c, s.Bech32HRP, QueryParameterUnlockableByAddress)
+	routeGroup.POST(apitypes.EndpointOutputsUnlockableByAddresses+apitypes.EndpointSuffixCount, func(c echo.Context) error {
+		resp, err := s.outputsUnlockableByAddressesCount(c)
 		if err != nil {
-			return nil, err
+			return err
 		}
-		filters = append(filters, indexer.CombinedUnlockableByAddress(addr))
-	}
 
-	if len(c.QueryParam(QueryParameterCursor)) > 0 {
-		cursor, pageSize, err := s.parseCursorQueryParameter(c)
-		if err != nil {
-			return nil, err
-		}
-		filters = append(filters, indexer.CombinedCursor(cursor), indexer.CombinedPageSize(pageSize))
-	}
+		return httpserver.SendResponseByHeader(c, s.APIProvider.CommittedAPI(), resp)
+	})
 
-	if len(c.QueryParam(QueryParameterCreatedBefore)) > 0 {
-		slot, err := httpserver.ParseSlotQueryParam(c, QueryParameterCreatedBefore)
+	routeGroup.GET(api.IndexerEndpointOutputsBasic+apitypes.EndpointSuffixCount, func(c echo.Context) error {
+		resp, err := s.basicOutputsCount(c)
 		if err != nil {
-			return nil, err
+			return err
 		}
-		filters = append(filters, indexer.CombinedCreatedBefore(slot))
-	}
 
-	if len(c.QueryParam(QueryParameterCreatedAfter)) > 0 {
-		slot, err := httpserver.ParseSlotQueryParam(c, QueryParameterCreatedAfter)
+		return httpserver.SendResponseByHeader(c, s.APIProvider.CommittedAPI(), resp)
+	})
+
+	routeGroup.GET(api.IndexerEndpointOutputsAccounts+apitypes.EndpointSuffixCount, func(c echo.Context) error {
+		resp, err := s.accountsCount(c)
 		if err != nil {
-			return nil, err
+			return err
 		}
-		filters = append(filters, indexer.CombinedCreatedAfter(slot))
-	}
-
-	return indexerResponseFromResult(s.Indexer.Combined(filters...))
-}
 
-func (s *IndexerServer) basicOutputsWithFilter(c echo.Context) (*api.IndexerResponse, error) {
-	filters := []options.Option[indexer.BasicFilterOptions]{indexer.BasicPageSize(s.pageSizeFromContext(c))}
+		return httpserver.SendResponseByHeader(c, s.APIProvider.CommittedAPI(), resp)
+	})
 
-	if len(c.QueryParam(QueryParameterHasNativeToken)) > 0 {
-		value, err := httpserver.ParseBoolQueryParam(c, QueryParameterHasNativeToken)
+	routeGroup.GET(api.IndexerEndpointOutputsAnchors+apitypes.EndpointSuffixCount, func(c echo.Context) error {
+		resp, err := s.anchorsCount(c)
 		if err != nil {
-			return nil, err
+			return err
 		}
-		filters = append(filters, indexer.BasicHasNativeToken(value))
-	}
 
-	if len(c.QueryParam(QueryParameterNativeToken)) > 0 {
-		value, err := httpserver.ParseHexQueryParam(c, QueryParameterNativeToken, iotago.NativeTokenIDLength)
-		if err != nil {
-			return nil, err
-		}
-		filters = append(filters, indexer.BasicNativeToken(iotago.NativeTokenID(value)))
-	}
+		return httpserver.SendResponseByHeader(c, s.APIProvider.CommittedAPI(), resp)
+	})
 
-	if len(c.QueryParam(QueryParameterUnlockableByAddress)) > 0 {
-		addr, err := httpserver.ParseBech32AddressQueryParam(c, s.Bech32HRP, QueryParameterUnlockableByAddress)
+	routeGroup.GET(api.IndexerEndpointOutputsFoundries+apitypes.EndpointSuffixCount, func(c echo.Context) error {
+		resp, err := s.foundriesCount(c)
 		if err != nil {
-			return nil, err
+			return err
 		}
-		filters = append(filters, indexer.BasicUnlockableByAddress(addr))
-	}
 
-	if len(c.QueryParam(QueryParameterAddress)) > 0 {
-		addr, err := httpserver.ParseBech32AddressQueryParam(c, s.Bech32HRP, QueryParameterAddress)
+		return httpserver.SendResponseByHeader(c, s.APIProvider.CommittedAPI(), resp)
+	})
+
+	routeGroup.GET(api.IndexerEndpointOutputsNFTs+apitypes.EndpointSuffixCount, func(c echo.Context) error {
+		resp, err := s.nftsCount(c)
 		if err != nil {
-			return nil, err
+			return err
 		}
-		filters = append(filters, indexer.BasicUnlockAddress(addr))
-	}
 
-	if len(c.QueryParam(QueryParameterHasStorageDepositReturn)) > 0 {
-		value, err := httpserver.ParseBoolQueryParam(c, QueryParameterHasStorageDepositReturn)
+		return httpserver.SendResponseByHeader(c, s.APIProvider.CommittedAPI(), resp)
+	})
+
+	routeGroup.GET(api.IndexerEndpointOutputsDelegations+apitypes.EndpointSuffixCount, func(c echo.Context) error {
+		resp, err := s.delegationsCount(c)
 		if err != nil {
-			return nil, err
+			return err
 		}
-		filters = append(filters, indexer.BasicHasStorageDepositReturnCondition(value))
-	}
 
-	if len(c.QueryParam(QueryParameterStorageDepositReturnAddress)) > 0 {
-		addr, err := httpserver.ParseBech32AddressQueryParam(c, s.Bech32HRP, QueryParameterStorageDepositReturnAddress)
+		return httpserver.SendResponseByHeader(c, s.APIProvider.CommittedAPI(), resp)
+	})
+
+	routeGroup.GET(api.IndexerEndpointOutputs+apitypes.EndpointSuffixEvents, func(c echo.Context) error {
+		filters, err := s.combinedOutputFilters(c)
 		if err != nil {
-			return nil, err
+			return err
 		}
-		filters = append(filters, indexer.BasicStorageDepositReturnAddress(addr))
-	}
 
-	if len(c.QueryParam(QueryParameterHasExpiration)) > 0 {
-		value, err := httpserver.ParseBoolQueryParam(c, QueryParameterHasExpiration)
+		return s.streamOutputEvents(c, func() *indexer.OutputSubscription {
+			return s.Indexer.CombinedSubscription(filters...)
+		})
+	})
+
+	routeGroup.GET(api.IndexerEndpointOutputsBasic+apitypes.EndpointSuffixEvents, func(c echo.Context) error {
+		filters, err := s.basicOutputFilters(c)
 		if err != nil {
-			return nil, err
+			return err
 		}
-		filters = append(filters, indexer.BasicHasExpirationCondition(value))
-	}
 
-	if len(c.QueryParam(QueryParameterExpirationReturnAddress)) > 0 {
-		addr, err := httpserver.ParseBech32AddressQueryParam(c, s.Bech32HRP, QueryParameterExpirationReturnAddress)
+		return s.streamOutputEvents(c, func() *indexer.OutputSubscription {
+			return s.Indexer.BasicSubscription(filters...)
+		})
+	})
+
+	routeGroup.GET(api.IndexerEndpointOutputsAccounts+apitypes.EndpointSuffixEvents, func(c echo.Context) error {
+		filters, err := s.accountFilters(c)
 		if err != nil {
-			return nil, err
+			return err
 		}
-		filters = append(filters, indexer.BasicExpirationReturnAddress(addr))
-	}
 
-	if len(c.QueryParam(QueryParameterExpiresBefore)) > 0 {
-		slot, err := httpserver.ParseSlotQueryParam(c, QueryParameterExpiresBefore)
+		return s.streamOutputEvents(c, func() *indexer.OutputSubscription {
+			return s.Indexer.AccountSubscription(filters...)
+		})
+	})
+
+	routeGroup.GET(api.IndexerEndpointOutputsAnchors+apitypes.EndpointSuffixEvents, func(c echo.Context) error {
+		filters, err := s.anchorFilters(c)
 		if err != nil {
-			return nil, err
+			return err
 		}
-		filters = append(filters, indexer.BasicExpiresBefore(slot))
-	}
 
-	if len(c.QueryParam(QueryParameterExpiresAfter)) > 0 {
-		slot, err := httpserver.ParseSlotQueryParam(c, QueryParameterExpiresAfter)
+		return s.streamOutputEvents(c, func() *indexer.OutputSubscription {
+			return s.Indexer.AnchorSubscription(filters...)
+		})
+	})
+
+	routeGroup.GET(api.IndexerEndpointOutputsFoundries+apitypes.EndpointSuffixEvents, func(c echo.Context) error {
+		filters, err := s.foundryFilters(c)
 		if err != nil {
-			return nil, err
+			return err
 		}
-		filters = append(filters, indexer.BasicExpiresAfter(slot))
-	}
 
-	if len(c.QueryParam(QueryParameterHasTimelock)) > 0 {
-		value, err := httpserver.ParseBoolQueryParam(c, QueryParameterHasTimelock)
+		return s.streamOutputEvents(c, func() *indexer.OutputSubscription {
+			return s.Indexer.FoundrySubscription(filters...)
+		})
+	})
+
+	routeGroup.GET(api.IndexerEndpointOutputsNFTs+apitypes.EndpointSuffixEvents, func(c echo.Context) error {
+		filters, err := s.nftFilters(c)
 		if err != nil {
-			return nil, err
+			return err
 		}
-		filters = append(filters, indexer.BasicHasTimelockCondition(value))
-	}
 
-	if len(c.QueryParam(QueryParameterTimelockedBefore)) > 0 {
-		slot, err := httpserver.ParseSlotQueryParam(c, QueryParameterTimelockedBefore)
+		return s.streamOutputEvents(c, func() *indexer.OutputSubscription {
+			return s.Indexer.NFTSubscription(filters...)
+		})
+	})
+
+	routeGroup.GET(api.IndexerEndpointOutputsDelegations+apitypes.EndpointSuffixEvents, func(c echo.Context) error {
+		filters, err := s.delegationFilters(c)
 		if err != nil {
-			return nil, err
+			return err
 		}
-		filters = append(filters, indexer.BasicTimelockedBefore(slot))
-	}
 
-	if len(c.QueryParam(QueryParameterTimelockedAfter)) > 0 {
-		slot, err := httpserver.ParseSlotQueryParam(c, QueryParameterTimelockedAfter)
+		return s.streamOutputEvents(c, func() *indexer.OutputSubscription {
+			return s.Indexer.DelegationSubscription(filters...)
+		})
+	})
+
+	routeGroup.GET(api.EndpointWithEchoParameters(api.IndexerEndpointMultiAddressByAddress), s.multiAddressByAddress)
+
+	routeGroup.GET(api.EndpointWithEchoParameters(apitypes.EndpointBalanceByAddress), func(c echo.Context) error {
+		resp, err := s.balanceByAddress(c)
 		if err != nil {
-			return nil, err
+			return err
 		}
-		filters = append(filters, indexer.BasicTimelockedAfter(slot))
-	}
 
-	if len(c.QueryParam(QueryParameterSender)) > 0 {
-		addr, err := httpserver.ParseBech32AddressQueryParam(c, s.Bech32HRP, QueryParameterSender)
+		return httpserver.SendResponseByHeader(c, s.APIProvider.CommittedAPI(), resp)
+	})
+
+	routeGroup.GET(apitypes.EndpointValidators, func(c echo.Context) error {
+		resp, err := s.validators(c)
 		if err != nil {
-			return nil, err
+			return err
 		}
-		filters = append(filters, indexer.BasicSender(addr))
-	}
 
-	if len(c.QueryParam(QueryParameterTag)) > 0 {
-		tagBytes, err := httpserver.ParseHexQueryParam(c, QueryParameterTag, MaxTagLength)
+		return httpserver.SendResponseByHeader(c, s.APIProvider.CommittedAPI(), resp)
+	})
+
+	routeGroup.GET(apitypes.EndpointNativeTokens, func(c echo.Context) error {
+		resp, err := s.nativeTokensWithFilter(c)
 		if err != nil {
-			return nil, err
+			return err
 		}
-		filters = append(filters, indexer.BasicTag(tagBytes))
-	}
 
-	if len(c.QueryParam(QueryParameterCursor)) > 0 {
-		cursor, pageSize, err := s.parseCursorQueryParameter(c)
+		return httpserver.SendResponseByHeader(c, s.APIProvider.CommittedAPI(), resp)
+	})
+
+	routeGroup.GET(api.EndpointWithEchoParameters(apitypes.EndpointNativeTokenByID), func(c echo.Context) error {
+		resp, err := s.nativeTokenByID(c)
 		if err != nil {
-			return nil, err
+			return err
 		}
-		filters = append(filters, indexer.BasicCursor(cursor), indexer.BasicPageSize(pageSize))
-	}
 
-	if len(c.QueryParam(QueryParameterCreatedBefore)) > 0 {
-		slot, err := httpserver.ParseSlotQueryParam(c, QueryParameterCreatedBefore)
+		return httpserver.SendResponseByHeader(c, s.APIProvider.CommittedAPI(), resp)
+	})
+
+	routeGroup.GET(api.EndpointWithEchoParameters(apitypes.EndpointNativeTokenHolders), func(c echo.Context) error {
+		resp, err := s.nativeTokenHolders(c)
 		if err != nil {
-			return nil, err
+			return err
 		}
-		filters = append(filters, indexer.BasicCreatedBefore(slot))
-	}
 
-	if len(c.QueryParam(QueryParameterCreatedAfter)) > 0 {
-		slot, err := httpserver.ParseSlotQueryParam(c, QueryParameterCreatedAfter)
+		return httpserver.SendResponseByHeader(c, s.APIProvider.CommittedAPI(), resp)
+	})
+
+	routeGroup.GET(apitypes.EndpointChanges, func(c echo.Context) error {
+		resp, err := s.changes(c)
 		if err != nil {
-			return nil, err
+			return err
 		}
-		filters = append(filters, indexer.BasicCreatedAfter(slot))
-	}
 
-	return indexerResponseFromResult(s.Indexer.Basic(filters...))
-}
+		return httpserver.SendResponseByHeader(c, s.APIProvider.CommittedAPI(), resp)
+	})
 
-func (s *IndexerServer) accountByAddress(c echo.Context) (*api.IndexerResponse, error) {
-	address, err := httpserver.ParseBech32AddressParam(c, s.Bech32HRP, api.ParameterBech32Address)
-	if err != nil {
-		return nil, err
+	routeGroup.GET(apitypes.EndpointOpenAPI, s.openAPISpec)
+
+	// The webhook routes only exist if the webhooks are enabled and an admin key is configured
+	if s.Webhooks != nil && s.WebhooksAdminKey != "" {
+		s.configureWebhookRoutes(routeGroup)
 	}
+}
 
-	accountAddress, ok := address.(*iotago.AccountAddress)
-	if !ok {
-		return nil, ierrors.Wrapf(httpserver.ErrInvalidParameter, "invalid address: %s, not an account address", address.String())
+// streamOutputEvents sends the events of the subscription to the client until the client disconnects or the subscription is cancelled.
+func (s *IndexerServer) streamOutputEvents(c echo.Context, subscribe func() *indexer.OutputSubscription) error {
+	clientID := auth.ClientID(c)
+	if err := s.acquireSubscription(clientID); err != nil {
+		return err
 	}
+	defer s.releaseSubscription(clientID)
 
-	return singleOutputResponseFromResult(s.Indexer.AccountByID(accountAddress.AccountID()))
-}
+	subscription := subscribe()
+	defer s.Indexer.Unsubscribe(subscription)
 
-func (s *IndexerServer) accountsWithFilter(c echo.Context) (*api.IndexerResponse, error) {
-	filters := []options.Option[indexer.AccountFilterOptions]{indexer.AccountPageSize(s.pageSizeFromContext(c))}
+	resp := c.Response()
+	resp.Header().Set(echo.HeaderContentType, apitypes.MIMETextEventStream)
+	resp.Header().Set(echo.HeaderCacheControl, "no-cache")
+	resp.Header().Set(echo.HeaderConnection, "keep-alive")
+	resp.WriteHeader(http.StatusOK)
+	resp.Flush()
 
-	if len(c.QueryParam(QueryParameterAddress)) > 0 {
-		addr, err := httpserver.ParseBech32AddressQueryParam(c, s.Bech32HRP, QueryParameterAddress)
-		if err != nil {
-			return nil, err
-		}
-		filters = append(filters, indexer.AccountUnlockAddress(addr))
-	}
+	keepAliveTicker := time.NewTicker(outputEventsKeepAliveInterval)
+	defer keepAliveTicker.Stop()
 
-	if len(c.QueryParam(QueryParameterIssuer)) > 0 {
-		issuer, err := httpserver.ParseBech32AddressQueryParam(c, s.Bech32HRP, QueryParameterIssuer)
-		if err != nil {
-			return nil, err
-		}
-		filters = append(filters, indexer.AccountIssuer(issuer))
-	}
+	for {
+		select {
+		case <-c.Request().Context().Done():
+			return nil
 
-	if len(c.QueryParam(QueryParameterSender)) > 0 {
-		sender, err := httpserver.ParseBech32AddressQueryParam(c, s.Bech32HRP, QueryParameterSender)
-		if err != nil {
-			return nil, err
-		}
-		filters = append(filters, indexer.AccountSender(sender))
-	}
+		case <-keepAliveTicker.C:
+			if _, err := fmt.Fprint(resp, ": keep-alive\n\n"); err != nil {
+				return nil
+			}
 
-	if len(c.QueryParam(QueryParameterCursor)) > 0 {
-		cursor, pageSize, err := s.parseCursorQueryParameter(c)
-		if err != nil {
-			return nil, err
+		case event, ok := <-subscription.Events():
+			if !ok {
+				// The subscription was cancelled, the client needs to reconnect
+				return nil
+			}
+
+			data, err := s.APIProvider.CommittedAPI().JSONEncode(outputEventResponseFromEvent(event))
+			if err != nil {
+				return ierrors.WithMessagef(echo.ErrInternalServerError, "encoding output event failed: %s", err)
+			}
+
+			if _, err := fmt.Fprintf(resp, "event: %s\ndata: %s\n\n", apitypes.OutputEventName, data); err != nil {
+				return nil
+			}
 		}
-		filters = append(filters, indexer.AccountCursor(cursor), indexer.AccountPageSize(pageSize))
+
+		resp.Flush()
 	}
+}
 
-	if len(c.QueryParam(QueryParameterCreatedBefore)) > 0 {
-		slot, err := httpserver.ParseSlotQueryParam(c, QueryParameterCreatedBefore)
-		if err != nil {
-			return nil, err
-		}
-		filters = append(filters, indexer.AccountCreatedBefore(slot))
+// acquireSubscription counts a new subscription of the client, or returns an error if the overall limit or the limit of the client is reached.
+// The limits are checked and the subscription is counted under the same lock, so that concurrent requests can't exceed the limits.
+func (s *IndexerServer) acquireSubscription(clientID string) error {
+	s.clientSubscriptionsMutex.Lock()
+	defer s.clientSubscriptionsMutex.Unlock()
+
+	if s.subscriptionCount >= s.RestAPILimitsMaxSubscriptions {
+		return ierrors.WithMessagef(echo.ErrServiceUnavailable, "maximum number of subscriptions reached: %d", s.RestAPILimitsMaxSubscriptions)
 	}
 
-	if len(c.QueryParam(QueryParameterCreatedAfter)) > 0 {
-		slot, err := httpserver.ParseSlotQueryParam(c, QueryParameterCreatedAfter)
-		if err != nil {
-			return nil, err
-		}
-		filters = append(filters, indexer.AccountCreatedAfter(slot))
+	if s.clientSubscriptions[clientID] >= s.RestAPILimitsMaxSubscriptionsPerClient {
+		return ierrors.WithMessagef(echo.ErrTooManyRequests, "maximum number of subscriptions per client reached: %d", s.RestAPILimitsMaxSubscriptionsPerClient)
 	}
 
-	return indexerResponseFromResult(s.Indexer.Account(filters...))
+	s.subscriptionCount++
+	s.clientSubscriptions[clientID]++
+
+	return nil
 }
 
-func (s *IndexerServer) anchorByAddress(c echo.Context) (*api.IndexerResponse, error) {
-	address, err := httpserver.ParseBech32AddressParam(c, s.Bech32HRP, api.ParameterBech32Address)
-	if err != nil {
-		return nil, err
+func (s *IndexerServer) releaseSubscription(clientID string) {
+	s.clientSubscriptionsMutex.Lock()
+	defer s.clientSubscriptionsMutex.Unlock()
+
+	s.subscriptionCount--
+	s.clientSubscriptions[clientID]--
+	if s.clientSubscriptions[clientID] <= 0 {
+		delete(s.clientSubscriptions, clientID)
 	}
+}
 
-	anchorAddress, ok := address.(*iotago.AnchorAddress)
-	if !ok {
-		return nil, ierrors.Wrapf(httpserver.ErrInvalidParameter, "invalid address: %s, not an anchor address", address.String())
+func (s *IndexerServer) combinedOutputsWithFilter(c echo.Context) (*api.IndexerResponse, error) {
+	filters, err := s.combinedOutputFilters(c)
+	if err != nil {
+		return nil, err
 	}
 
-	return singleOutputResponseFromResult(s.Indexer.AnchorByID(anchorAddress.AnchorID()))
+	return indexerResponseFromResult(s.Indexer.Combined(filters...))
 }
 
-func (s *IndexerServer) anchorsWithFilter(c echo.Context) (*api.IndexerResponse, error) {
-	filters := []options.Option[indexer.AnchorFilterOptions]{indexer.AnchorPageSize(s.pageSizeFromContext(c))}
+func (s *IndexerServer) combinedOutputsCount(c echo.Context) (*apitypes.CountResponse, error) {
+	filters, err := s.combinedOutputFilters(c)
+	if err != nil {
+		return nil, err
+	}
 
-	if len(c.QueryParam(QueryParameterUnlockableByAddress)) > 0 {
-		addr, err := httpserver.ParseBech32AddressQueryParam(c, s.Bech32HRP, QueryParameterUnlockableByAddress)
-		if err != nil {
-			return nil, err
-		}
-		filters = append(filters, indexer.AnchorUnlockableByAddress(addr))
+	return countResponseFromResult(s.Indexer.CombinedCount(filters...))
+}
+
+func (s *IndexerServer) outputsUnlockableByAddresses(c echo.Context) (*api.IndexerResponse, error) {
+	filters, err := s.unlockableByAddressesFilters(c)
+	if err != nil {
+		return nil, err
 	}
 
-	if len(c.QueryParam(QueryParameterStateController)) > 0 {
-		stateController, err := httpserver.ParseBech32AddressQueryParam(c, s.Bech32HRP, QueryParameterStateController)
-		if err != nil {
-			return nil, err
-		}
-		filters = append(filters, indexer.AnchorStateController(stateController))
+	return indexerResponseFromResult(s.Indexer.Combined(filters...))
+}
+
+func (s *IndexerServer) outputsUnlockableByAddressesCount(c echo.Context) (*apitypes.CountResponse, error) {
+	filters, err := s.unlockableByAddressesFilters(c)
+	if err != nil {
+		return nil, err
+	}
+
+	return countResponseFromResult(s.Indexer.CombinedCount(filters...))
+}
+
+func (s *IndexerServer) unlockableByAddressesFilters(c echo.Context) ([]options.Option[indexer.CombinedFilterOptions], error) {
+	addresses, err := s.parseAddressesRequest(c)
+	if err != nil {
+		return nil, err
+	}
+
+	filters, err := s.combinedOutputFilters(c)
+	if err != nil {
+		return nil, err
 	}
 
-	if len(c.QueryParam(QueryParameterGovernor)) > 0 {
-		governor, err := httpserver.ParseBech32AddressQueryParam(c, s.Bech32HRP, QueryParameterGovernor)
+	return append(filters, indexer.CombinedUnlockableByAnyAddress(addresses...)), nil
+}
+
+func (s *IndexerServer) combinedOutputFilters(c echo.Context) ([]options.Option[indexer.CombinedFilterOptions], error) {
+	filters := []options.Option[indexer.CombinedFilterOptions]{indexer.CombinedPageSize(s.pageSizeFromContext(c))}
+
+	if len(c.QueryParam(apitypes.QueryParameterHasNativeToken)) > 0 {
+		value, err := httpserver.ParseBoolQueryParam(c, apitypes.QueryParameterHasNativeToken)
 		if err != nil {
 			return nil, err
 		}
-		filters = append(filters, indexer.AnchorGovernor(governor))
+		filters = append(filters, indexer.CombinedHasNativeToken(value))
 	}
 
-	if len(c.QueryParam(QueryParameterIssuer)) > 0 {
-		issuer, err := httpserver.ParseBech32AddressQueryParam(c, s.Bech32HRP, QueryParameterIssuer)
+	if len(c.QueryParam(apitypes.QueryParameterNativeToken)) > 0 {
+		value, err := httpserver.ParseHexQueryParam(c, apitypes.QueryParameterNativeToken, iotago.NativeTokenIDLength)
 		if err != nil {
 			return nil, err
 		}
-		filters = append(filters, indexer.AnchorIssuer(issuer))
+		filters = append(filters, indexer.CombinedNativeToken(iotago.NativeTokenID(value)))
 	}
 
-	if len(c.QueryParam(QueryParameterSender)) > 0 {
-		sender, err := httpserver.ParseBech32AddressQueryParam(c, s.Bech32HRP, QueryParameterSender)
+	if len(c.QueryParam(apitypes.QueryParameterUnlockableByAddress)) > 0 {
+		addr, err := httpserver.ParseBech32AddressQueryParam(c, s.Bech32HRP, apitypes.QueryParameterUnlockableByAddress)
 		if err != nil {
 			return nil, err
 		}
-		filters = append(filters, indexer.AnchorSender(sender))
+		filters = append(filters, indexer.CombinedUnlockableByAddress(addr))
+	}
+
+	filters, err := appendSortOrderFilter(c, filters, indexer.CombinedSortOrder)
+	if err != nil {
+		return nil, err
 	}
 
-	if len(c.QueryParam(QueryParameterCursor)) > 0 {
+	if len(c.QueryParam(apitypes.QueryParameterCursor)) > 0 {
 		cursor, pageSize, err := s.parseCursorQueryParameter(c)
 		if err != nil {
 			return nil, err
 		}
-		filters = append(filters, indexer.AnchorCursor(cursor), indexer.AnchorPageSize(pageSize))
+		filters = append(filters, indexer.CombinedCursor(cursor), indexer.CombinedPageSize(pageSize))
+	}
+
+	filters, err = appendAmountFilters(c, filters, indexer.CombinedMinAmount, indexer.CombinedMaxAmount)
+	if err != nil {
+		return nil, err
 	}
 
-	if len(c.QueryParam(QueryParameterCreatedBefore)) > 0 {
-		slot, err := httpserver.ParseSlotQueryParam(c, QueryParameterCreatedBefore)
+	if len(c.QueryParam(apitypes.QueryParameterCreatedBefore)) > 0 {
+		slot, err := httpserver.ParseSlotQueryParam(c, apitypes.QueryParameterCreatedBefore)
 		if err != nil {
 			return nil, err
 		}
-		filters = append(filters, indexer.AnchorCreatedBefore(slot))
+		filters = append(filters, indexer.CombinedCreatedBefore(slot))
 	}
 
-	if len(c.QueryParam(QueryParameterCreatedAfter)) > 0 {
-		slot, err := httpserver.ParseSlotQueryParam(c, QueryParameterCreatedAfter)
+	if len(c.QueryParam(apitypes.QueryParameterCreatedAfter)) > 0 {
+		slot, err := httpserver.ParseSlotQueryParam(c, apitypes.QueryParameterCreatedAfter)
 		if err != nil {
 			return nil, err
 		}
-		filters = append(filters, indexer.AnchorCreatedAfter(slot))
+		filters = append(filters, indexer.CombinedCreatedAfter(slot))
 	}
 
-	return indexerResponseFromResult(s.Indexer.Anchor(filters...))
+	filters, err = appendAsOfSlotFilter(c, filters, indexer.CombinedAsOfSlot)
+	if err != nil {
+		return nil, err
+	}
+
+	return filters, nil
 }
 
-func (s *IndexerServer) nftByAddress(c echo.Context) (*api.IndexerResponse, error) {
-	address, err := httpserver.ParseBech32AddressParam(c, s.Bech32HRP, api.ParameterBech32Address)
+func (s *IndexerServer) basicOutputsWithFilter(c echo.Context) (*api.IndexerResponse, error) {
+	filters, err := s.basicOutputFilters(c)
 	if err != nil {
 		return nil, err
 	}
 
-	nftAddress, ok := address.(*iotago.NFTAddress)
-	if !ok {
-		return nil, ierrors.Wrapf(httpserver.ErrInvalidParameter, "invalid address: %s, not an nft address", address.String())
+	return indexerResponseFromResult(s.Indexer.Basic(filters...))
+}
+
+func (s *IndexerServer) basicOutputsCount(c echo.Context) (*apitypes.CountResponse, error) {
+	filters, err := s.basicOutputFilters(c)
+	if err != nil {
+		return nil, err
 	}
 
-	return singleOutputResponseFromResult(s.Indexer.NFTByID(nftAddress.NFTID()))
+	return countResponseFromResult(s.Indexer.BasicCount(filters...))
 }
 
-func (s *IndexerServer) nftsWithFilter(c echo.Context) (*api.IndexerResponse, error) {
-	filters := []options.Option[indexer.NFTFilterOptions]{indexer.NFTPageSize(s.pageSizeFromContext(c))}
+func (s *IndexerServer) basicOutputFilters(c echo.Context) ([]options.Option[indexer.BasicFilterOptions], error) {
+	filters := []options.Option[indexer.BasicFilterOptions]{indexer.BasicPageSize(s.pageSizeFromContext(c))}
 
-	if len(c.QueryParam(QueryParameterUnlockableByAddress)) > 0 {
-		addr, err := httpserver.ParseBech32AddressQueryParam(c, s.Bech32HRP, QueryParameterUnlockableByAddress)
+	if len(c.QueryParam(apitypes.QueryParameterHasNativeToken)) > 0 {
+		value, err := httpserver.ParseBoolQueryParam(c, apitypes.QueryParameterHasNativeToken)
 		if err != nil {
 			return nil, err
 		}
-		filters = append(filters, indexer.NFTUnlockableByAddress(addr))
+		filters = append(filters, indexer.BasicHasNativeToken(value))
 	}
 
-	if len(c.QueryParam(QueryParameterAddress)) > 0 {
-		addr, err := httpserver.ParseBech32AddressQueryParam(c, s.Bech32HRP, QueryParameterAddress)
+	if len(c.QueryParam(apitypes.QueryParameterNativeToken)) > 0 {
+		value, err := httpserver.ParseHexQueryParam(c, apitypes.QueryParameterNativeToken, iotago.NativeTokenIDLength)
 		if err != nil {
 			return nil, err
 		}
-		filters = append(filters, indexer.NFTUnlockAddress(addr))
+		filters = append(filters, indexer.BasicNativeToken(iotago.NativeTokenID(value)))
 	}
 
-	if len(c.QueryParam(QueryParameterHasStorageDepositReturn)) > 0 {
-		value, err := httpserver.ParseBoolQueryParam(c, QueryParameterHasStorageDepositReturn)
+	if len(c.QueryParam(apitypes.QueryParameterUnlockableByAddress)) > 0 {
+		addr, err := httpserver.ParseBech32AddressQueryParam(c, s.Bech32HRP, apitypes.QueryParameterUnlockableByAddress)
 		if err != nil {
 			return nil, err
 		}
-		filters = append(filters, indexer.NFTHasStorageDepositReturnCondition(value))
+		filters = append(filters, indexer.BasicUnlockableByAddress(addr))
 	}
 
-	if len(c.QueryParam(QueryParameterStorageDepositReturnAddress)) > 0 {
-		addr, err := httpserver.ParseBech32AddressQueryParam(c, s.Bech32HRP, QueryParameterStorageDepositReturnAddress)
+	if len(c.QueryParam(apitypes.QueryParameterAddress)) > 0 {
+		addr, err := httpserver.ParseBech32AddressQueryParam(c, s.Bech32HRP, apitypes.QueryParameterAddress)
 		if err != nil {
 			return nil, err
 		}
-		filters = append(filters, indexer.NFTStorageDepositReturnAddress(addr))
+		filters = append(filters, indexer.BasicUnlockAddress(addr))
 	}
 
-	if len(c.QueryParam(QueryParameterHasExpiration)) > 0 {
-		value, err := httpserver.ParseBoolQueryParam(c, QueryParameterHasExpiration)
+	if len(c.QueryParam(apitypes.QueryParameterHasStorageDepositReturn)) > 0 {
+		value, err := httpserver.ParseBoolQueryParam(c, apitypes.QueryParameterHasStorageDepositReturn)
 		if err != nil {
 			return nil, err
 		}
-		filters = append(filters, indexer.NFTHasExpirationCondition(value))
+		filters = append(filters, indexer.BasicHasStorageDepositReturnCondition(value))
 	}
 
-	if len(c.QueryParam(QueryParameterExpirationReturnAddress)) > 0 {
-		addr, err := httpserver.ParseBech32AddressQueryParam(c, s.Bech32HRP, QueryParameterExpirationReturnAddress)
+	if len(c.QueryParam(apitypes.QueryParameterStorageDepositReturnAddress)) > 0 {
+		addr, err := httpserver.ParseBech32AddressQueryParam(c, s.Bech32HRP, apitypes.QueryParameterStorageDepositReturnAddress)
 		if err != nil {
 			return nil, err
 		}
-		filters = append(filters, indexer.NFTExpirationReturnAddress(addr))
+		filters = append(filters, indexer.BasicStorageDepositReturnAddress(addr))
 	}
 
-	if len(c.QueryParam(QueryParameterExpiresBefore)) > 0 {
-		slot, err := httpserver.ParseSlotQueryParam(c, QueryParameterExpiresBefore)
+	if len(c.QueryParam(apitypes.QueryParameterHasExpiration)) > 0 {
+		value, err := httpserver.ParseBoolQueryParam(c, apitypes.QueryParameterHasExpiration)
 		if err != nil {
 			return nil, err
 		}
-		filters = append(filters, indexer.NFTExpiresBefore(slot))
+		filters = append(filters, indexer.BasicHasExpirationCondition(value))
 	}
 
-	if len(c.QueryParam(QueryParameterExpiresAfter)) > 0 {
-		slot, err := httpserver.ParseSlotQueryParam(c, QueryParameterExpiresAfter)
+	if len(c.QueryParam(apitypes.QueryParameterExpirationReturnAddress)) > 0 {
+		addr, err := httpserver.ParseBech32AddressQueryParam(c, s.Bech32HRP, apitypes.QueryParameterExpirationReturnAddress)
 		if err != nil {
 			return nil, err
 		}
-		filters = append(filters, indexer.NFTExpiresAfter(slot))
+		filters = append(filters, indexer.BasicExpirationReturnAddress(addr))
 	}
 
-	if len(c.QueryParam(QueryParameterHasTimelock)) > 0 {
-		value, err := httpserver.ParseBoolQueryParam(c, QueryParameterHasTimelock)
+	if len(c.QueryParam(apitypes.QueryParameterExpiresBefore)) > 0 {
+		slot, err := httpserver.ParseSlotQueryParam(c, apitypes.QueryParameterExpiresBefore)
 		if err != nil {
 			return nil, err
 		}
-		filters = append(filters, indexer.NFTHasTimelockCondition(value))
+		filters = append(filters, indexer.BasicExpiresBefore(slot))
 	}
 
-	if len(c.QueryParam(QueryParameterTimelockedBefore)) > 0 {
-		slot, err := httpserver.ParseSlotQueryParam(c, QueryParameterTimelockedBefore)
+	if len(c.QueryParam(apitypes.QueryParameterExpiresAfter)) > 0 {
+		slot, err := httpserver.ParseSlotQueryParam(c, apitypes.QueryParameterExpiresAfter)
 		if err != nil {
 			return nil, err
 		}
-		filters = append(filters, indexer.NFTTimelockedBefore(slot))
+		filters = append(filters, indexer.BasicExpiresAfter(slot))
 	}
 
-	if len(c.QueryParam(QueryParameterTimelockedAfter)) > 0 {
-		slot, err := httpserver.ParseSlotQueryParam(c, QueryParameterTimelockedAfter)
+	if len(c.QueryParam(apitypes.QueryParameterHasTimelock)) > 0 {
+		value, err := httpserver.ParseBoolQueryParam(c, apitypes.QueryParameterHasTimelock)
 		if err != nil {
 			return nil, err
 		}
-		filters = append(filters, indexer.NFTTimelockedAfter(slot))
+		filters = append(filters, indexer.BasicHasTimelockCondition(value))
 	}
 
-	if len(c.QueryParam(QueryParameterIssuer)) > 0 {
-		addr, err := httpserver.ParseBech32AddressQueryParam(c, s.Bech32HRP, QueryParameterIssuer)
+	if len(c.QueryParam(apitypes.QueryParameterTimelockedBefore)) > 0 {
+		slot, err := httpserver.ParseSlotQueryParam(c, apitypes.QueryParameterTimelockedBefore)
 		if err != nil {
 			return nil, err
 		}
-		filters = append(filters, indexer.NFTIssuer(addr))
+		filters = append(filters, indexer.BasicTimelockedBefore(slot))
 	}
 
-	if len(c.QueryParam(QueryParameterSender)) > 0 {
-		addr, err := httpserver.ParseBech32AddressQueryParam(c, s.Bech32HRP, QueryParameterSender)
+	if len(c.QueryParam(apitypes.QueryParameterTimelockedAfter)) > 0 {
+		slot, err := httpserver.ParseSlotQueryParam(c, apitypes.QueryParameterTimelockedAfter)
 		if err != nil {
 			return nil, err
 		}
-		filters = append(filters, indexer.NFTSender(addr))
+		filters = append(filters, indexer.BasicTimelockedAfter(slot))
 	}
 
-	if len(c.QueryParam(QueryParameterTag)) > 0 {
-		tagBytes, err := httpserver.ParseHexQueryParam(c, QueryParameterTag, MaxTagLength)
+	if len(c.QueryParam(apitypes.QueryParameterSender)) > 0 {
+		addr, err := httpserver.ParseBech32AddressQueryParam(c, s.Bech32HRP, apitypes.QueryParameterSender)
 		if err != nil {
 			return nil, err
 		}
-		filters = append(filters, indexer.NFTTag(tagBytes))
+		filters = append(filters, indexer.BasicSender(addr))
+	}
+
+	if len(c.QueryParam(apitypes.QueryParameterTag)) > 0 {
+		tagBytes, err := httpserver.ParseHexQueryParam(c, apitypes.QueryParameterTag, apitypes.MaxTagLength)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, indexer.BasicTag(tagBytes))
+	}
+
+	filters, err := appendSortOrderFilter(c, filters, indexer.BasicSortOrder)
+	if err != nil {
+		return nil, err
 	}
 
-	if len(c.QueryParam(QueryParameterCursor)) > 0 {
+	if len(c.QueryParam(apitypes.QueryParameterCursor)) > 0 {
 		cursor, pageSize, err := s.parseCursorQueryParameter(c)
 		if err != nil {
 			return nil, err
 		}
-		filters = append(filters, indexer.NFTCursor(cursor), indexer.NFTPageSize(pageSize))
+		filters = append(filters, indexer.BasicCursor(cursor), indexer.BasicPageSize(pageSize))
+	}
+
+	filters, err = appendAmountFilters(c, filters, indexer.BasicMinAmount, indexer.BasicMaxAmount)
+	if err != nil {
+		return nil, err
 	}
 
-	if len(c.QueryParam(QueryParameterCreatedBefore)) > 0 {
-		slot, err := httpserver.ParseSlotQueryParam(c, QueryParameterCreatedBefore)
+	if len(c.QueryParam(apitypes.QueryParameterCreatedBefore)) > 0 {
+		slot, err := httpserver.ParseSlotQueryParam(c, apitypes.QueryParameterCreatedBefore)
 		if err != nil {
 			return nil, err
 		}
-		filters = append(filters, indexer.NFTCreatedBefore(slot))
+		filters = append(filters, indexer.BasicCreatedBefore(slot))
 	}
 
-	if len(c.QueryParam(QueryParameterCreatedAfter)) > 0 {
-		slot, err := httpserver.ParseSlotQueryParam(c, QueryParameterCreatedAfter)
+	if len(c.QueryParam(apitypes.QueryParameterCreatedAfter)) > 0 {
+		slot, err := httpserver.ParseSlotQueryParam(c, apitypes.QueryParameterCreatedAfter)
 		if err != nil {
 			return nil, err
 		}
-		filters = append(filters, indexer.NFTCreatedAfter(slot))
+		filters = append(filters, indexer.BasicCreatedAfter(slot))
 	}
 
-	return indexerResponseFromResult(s.Indexer.NFT(filters...))
+	filters, err = appendAsOfSlotFilter(c, filters, indexer.BasicAsOfSlot)
+	if err != nil {
+		return nil, err
+	}
+
+	return filters, nil
 }
 
-func (s *IndexerServer) foundryByID(c echo.Context) (*api.IndexerResponse, error) {
-	foundryID, err := httpserver.ParseFoundryIDParam(c, api.ParameterFoundryID)
+func (s *IndexerServer) accountByAddress(c echo.Context) (*api.IndexerResponse, error) {
+	address, err := httpserver.ParseBech32AddressParam(c, s.Bech32HRP, api.ParameterBech32Address)
+	if err != nil {
+		return nil, err
+	}
+
+	accountAddress, ok := address.(*iotago.AccountAddress)
+	if !ok {
+		return nil, ierrors.Wrapf(httpserver.ErrInvalidParameter, "invalid address: %s, not an account address", address.String())
+	}
+
+	filters := []options.Option[indexer.AccountFilterOptions]{}
+	filters, err = appendAsOfSlotFilter(c, filters, indexer.AccountAsOfSlot)
 	if err != nil {
 		return nil, err
 	}
 
-	return singleOutputResponseFromResult(s.Indexer.FoundryByID(foundryID))
+	return singleOutputResponseFromResult(s.Indexer.AccountByID(accountAddress.AccountID(), filters...))
 }
 
-func (s *IndexerServer) foundriesWithFilter(c echo.Context) (*api.IndexerResponse, error) {
-	filters := []options.Option[indexer.FoundryFilterOptions]{indexer.FoundryPageSize(s.pageSizeFromContext(c))}
+func (s *IndexerServer) accountsWithFilter(c echo.Context) (*api.IndexerResponse, error) {
+	filters, err := s.accountFilters(c)
+	if err != nil {
+		return nil, err
+	}
+
+	return indexerResponseFromResult(s.Indexer.Account(filters...))
+}
+
+func (s *IndexerServer) accountsCount(c echo.Context) (*apitypes.CountResponse, error) {
+	filters, err := s.accountFilters(c)
+	if err != nil {
+		return nil, err
+	}
+
+	return countResponseFromResult(s.Indexer.AccountCount(filters...))
+}
+
+func (s *IndexerServer) accountFilters(c echo.Context) ([]options.Option[indexer.AccountFilterOptions], error) {
+	filters := []options.Option[indexer.AccountFilterOptions]{indexer.AccountPageSize(s.pageSizeFromContext(c))}
 
-	if len(c.QueryParam(QueryParameterHasNativeToken)) > 0 {
-		value, err := httpserver.ParseBoolQueryParam(c, QueryParameterHasNativeToken)
+	if len(c.QueryParam(apitypes.QueryParameterAddress)) > 0 {
+		addr, err := httpserver.ParseBech32AddressQueryParam(c, s.Bech32HRP, apitypes.QueryParameterAddress)
 		if err != nil {
 			return nil, err
 		}
-		filters = append(filters, indexer.FoundryHasNativeToken(value))
+		filters = append(filters, indexer.AccountUnlockAddress(addr))
 	}
 
-	if len(c.QueryParam(QueryParameterNativeToken)) > 0 {
-		value, err := httpserver.ParseHexQueryParam(c, QueryParameterNativeToken, iotago.NativeTokenIDLength)
+	if len(c.QueryParam(apitypes.QueryParameterIssuer)) > 0 {
+		issuer, err := httpserver.ParseBech32AddressQueryParam(c, s.Bech32HRP, apitypes.QueryParameterIssuer)
 		if err != nil {
 			return nil, err
 		}
-		filters = append(filters, indexer.FoundryNativeToken(iotago.NativeTokenID(value)))
+		filters = append(filters, indexer.AccountIssuer(issuer))
 	}
 
-	if len(c.QueryParam(QueryParameterAccount)) > 0 {
-		addr, err := httpserver.ParseBech32AddressQueryParam(c, s.Bech32HRP, QueryParameterAccount)
+	if len(c.QueryParam(apitypes.QueryParameterSender)) > 0 {
+		sender, err := httpserver.ParseBech32AddressQueryParam(c, s.Bech32HRP, apitypes.QueryParameterSender)
 		if err != nil {
 			return nil, err
 		}
-		if addr.Type() != iotago.AddressAccount {
-			return nil, ierrors.WithMessagef(httpserver.ErrInvalidParameter, "invalid address: %s, not an account address", addr.Bech32(s.Bech32HRP))
-		}
-
-		//nolint:forcetypeassert // we already checked the type
-		filters = append(filters, indexer.FoundryWithAccountAddress(addr.(*iotago.AccountAddress)))
+		filters = append(filters, indexer.AccountSender(sender))
 	}
 
-	if len(c.QueryParam(QueryParameterCursor)) > 0 {
-		cursor, pageSize, err := s.parseCursorQueryParameter(c)
+	if len(c.QueryParam(apitypes.QueryParameterIsBlockIssuer)) > 0 {
+		value, err := httpserver.ParseBoolQueryParam(c, apitypes.QueryParameterIsBlockIssuer)
 		if err != nil {
 			return nil, err
 		}
-		filters = append(filters, indexer.FoundryCursor(cursor), indexer.FoundryPageSize(pageSize))
+		filters = append(filters, indexer.AccountIsBlockIssuer(value))
 	}
 
-	if len(c.QueryParam(QueryParameterCreatedBefore)) > 0 {
-		slot, err := httpserver.ParseSlotQueryParam(c, QueryParameterCreatedBefore)
+	if len(c.QueryParam(apitypes.QueryParameterBlockIssuerExpiresBefore)) > 0 {
+		slot, err := httpserver.ParseSlotQueryParam(c, apitypes.QueryParameterBlockIssuerExpiresBefore)
 		if err != nil {
 			return nil, err
 		}
-		filters = append(filters, indexer.FoundryCreatedBefore(slot))
+		filters = append(filters, indexer.AccountBlockIssuerExpiresBefore(slot))
 	}
 
-	if len(c.QueryParam(QueryParameterCreatedAfter)) > 0 {
-		slot, err := httpserver.ParseSlotQueryParam(c, QueryParameterCreatedAfter)
+	if len(c.QueryParam(apitypes.QueryParameterBlockIssuerExpiresAfter)) > 0 {
+		slot, err := httpserver.ParseSlotQueryParam(c, apitypes.QueryParameterBlockIssuerExpiresAfter)
 		if err != nil {
 			return nil, err
 		}
-		filters = append(filters, indexer.FoundryCreatedAfter(slot))
+		filters = append(filters, indexer.AccountBlockIssuerExpiresAfter(slot))
 	}
 
-	return indexerResponseFromResult(s.Indexer.Foundry(filters...))
-}
-
-func (s *IndexerServer) delegationByID(c echo.Context) (*api.IndexerResponse, error) {
-	delegationID, err := httpserver.ParseDelegationIDParam(c, api.ParameterDelegationID)
+	filters, err := appendSortOrderFilter(c, filters, indexer.AccountSortOrder)
 	if err != nil {
 		return nil, err
 	}
 
-	return singleOutputResponseFromResult(s.Indexer.DelegationByID(delegationID))
-}
-
-func (s *IndexerServer) delegationsWithFilter(c echo.Context) (*api.IndexerResponse, error) {
-	filters := []options.Option[indexer.DelegationFilterOptions]{indexer.DelegationPageSize(s.pageSizeFromContext(c))}
-
-	if len(c.QueryParam(QueryParameterAddress)) > 0 {
-		addr, err := httpserver.ParseBech32AddressQueryParam(c, s.Bech32HRP, QueryParameterAddress)
+	if len(c.QueryParam(apitypes.QueryParameterCursor)) > 0 {
+		cursor, pageSize, err := s.parseCursorQueryParameter(c)
 		if err != nil {
 			return nil, err
 		}
-		filters = append(filters, indexer.DelegationAddress(addr))
+		filters = append(filters, indexer.AccountCursor(cursor), indexer.AccountPageSize(pageSize))
 	}
 
-	if len(c.QueryParam(QueryParameterValidator)) > 0 {
-		addr, err := httpserver.ParseBech32AddressQueryParam(c, s.Bech32HRP, QueryParameterValidator)
-		if err != nil {
-			return nil, err
-		}
-		if addr.Type() != iotago.AddressAccount {
-			return nil, ierrors.WithMessagef(httpserver.ErrInvalidParameter, "invalid address: %s, not an account address", addr.Bech32(s.Bech32HRP))
-		}
-
-		//nolint:forcetypeassert // we already checked the type
-		filters = append(filters, indexer.DelegationValidator(addr.(*iotago.AccountAddress)))
+	filters, err = appendAmountFilters(c, filters, indexer.AccountMinAmount, indexer.AccountMaxAmount)
+	if err != nil {
+		return nil, err
 	}
 
-	if len(c.QueryParam(QueryParameterCursor)) > 0 {
-		cursor, pageSize, err := s.parseCursorQueryParameter(c)
+	if len(c.QueryParam(apitypes.QueryParameterCreatedBefore)) > 0 {
+		slot, err := httpserver.ParseSlotQueryParam(c, apitypes.QueryParameterCreatedBefore)
 		if err != nil {
 			return nil, err
 		}
-		filters = append(filters, indexer.DelegationCursor(cursor), indexer.DelegationPageSize(pageSize))
+		filters = append(filters, indexer.AccountCreatedBefore(slot))
 	}
 
-	if len(c.QueryParam(QueryParameterCreatedBefore)) > 0 {
-		slot, err := httpserver.ParseSlotQueryParam(c, QueryParameterCreatedBefore)
+	if len(c.QueryParam(apitypes.QueryParameterCreatedAfter)) > 0 {
+		slot, err := httpserver.ParseSlotQueryParam(c, apitypes.QueryParameterCreatedAfter)
 		if err != nil {
 			return nil, err
 		}
-		filters = append(filters, indexer.DelegationCreatedBefore(slot))
+		filters = append(filters, indexer.AccountCreatedAfter(slot))
 	}
 
-	if len(c.QueryParam(QueryParameterCreatedAfter)) > 0 {
-		slot, err := httpserver.ParseSlotQueryParam(c, QueryParameterCreatedAfter)
-		if err != nil {
-			return nil, err
-		}
-		filters = append(filters, indexer.DelegationCreatedAfter(slot))
+	filters, err = appendAsOfSlotFilter(c, filters, indexer.AccountAsOfSlot)
+	if err != nil {
+		return nil, err
 	}
 
-	return indexerResponseFromResult(s.Indexer.Delegation(filters...))
+	return filters, nil
 }
 
-func singleOutputResponseFromResult(result *indexer.IndexerResult) (*api.IndexerResponse, error) {
-	if result.Error != nil {
-		return nil, ierrors.WithMessagef(echo.ErrInternalServerError, "reading outputIDs failed: %s", result.Error)
-	}
-	if len(result.OutputIDs) == 0 {
-		return nil, ierrors.WithMessage(echo.ErrNotFound, "record not found")
+func (s *IndexerServer) accountsByBlockIssuerKey(c echo.Context) (*api.IndexerResponse, error) {
+	keyBytes, err := hexutil.DecodeHex(c.Param(apitypes.ParameterBlockIssuerKey))
+	if err != nil {
+		return nil, ierrors.WithMessagef(httpserver.ErrInvalidParameter, "invalid block issuer key: %s", err)
 	}
 
-	return indexerResponseFromResult(result)
-}
-
-func indexerResponseFromResult(result *indexer.IndexerResult) (*api.IndexerResponse, error) {
-	if result.Error != nil {
-		return nil, ierrors.WithMessagef(echo.ErrInternalServerError, "reading outputIDs failed: %s", result.Error)
+	// Deserialize the key to make sure it is valid and in its canonical form
+	key, consumed, err := iotago.BlockIssuerKeyFromBytes(keyBytes)
+	if err != nil || consumed != len(keyBytes) {
+		return nil, ierrors.WithMessagef(httpserver.ErrInvalidParameter, "invalid block issuer key: %s", c.Param(apitypes.ParameterBlockIssuerKey))
 	}
 
-	var cursor string
-	if result.Cursor != nil {
-		// Add the pageSize to the cursor we expose in the API
-		cursor = fmt.Sprintf("%s.%d", *result.Cursor, result.PageSize)
+	serializedKey, err := key.Bytes()
+	if err != nil {
+		return nil, ierrors.WithMessagef(httpserver.ErrInvalidParameter, "invalid block issuer key: %s", err)
 	}
 
-	return &api.IndexerResponse{
-		CommittedSlot: result.CommittedSlot,
-		PageSize:      result.PageSize,
-		Cursor:        cursor,
-		Items:         iotago.HexOutputIDsFromOutputIDs(result.OutputIDs...),
-	}, nil
-}
+	filters := []options.Option[indexer.AccountFilterOptions]{
+		indexer.AccountBlockIssuerKey(serializedKey),
+		indexer.AccountPageSize(s.pageSizeFromContext(c)),
+	}
 
-func (s *IndexerServer) multiAddressByAddress(c echo.Context) error {
-	address, err := httpserver.ParseBech32AddressParam(c, s.Bech32HRP, api.ParameterBech32Address)
+	filters, err = appendSortOrderFilter(c, filters, indexer.AccountSortOrder)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	if multiAddressRef, isMultiRef := address.(*iotago.MultiAddressReference); isMultiRef {
-		multiAddress, err := s.Indexer.MultiAddressForReference(multiAddressRef)
+	if len(c.QueryParam(apitypes.QueryParameterCursor)) > 0 {
+		cursor, pageSize, err := s.parseCursorQueryParameter(c)
 		if err != nil {
-			if ierrors.Is(err, indexer.ErrMultiAddressNotFound) {
-				return echo.ErrNotFound
-			}
-
-			return err
+			return nil, err
 		}
-
-		return httpserver.SendResponseByHeader(c, s.APIProvider.CommittedAPI(), multiAddress)
+		filters = append(filters, indexer.AccountCursor(cursor), indexer.AccountPageSize(pageSize))
+	}
+
+	filters, err = appendAsOfSlotFilter(c, filters, indexer.AccountAsOfSlot)
+	if err != nil {
+		return nil, err
+	}
+
+	return indexerResponseFromResult(s.Indexer.Account(filters...))
+}
+
+func (s *IndexerServer) anchorByAddress(c echo.Context) (*api.IndexerResponse, error) {
+	address, err := httpserver.ParseBech32AddressParam(c, s.Bech32HRP, api.ParameterBech32Address)
+	if err != nil {
+		return nil, err
+	}
+
+	anchorAddress, ok := address.(*iotago.AnchorAddress)
+	if !ok {
+		return nil, ierrors.Wrapf(httpserver.ErrInvalidParameter, "invalid address: %s, not an anchor address", address.String())
+	}
+
+	filters := []options.Option[indexer.AnchorFilterOptions]{}
+	filters, err = appendAsOfSlotFilter(c, filters, indexer.AnchorAsOfSlot)
+	if err != nil {
+		return nil, err
+	}
+
+	return singleOutputResponseFromResult(s.Indexer.AnchorByID(anchorAddress.AnchorID(), filters...))
+}
+
+func (s *IndexerServer) anchorsWithFilter(c echo.Context) (*api.IndexerResponse, error) {
+	filters, err := s.anchorFilters(c)
+	if err != nil {
+		return nil, err
+	}
+
+	return indexerResponseFromResult(s.Indexer.Anchor(filters...))
+}
+
+func (s *IndexerServer) anchorsCount(c echo.Context) (*apitypes.CountResponse, error) {
+	filters, err := s.anchorFilters(c)
+	if err != nil {
+		return nil, err
+	}
+
+	return countResponseFromResult(s.Indexer.AnchorCount(filters...))
+}
+
+func (s *IndexerServer) anchorFilters(c echo.Context) ([]options.Option[indexer.AnchorFilterOptions], error) {
+	filters := []options.Option[indexer.AnchorFilterOptions]{indexer.AnchorPageSize(s.pageSizeFromContext(c))}
+
+	if len(c.QueryParam(apitypes.QueryParameterUnlockableByAddress)) > 0 {
+		addr, err := httpserver.ParseBech32AddressQueryParam(c, s.Bech32HRP, apitypes.QueryParameterUnlockableByAddress)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, indexer.AnchorUnlockableByAddress(addr))
+	}
+
+	if len(c.QueryParam(apitypes.QueryParameterStateController)) > 0 {
+		stateController, err := httpserver.ParseBech32AddressQueryParam(c, s.Bech32HRP, apitypes.QueryParameterStateController)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, indexer.AnchorStateController(stateController))
+	}
+
+	if len(c.QueryParam(apitypes.QueryParameterGovernor)) > 0 {
+		governor, err := httpserver.ParseBech32AddressQueryParam(c, s.Bech32HRP, apitypes.QueryParameterGovernor)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, indexer.AnchorGovernor(governor))
+	}
+
+	if len(c.QueryParam(apitypes.QueryParameterIssuer)) > 0 {
+		issuer, err := httpserver.ParseBech32AddressQueryParam(c, s.Bech32HRP, apitypes.QueryParameterIssuer)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, indexer.AnchorIssuer(issuer))
+	}
+
+	if len(c.QueryParam(apitypes.QueryParameterSender)) > 0 {
+		sender, err := httpserver.ParseBech32AddressQueryParam(c, s.Bech32HRP, apitypes.QueryParameterSender)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, indexer.AnchorSender(sender))
+	}
+
+	filters, err := appendSortOrderFilter(c, filters, indexer.AnchorSortOrder)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(c.QueryParam(apitypes.QueryParameterCursor)) > 0 {
+		cursor, pageSize, err := s.parseCursorQueryParameter(c)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, indexer.AnchorCursor(cursor), indexer.AnchorPageSize(pageSize))
+	}
+
+	filters, err = appendAmountFilters(c, filters, indexer.AnchorMinAmount, indexer.AnchorMaxAmount)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(c.QueryParam(apitypes.QueryParameterCreatedBefore)) > 0 {
+		slot, err := httpserver.ParseSlotQueryParam(c, apitypes.QueryParameterCreatedBefore)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, indexer.AnchorCreatedBefore(slot))
+	}
+
+	if len(c.QueryParam(apitypes.QueryParameterCreatedAfter)) > 0 {
+		slot, err := httpserver.ParseSlotQueryParam(c, apitypes.QueryParameterCreatedAfter)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, indexer.AnchorCreatedAfter(slot))
+	}
+
+	filters, err = appendAsOfSlotFilter(c, filters, indexer.AnchorAsOfSlot)
+	if err != nil {
+		return nil, err
+	}
+
+	return filters, nil
+}
+
+func (s *IndexerServer) nftByAddress(c echo.Context) (*api.IndexerResponse, error) {
+	address, err := httpserver.ParseBech32AddressParam(c, s.Bech32HRP, api.ParameterBech32Address)
+	if err != nil {
+		return nil, err
+	}
+
+	nftAddress, ok := address.(*iotago.NFTAddress)
+	if !ok {
+		return nil, ierrors.Wrapf(httpserver.ErrInvalidParameter, "invalid address: %s, not an nft address", address.String())
+	}
+
+	filters := []options.Option[indexer.NFTFilterOptions]{}
+	filters, err = appendAsOfSlotFilter(c, filters, indexer.NFTAsOfSlot)
+	if err != nil {
+		return nil, err
+	}
+
+	return singleOutputResponseFromResult(s.Indexer.NFTByID(nftAddress.NFTID(), filters...))
+}
+
+func (s *IndexerServer) nftsWithFilter(c echo.Context) (*api.IndexerResponse, error) {
+	filters, err := s.nftFilters(c)
+	if err != nil {
+		return nil, err
+	}
+
+	return indexerResponseFromResult(s.Indexer.NFT(filters...))
+}
+
+func (s *IndexerServer) nftsCount(c echo.Context) (*apitypes.CountResponse, error) {
+	filters, err := s.nftFilters(c)
+	if err != nil {
+		return nil, err
+	}
+
+	return countResponseFromResult(s.Indexer.NFTCount(filters...))
+}
+
+func (s *IndexerServer) nftFilters(c echo.Context) ([]options.Option[indexer.NFTFilterOptions], error) {
+	filters := []options.Option[indexer.NFTFilterOptions]{indexer.NFTPageSize(s.pageSizeFromContext(c))}
+
+	if len(c.QueryParam(apitypes.QueryParameterUnlockableByAddress)) > 0 {
+		addr, err := httpserver.ParseBech32AddressQueryParam(c, s.Bech32HRP, apitypes.QueryParameterUnlockableByAddress)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, indexer.NFTUnlockableByAddress(addr))
+	}
+
+	if len(c.QueryParam(apitypes.QueryParameterAddress)) > 0 {
+		addr, err := httpserver.ParseBech32AddressQueryParam(c, s.Bech32HRP, apitypes.QueryParameterAddress)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, indexer.NFTUnlockAddress(addr))
+	}
+
+	if len(c.QueryParam(apitypes.QueryParameterHasStorageDepositReturn)) > 0 {
+		value, err := httpserver.ParseBoolQueryParam(c, apitypes.QueryParameterHasStorageDepositReturn)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, indexer.NFTHasStorageDepositReturnCondition(value))
+	}
+
+	if len(c.QueryParam(apitypes.QueryParameterStorageDepositReturnAddress)) > 0 {
+		addr, err := httpserver.ParseBech32AddressQueryParam(c, s.Bech32HRP, apitypes.QueryParameterStorageDepositReturnAddress)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, indexer.NFTStorageDepositReturnAddress(addr))
+	}
+
+	if len(c.QueryParam(apitypes.QueryParameterHasExpiration)) > 0 {
+		value, err := httpserver.ParseBoolQueryParam(c, apitypes.QueryParameterHasExpiration)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, indexer.NFTHasExpirationCondition(value))
+	}
+
+	if len(c.QueryParam(apitypes.QueryParameterExpirationReturnAddress)) > 0 {
+		addr, err := httpserver.ParseBech32AddressQueryParam(c, s.Bech32HRP, apitypes.QueryParameterExpirationReturnAddress)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, indexer.NFTExpirationReturnAddress(addr))
+	}
+
+	if len(c.QueryParam(apitypes.QueryParameterExpiresBefore)) > 0 {
+		slot, err := httpserver.ParseSlotQueryParam(c, apitypes.QueryParameterExpiresBefore)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, indexer.NFTExpiresBefore(slot))
+	}
+
+	if len(c.QueryParam(apitypes.QueryParameterExpiresAfter)) > 0 {
+		slot, err := httpserver.ParseSlotQueryParam(c, apitypes.QueryParameterExpiresAfter)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, indexer.NFTExpiresAfter(slot))
+	}
+
+	if len(c.QueryParam(apitypes.QueryParameterHasTimelock)) > 0 {
+		value, err := httpserver.ParseBoolQueryParam(c, apitypes.QueryParameterHasTimelock)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, indexer.NFTHasTimelockCondition(value))
+	}
+
+	if len(c.QueryParam(apitypes.QueryParameterTimelockedBefore)) > 0 {
+		slot, err := httpserver.ParseSlotQueryParam(c, apitypes.QueryParameterTimelockedBefore)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, indexer.NFTTimelockedBefore(slot))
+	}
+
+	if len(c.QueryParam(apitypes.QueryParameterTimelockedAfter)) > 0 {
+		slot, err := httpserver.ParseSlotQueryParam(c, apitypes.QueryParameterTimelockedAfter)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, indexer.NFTTimelockedAfter(slot))
+	}
+
+	if len(c.QueryParam(apitypes.QueryParameterIssuer)) > 0 {
+		addr, err := httpserver.ParseBech32AddressQueryParam(c, s.Bech32HRP, apitypes.QueryParameterIssuer)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, indexer.NFTIssuer(addr))
+	}
+
+	if len(c.QueryParam(apitypes.QueryParameterCollection)) > 0 {
+		value, err := httpserver.ParseHexQueryParam(c, apitypes.QueryParameterCollection, iotago.NFTIDLength)
+		if err != nil {
+			return nil, err
+		}
+		if len(value) != iotago.NFTIDLength {
+			return nil, ierrors.WithMessagef(httpserver.ErrInvalidParameter, "invalid query parameter %s: invalid NFT ID length: %d", apitypes.QueryParameterCollection, len(value))
+		}
+		filters = append(filters, indexer.NFTCollection(iotago.NFTID(value)))
+	}
+
+	if len(c.QueryParam(apitypes.QueryParameterCollectionName)) > 0 {
+		value, err := parseMetadataQueryParam(c, apitypes.QueryParameterCollectionName)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, indexer.NFTCollectionName(value))
+	}
+
+	if len(c.QueryParam(apitypes.QueryParameterMediaType)) > 0 {
+		value, err := parseMetadataQueryParam(c, apitypes.QueryParameterMediaType)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, indexer.NFTMediaType(value))
+	}
+
+	if len(c.QueryParam(apitypes.QueryParameterNamePrefix)) > 0 {
+		value, err := parseMetadataQueryParam(c, apitypes.QueryParameterNamePrefix)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, indexer.NFTNamePrefix(value))
+	}
+
+	if len(c.QueryParam(apitypes.QueryParameterSender)) > 0 {
+		addr, err := httpserver.ParseBech32AddressQueryParam(c, s.Bech32HRP, apitypes.QueryParameterSender)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, indexer.NFTSender(addr))
+	}
+
+	if len(c.QueryParam(apitypes.QueryParameterTag)) > 0 {
+		tagBytes, err := httpserver.ParseHexQueryParam(c, apitypes.QueryParameterTag, apitypes.MaxTagLength)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, indexer.NFTTag(tagBytes))
+	}
+
+	filters, err := appendSortOrderFilter(c, filters, indexer.NFTSortOrder)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(c.QueryParam(apitypes.QueryParameterCursor)) > 0 {
+		cursor, pageSize, err := s.parseCursorQueryParameter(c)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, indexer.NFTCursor(cursor), indexer.NFTPageSize(pageSize))
+	}
+
+	filters, err = appendAmountFilters(c, filters, indexer.NFTMinAmount, indexer.NFTMaxAmount)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(c.QueryParam(apitypes.QueryParameterCreatedBefore)) > 0 {
+		slot, err := httpserver.ParseSlotQueryParam(c, apitypes.QueryParameterCreatedBefore)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, indexer.NFTCreatedBefore(slot))
+	}
+
+	if len(c.QueryParam(apitypes.QueryParameterCreatedAfter)) > 0 {
+		slot, err := httpserver.ParseSlotQueryParam(c, apitypes.QueryParameterCreatedAfter)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, indexer.NFTCreatedAfter(slot))
+	}
+
+	filters, err = appendAsOfSlotFilter(c, filters, indexer.NFTAsOfSlot)
+	if err != nil {
+		return nil, err
+	}
+
+	return filters, nil
+}
+
+func (s *IndexerServer) foundryByID(c echo.Context) (*api.IndexerResponse, error) {
+	foundryID, err := httpserver.ParseFoundryIDParam(c, api.ParameterFoundryID)
+	if err != nil {
+		return nil, err
+	}
+
+	filters := []options.Option[indexer.FoundryFilterOptions]{}
+	filters, err = appendAsOfSlotFilter(c, filters, indexer.FoundryAsOfSlot)
+	if err != nil {
+		return nil, err
+	}
+
+	return singleOutputResponseFromResult(s.Indexer.FoundryByID(foundryID, filters...))
+}
+
+func (s *IndexerServer) foundryTokenSupplyByID(c echo.Context) (*apitypes.FoundryTokenSupplyResponse, error) {
+	foundryID, err := httpserver.ParseFoundryIDParam(c, api.ParameterFoundryID)
+	if err != nil {
+		return nil, err
+	}
+
+	filters := []options.Option[indexer.FoundryFilterOptions]{}
+	filters, err = appendAsOfSlotFilter(c, filters, indexer.FoundryAsOfSlot)
+	if err != nil {
+		return nil, err
+	}
+
+	supply, err := s.Indexer.FoundryTokenSupplyByID(foundryID, filters...)
+	if err != nil {
+		switch {
+		case ierrors.Is(err, indexer.ErrFoundryNotFound):
+			return nil, ierrors.WithMessage(echo.ErrNotFound, "record not found")
+		case ierrors.Is(err, indexer.ErrSlotNotRetained):
+			return nil, ierrors.WithMessagef(httpserver.ErrInvalidParameter, "invalid query parameter %s: %s", apitypes.QueryParameterAsOfSlot, err)
+		default:
+			return nil, ierrors.WithMessagef(echo.ErrInternalServerError, "reading foundry token supply failed: %s", err)
+		}
+	}
+
+	return &apitypes.FoundryTokenSupplyResponse{
+		CommittedSlot:     supply.CommittedSlot,
+		FoundryID:         supply.FoundryID,
+		OutputID:          supply.OutputID,
+		SerialNumber:      supply.SerialNumber,
+		MintedTokens:      supply.MintedTokens,
+		MeltedTokens:      supply.MeltedTokens,
+		MaximumSupply:     supply.MaximumSupply,
+		CirculatingSupply: supply.CirculatingSupply,
+	}, nil
+}
+
+func (s *IndexerServer) foundriesWithFilter(c echo.Context) (*api.IndexerResponse, error) {
+	filters, err := s.foundryFilters(c)
+	if err != nil {
+		return nil, err
+	}
+
+	return indexerResponseFromResult(s.Indexer.Foundry(filters...))
+}
+
+func (s *IndexerServer) foundriesCount(c echo.Context) (*apitypes.CountResponse, error) {
+	filters, err := s.foundryFilters(c)
+	if err != nil {
+		return nil, err
+	}
+
+	return countResponseFromResult(s.Indexer.FoundryCount(filters...))
+}
+
+func (s *IndexerServer) foundryFilters(c echo.Context) ([]options.Option[indexer.FoundryFilterOptions], error) {
+	filters := []options.Option[indexer.FoundryFilterOptions]{indexer.FoundryPageSize(s.pageSizeFromContext(c))}
+
+	if len(c.QueryParam(apitypes.QueryParameterHasNativeToken)) > 0 {
+		value, err := httpserver.ParseBoolQueryParam(c, apitypes.QueryParameterHasNativeToken)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, indexer.FoundryHasNativeToken(value))
+	}
+
+	if len(c.QueryParam(apitypes.QueryParameterNativeToken)) > 0 {
+		value, err := httpserver.ParseHexQueryParam(c, apitypes.QueryParameterNativeToken, iotago.NativeTokenIDLength)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, indexer.FoundryNativeToken(iotago.NativeTokenID(value)))
+	}
+
+	if len(c.QueryParam(apitypes.QueryParameterAccount)) > 0 {
+		addr, err := httpserver.ParseBech32AddressQueryParam(c, s.Bech32HRP, apitypes.QueryParameterAccount)
+		if err != nil {
+			return nil, err
+		}
+		if addr.Type() != iotago.AddressAccount {
+			return nil, ierrors.WithMessagef(httpserver.ErrInvalidParameter, "invalid address: %s, not an account address", addr.Bech32(s.Bech32HRP))
+		}
+
+		//nolint:forcetypeassert // we already checked the type
+		filters = append(filters, indexer.FoundryWithAccountAddress(addr.(*iotago.AccountAddress)))
+	}
+
+	if len(c.QueryParam(apitypes.QueryParameterSerialNumber)) > 0 {
+		value, err := httpserver.ParseUint32QueryParam(c, apitypes.QueryParameterSerialNumber)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, indexer.FoundrySerialNumber(value))
+	}
+
+	if len(c.QueryParam(apitypes.QueryParameterHasMintCapacity)) > 0 {
+		value, err := httpserver.ParseBoolQueryParam(c, apitypes.QueryParameterHasMintCapacity)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, indexer.FoundryHasMintCapacity(value))
+	}
+
+	filters, err := appendSortOrderFilter(c, filters, indexer.FoundrySortOrder)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(c.QueryParam(apitypes.QueryParameterCursor)) > 0 {
+		cursor, pageSize, err := s.parseCursorQueryParameter(c)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, indexer.FoundryCursor(cursor), indexer.FoundryPageSize(pageSize))
+	}
+
+	filters, err = appendAmountFilters(c, filters, indexer.FoundryMinAmount, indexer.FoundryMaxAmount)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(c.QueryParam(apitypes.QueryParameterCreatedBefore)) > 0 {
+		slot, err := httpserver.ParseSlotQueryParam(c, apitypes.QueryParameterCreatedBefore)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, indexer.FoundryCreatedBefore(slot))
+	}
+
+	if len(c.QueryParam(apitypes.QueryParameterCreatedAfter)) > 0 {
+		slot, err := httpserver.ParseSlotQueryParam(c, apitypes.QueryParameterCreatedAfter)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, indexer.FoundryCreatedAfter(slot))
+	}
+
+	filters, err = appendAsOfSlotFilter(c, filters, indexer.FoundryAsOfSlot)
+	if err != nil {
+		return nil, err
+	}
+
+	return filters, nil
+}
+
+func (s *IndexerServer) delegationByID(c echo.Context) (*api.IndexerResponse, error) {
+	delegationID, err := httpserver.ParseDelegationIDParam(c, api.ParameterDelegationID)
+	if err != nil {
+		return nil, err
+	}
+
+	filters := []options.Option[indexer.DelegationFilterOptions]{}
+	filters, err = appendAsOfSlotFilter(c, filters, indexer.DelegationAsOfSlot)
+	if err != nil {
+		return nil, err
+	}
+
+	return singleOutputResponseFromResult(s.Indexer.DelegationByID(delegationID, filters...))
+}
+
+func (s *IndexerServer) delegationsWithFilter(c echo.Context) (*api.IndexerResponse, error) {
+	filters, err := s.delegationFilters(c)
+	if err != nil {
+		return nil, err
+	}
+
+	return indexerResponseFromResult(s.Indexer.Delegation(filters...))
+}
+
+func (s *IndexerServer) delegationsCount(c echo.Context) (*apitypes.CountResponse, error) {
+	filters, err := s.delegationFilters(c)
+	if err != nil {
+		return nil, err
+	}
+
+	return countResponseFromResult(s.Indexer.DelegationCount(filters...))
+}
+
+func (s *IndexerServer) delegationFilters(c echo.Context) ([]options.Option[indexer.DelegationFilterOptions], error) {
+	filters := []options.Option[indexer.DelegationFilterOptions]{indexer.DelegationPageSize(s.pageSizeFromContext(c))}
+
+	if len(c.QueryParam(apitypes.QueryParameterAddress)) > 0 {
+		addr, err := httpserver.ParseBech32AddressQueryParam(c, s.Bech32HRP, apitypes.QueryParameterAddress)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, indexer.DelegationAddress(addr))
+	}
+
+	if len(c.QueryParam(apitypes.QueryParameterValidator)) > 0 {
+		addr, err := httpserver.ParseBech32AddressQueryParam(c, s.Bech32HRP, apitypes.QueryParameterValidator)
+		if err != nil {
+			return nil, err
+		}
+		if addr.Type() != iotago.AddressAccount {
+			return nil, ierrors.WithMessagef(httpserver.ErrInvalidParameter, "invalid address: %s, not an account address", addr.Bech32(s.Bech32HRP))
+		}
+
+		//nolint:forcetypeassert // we already checked the type
+		filters = append(filters, indexer.DelegationValidator(addr.(*iotago.AccountAddress)))
+	}
+
+	if len(c.QueryParam(apitypes.QueryParameterMinDelegatedAmount)) > 0 {
+		amount, err := parseBaseTokenQueryParam(c, apitypes.QueryParameterMinDelegatedAmount)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, indexer.DelegationMinDelegatedAmount(amount))
+	}
+
+	if len(c.QueryParam(apitypes.QueryParameterMaxDelegatedAmount)) > 0 {
+		amount, err := parseBaseTokenQueryParam(c, apitypes.QueryParameterMaxDelegatedAmount)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, indexer.DelegationMaxDelegatedAmount(amount))
+	}
+
+	if len(c.QueryParam(apitypes.QueryParameterStartEpochBefore)) > 0 {
+		epoch, err := httpserver.ParseEpochQueryParam(c, apitypes.QueryParameterStartEpochBefore)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, indexer.DelegationStartEpochBefore(epoch))
+	}
+
+	if len(c.QueryParam(apitypes.QueryParameterStartEpochAfter)) > 0 {
+		epoch, err := httpserver.ParseEpochQueryParam(c, apitypes.QueryParameterStartEpochAfter)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, indexer.DelegationStartEpochAfter(epoch))
+	}
+
+	if len(c.QueryParam(apitypes.QueryParameterHasEndEpoch)) > 0 {
+		value, err := httpserver.ParseBoolQueryParam(c, apitypes.QueryParameterHasEndEpoch)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, indexer.DelegationHasEndEpoch(value))
+	}
+
+	filters, err := appendSortOrderFilter(c, filters, indexer.DelegationSortOrder)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(c.QueryParam(apitypes.QueryParameterCursor)) > 0 {
+		cursor, pageSize, err := s.parseCursorQueryParameter(c)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, indexer.DelegationCursor(cursor), indexer.DelegationPageSize(pageSize))
+	}
+
+	filters, err = appendAmountFilters(c, filters, indexer.DelegationMinAmount, indexer.DelegationMaxAmount)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(c.QueryParam(apitypes.QueryParameterCreatedBefore)) > 0 {
+		slot, err := httpserver.ParseSlotQueryParam(c, apitypes.QueryParameterCreatedBefore)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, indexer.DelegationCreatedBefore(slot))
+	}
+
+	if len(c.QueryParam(apitypes.QueryParameterCreatedAfter)) > 0 {
+		slot, err := httpserver.ParseSlotQueryParam(c, apitypes.QueryParameterCreatedAfter)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, indexer.DelegationCreatedAfter(slot))
+	}
+
+	filters, err = appendAsOfSlotFilter(c, filters, indexer.DelegationAsOfSlot)
+	if err != nil {
+		return nil, err
+	}
+
+	return filters, nil
+}
+
+func errorFromResult(err error) error {
+	if ierrors.Is(err, indexer.ErrSlotNotRetained) {
+		return ierrors.WithMessagef(httpserver.ErrInvalidParameter, "invalid query parameter %s: %s", apitypes.QueryParameterAsOfSlot, err)
+	}
+
+	if ierrors.Is(err, indexer.ErrInvalidCursor) {
+		return ierrors.WithMessagef(httpserver.ErrInvalidParameter, "invalid query parameter %s: %s", apitypes.QueryParameterCursor, err)
+	}
+
+	return ierrors.WithMessagef(echo.ErrInternalServerError, "reading outputIDs failed: %s", err)
+}
+
+func countResponseFromResult(result *indexer.CountResult) (*apitypes.CountResponse, error) {
+	if result.Error != nil {
+		return nil, errorFromResult(result.Error)
+	}
+
+	return &apitypes.CountResponse{
+		CommittedSlot: result.CommittedSlot,
+		Count:         result.Count,
+	}, nil
+}
+
+func singleOutputResponseFromResult(result *indexer.IndexerResult) (*api.IndexerResponse, error) {
+	if result.Error != nil {
+		return nil, errorFromResult(result.Error)
+	}
+	if len(result.OutputIDs) == 0 {
+		return nil, ierrors.WithMessage(echo.ErrNotFound, "record not found")
+	}
+
+	return indexerResponseFromResult(result)
+}
+
+func indexerResponseFromResult(result *indexer.IndexerResult) (*api.IndexerResponse, error) {
+	if result.Error != nil {
+		return nil, errorFromResult(result.Error)
+	}
+
+	var cursor string
+	if result.Cursor != nil {
+		// Add the pageSize to the cursor we expose in the API
+		cursor = fmt.Sprintf("%s.%d", *result.Cursor, result.PageSize)
+	}
+
+	return &api.IndexerResponse{
+		CommittedSlot: result.CommittedSlot,
+		PageSize:      result.PageSize,
+		Cursor:        cursor,
+		Items:         iotago.HexOutputIDsFromOutputIDs(result.OutputIDs...),
+	}, nil
+}
+
+// sendIndexerResponse sends the outputIDs of the response, together with the stored outputs and their metadata if requested by the "include" query parameter.
+func (s *IndexerServer) sendIndexerResponse(c echo.Context, resp *api.IndexerResponse) error {
+	includeOutputs, includeMetadata, err := parseIncludeQueryParam(c)
+	if err != nil {
+		return err
+	}
+
+	if !includeOutputs && !includeMetadata {
+		return httpserver.SendResponseByHeader(c, s.APIProvider.CommittedAPI(), resp)
+	}
+
+	outputIDs, err := resp.Items.OutputIDs()
+	if err != nil {
+		return ierrors.WithMessagef(echo.ErrInternalServerError, "parsing outputIDs failed: %s", err)
+	}
+
+	storedOutputs, err := s.Indexer.StoredOutputs(outputIDs)
+	if err != nil {
+		return ierrors.WithMessagef(echo.ErrInternalServerError, "reading outputs failed: %s", err)
+	}
+
+	outputs := make([]*apitypes.OutputWithMetadataResponse, 0, len(storedOutputs))
+	for _, storedOutput := range storedOutputs {
+		output := &apitypes.OutputWithMetadataResponse{
+			OutputID: storedOutput.OutputID,
+		}
+
+		if storedOutput.Missing {
+			output.Missing = true
+			outputs = append(outputs, output)
+
+			continue
+		}
+
+		if includeOutputs {
+			essenceOutput, ok := storedOutput.Output.(iotago.TxEssenceOutput)
+			if !ok {
+				return ierrors.WithMessagef(echo.ErrInternalServerError, "unexpected output type %T", storedOutput.Output)
+			}
+			output.Output = essenceOutput
+		}
+
+		if includeMetadata {
+			output.Metadata = &apitypes.OutputMetadataResponse{
+				SlotBooked: storedOutput.CreatedAtSlot,
+				Committed:  storedOutput.Committed,
+			}
+		}
+
+		outputs = append(outputs, output)
+	}
+
+	return httpserver.SendResponseByHeader(c, s.APIProvider.CommittedAPI(), &apitypes.OutputsResponse{
+		CommittedSlot: resp.CommittedSlot,
+		PageSize:      resp.PageSize,
+		Items:         resp.Items,
+		Cursor:        resp.Cursor,
+		Outputs:       outputs,
+	})
+}
+
+// parseIncludeQueryParam parses the comma separated list of the data that should be returned in addition to the outputIDs.
+func parseIncludeQueryParam(c echo.Context) (bool, bool, error) {
+	var includeOutputs, includeMetadata bool
+	if len(c.QueryParam(apitypes.QueryParameterInclude)) == 0 {
+		return false, false, nil
+	}
+
+	for _, value := range strings.Split(c.QueryParam(apitypes.QueryParameterInclude), ",") {
+		switch strings.TrimSpace(value) {
+		case apitypes.IncludeOutputs:
+			includeOutputs = true
+		case apitypes.IncludeMetadata:
+			includeMetadata = true
+		default:
+			return false, false, ierrors.WithMessagef(httpserver.ErrInvalidParameter, "invalid query parameter %s: unknown value %s", apitypes.QueryParameterInclude, value)
+		}
+	}
+
+	return includeOutputs, includeMetadata, nil
+}
+
+func (s *IndexerServer) multiAddressByAddress(c echo.Context) error {
+	address, err := httpserver.ParseBech32AddressParam(c, s.Bech32HRP, api.ParameterBech32Address)
+	if err != nil {
+		return err
+	}
+
+	if multiAddressRef, isMultiRef := address.(*iotago.MultiAddressReference); isMultiRef {
+		multiAddress, err := s.Indexer.MultiAddressForReference(multiAddressRef)
+		if err != nil {
+			if ierrors.Is(err, indexer.ErrMultiAddressNotFound) {
+				return echo.ErrNotFound
+			}
+
+			return err
+		}
+
+		return httpserver.SendResponseByHeader(c, s.APIProvider.CommittedAPI(), multiAddress)
 	}
 
 	if restrictedAddress, isRestricted := address.(*iotago.RestrictedAddress); isRestricted {
@@ -865,35 +1782,412 @@ func (s *IndexerServer) multiAddressByAddress(c echo.Context) error {
 	return echo.ErrNotFound
 }
 
+func (s *IndexerServer) balanceByAddress(c echo.Context) (*apitypes.BalanceResponse, error) {
+	address, err := httpserver.ParseBech32AddressParam(c, s.Bech32HRP, api.ParameterBech32Address)
+	if err != nil {
+		return nil, err
+	}
+
+	balance, err := s.Indexer.BalanceForAddress(address)
+	if err != nil {
+		return nil, ierrors.WithMessagef(echo.ErrInternalServerError, "reading balance failed: %s", err)
+	}
+
+	return &apitypes.BalanceResponse{
+		CommittedSlot: balance.CommittedSlot,
+		Owned:         balanceEntryFromBalance(balance.Owned),
+		Unlockable:    balanceEntryFromBalance(balance.Unlockable),
+	}, nil
+}
+
+func (s *IndexerServer) nativeTokenHolders(c echo.Context) (*apitypes.NativeTokenHoldersResponse, error) {
+	nativeTokenID, err := httpserver.ParseFoundryIDParam(c, apitypes.ParameterNativeTokenID)
+	if err != nil {
+		return nil, err
+	}
+
+	filters := []options.Option[indexer.NativeTokenHoldersFilterOptions]{indexer.NativeTokenHoldersPageSize(s.pageSizeFromContext(c))}
+
+	if len(c.QueryParam(apitypes.QueryParameterCursor)) > 0 {
+		cursor, pageSize, err := s.parseHexCursorQueryParameter(c)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, indexer.NativeTokenHoldersCursor(cursor), indexer.NativeTokenHoldersPageSize(pageSize))
+	}
+
+	result := s.Indexer.NativeTokenHolders(nativeTokenID, filters...)
+	if result.Error != nil {
+		return nil, ierrors.WithMessagef(echo.ErrInternalServerError, "reading native token holders failed: %s", result.Error)
+	}
+
+	var cursor string
+	if result.Cursor != nil {
+		// Add the pageSize to the cursor we expose in the API
+		cursor = fmt.Sprintf("%s.%d", *result.Cursor, result.PageSize)
+	}
+
+	holders := make([]*apitypes.NativeTokenHolderResponse, 0, len(result.Holders))
+	for _, holder := range result.Holders {
+		holders = append(holders, &apitypes.NativeTokenHolderResponse{
+			Address: holder.Address.Bech32(s.Bech32HRP),
+			Amount:  holder.Amount,
+		})
+	}
+
+	return &apitypes.NativeTokenHoldersResponse{
+		CommittedSlot: result.CommittedSlot,
+		TotalAmount:   result.TotalAmount,
+		HolderCount:   result.HolderCount,
+		PageSize:      result.PageSize,
+		Cursor:        cursor,
+		Holders:       holders,
+	}, nil
+}
+
+// parseMetadataQueryParam parses a query parameter that is matched against an indexed metadata value.
+func parseMetadataQueryParam(c echo.Context, paramName string) (string, error) {
+	value := c.QueryParam(paramName)
+	if len(value) > indexer.MaxMetadataFieldLength {
+		return "", ierrors.WithMessagef(httpserver.ErrInvalidParameter, "invalid query parameter %s: value exceeds the maximum length of %d", paramName, indexer.MaxMetadataFieldLength)
+	}
+
+	return value, nil
+}
+
+// parseBaseTokenQueryParam parses a query parameter that contains an amount of base tokens.
+func parseBaseTokenQueryParam(c echo.Context, paramName string) (iotago.BaseToken, error) {
+	value, err := strconv.ParseUint(c.QueryParam(paramName), 10, 64)
+	if err != nil {
+		return 0, ierrors.WithMessagef(httpserver.ErrInvalidParameter, "invalid query parameter %s: %s", paramName, err)
+	}
+
+	return iotago.BaseToken(value), nil
+}
+
+func (s *IndexerServer) changes(c echo.Context) (*apitypes.ChangesResponse, error) {
+	filters := []options.Option[indexer.ChangesFilterOptions]{indexer.ChangesPageSize(s.pageSizeFromContext(c))}
+
+	if len(c.QueryParam(apitypes.QueryParameterFromSequenceNumber)) > 0 {
+		sequenceNumber, err := strconv.ParseUint(c.QueryParam(apitypes.QueryParameterFromSequenceNumber), 10, 64)
+		if err != nil {
+			return nil, ierrors.WithMessagef(httpserver.ErrInvalidParameter, "invalid query parameter %s: %s", apitypes.QueryParameterFromSequenceNumber, err)
+		}
+		filters = append(filters, indexer.ChangesFromSequenceNumber(sequenceNumber))
+	}
+
+	if len(c.QueryParam(apitypes.QueryParameterChangeLogID)) > 0 {
+		filters = append(filters, indexer.ChangesChangeLogID(c.QueryParam(apitypes.QueryParameterChangeLogID)))
+	}
+
+	result := s.Indexer.Changes(filters...)
+	if result.Error != nil {
+		// The client needs to start over with the current change log
+		if ierrors.Is(result.Error, indexer.ErrChangeLogIDMismatch) || ierrors.Is(result.Error, indexer.ErrChangeLogPruned) {
+			return nil, ierrors.WithMessagef(echo.ErrGone, "reading change log failed: %s", result.Error)
+		}
+
+		return nil, ierrors.WithMessagef(echo.ErrInternalServerError, "reading change log failed: %s", result.Error)
+	}
+
+	items := make([]*apitypes.ChangeResponse, 0, len(result.Changes))
+	for _, change := range result.Changes {
+		items = append(items, &apitypes.ChangeResponse{
+			SequenceNumber: change.SequenceNumber,
+			Slot:           change.Slot,
+			OutputID:       change.OutputID,
+			OutputType:     change.OutputType,
+			Spent:          change.Spent,
+		})
+	}
+
+	return &apitypes.ChangesResponse{
+		ChangeLogID:        result.ChangeLogID,
+		CommittedSlot:      result.CommittedSlot,
+		PageSize:           result.PageSize,
+		NextSequenceNumber: result.NextSequenceNumber,
+		Items:              items,
+	}, nil
+}
+
+func (s *IndexerServer) nativeTokenByID(c echo.Context) (*apitypes.NativeTokenResponse, error) {
+	nativeTokenID, err := httpserver.ParseFoundryIDParam(c, apitypes.ParameterNativeTokenID)
+	if err != nil {
+		return nil, err
+	}
+
+	metadata, err := s.Indexer.NativeTokenByID(nativeTokenID)
+	if err != nil {
+		if ierrors.Is(err, indexer.ErrNativeTokenNotFound) {
+			return nil, ierrors.WithMessage(echo.ErrNotFound, "record not found")
+		}
+
+		return nil, ierrors.WithMessagef(echo.ErrInternalServerError, "reading native token failed: %s", err)
+	}
+
+	return nativeTokenResponseFromMetadata(metadata), nil
+}
+
+func (s *IndexerServer) nativeTokensWithFilter(c echo.Context) (*apitypes.NativeTokensResponse, error) {
+	filters := []options.Option[indexer.NativeTokenFilterOptions]{indexer.NativeTokenPageSize(s.pageSizeFromContext(c))}
+
+	if len(c.QueryParam(apitypes.QueryParameterSymbolPrefix)) > 0 {
+		value, err := parseMetadataQueryParam(c, apitypes.QueryParameterSymbolPrefix)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, indexer.NativeTokenSymbolPrefix(value))
+	}
+
+	if len(c.QueryParam(apitypes.QueryParameterNamePrefix)) > 0 {
+		value, err := parseMetadataQueryParam(c, apitypes.QueryParameterNamePrefix)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, indexer.NativeTokenNamePrefix(value))
+	}
+
+	if len(c.QueryParam(apitypes.QueryParameterCursor)) > 0 {
+		cursor, pageSize, err := s.parseHexCursorQueryParameter(c)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, indexer.NativeTokenCursor(cursor), indexer.NativeTokenPageSize(pageSize))
+	}
+
+	result := s.Indexer.NativeTokens(filters...)
+	if result.Error != nil {
+		return nil, ierrors.WithMessagef(echo.ErrInternalServerError, "reading native tokens failed: %s", result.Error)
+	}
+
+	var cursor string
+	if result.Cursor != nil {
+		// Add the pageSize to the cursor we expose in the API
+		cursor = fmt.Sprintf("%s.%d", *result.Cursor, result.PageSize)
+	}
+
+	items := make([]*apitypes.NativeTokenResponse, 0, len(result.NativeTokens))
+	for _, metadata := range result.NativeTokens {
+		items = append(items, nativeTokenResponseFromMetadata(metadata))
+	}
+
+	return &apitypes.NativeTokensResponse{
+		CommittedSlot: result.CommittedSlot,
+		PageSize:      result.PageSize,
+		Cursor:        cursor,
+		Items:         items,
+	}, nil
+}
+
+func (s *IndexerServer) validators(c echo.Context) (*apitypes.ValidatorsResponse, error) {
+	filters := []options.Option[indexer.ValidatorFilterOptions]{indexer.ValidatorPageSize(s.pageSizeFromContext(c))}
+
+	if len(c.QueryParam(apitypes.QueryParameterCursor)) > 0 {
+		cursor, pageSize, err := s.parseHexCursorQueryParameter(c)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, indexer.ValidatorCursor(cursor), indexer.ValidatorPageSize(pageSize))
+	}
+
+	result := s.Indexer.Validators(filters...)
+	if result.Error != nil {
+		return nil, ierrors.WithMessagef(echo.ErrInternalServerError, "reading validators failed: %s", result.Error)
+	}
+
+	var cursor string
+	if result.Cursor != nil {
+		// Add the pageSize to the cursor we expose in the API
+		cursor = fmt.Sprintf("%s.%d", *result.Cursor, result.PageSize)
+	}
+
+	items := make([]*apitypes.ValidatorResponse, 0, len(result.Validators))
+	for _, validator := range result.Validators {
+		items = append(items, &apitypes.ValidatorResponse{
+			Address:         validator.AccountID.ToAddress().Bech32(s.Bech32HRP),
+			OutputID:        validator.OutputID,
+			StakedAmount:    validator.StakedAmount,
+			FixedCost:       validator.FixedCost,
+			StartEpoch:      validator.StartEpoch,
+			EndEpoch:        validator.EndEpoch,
+			DelegatedAmount: validator.DelegatedAmount,
+			DelegationCount: validator.DelegationCount,
+		})
+	}
+
+	return &apitypes.ValidatorsResponse{
+		CommittedSlot: result.CommittedSlot,
+		PageSize:      result.PageSize,
+		Cursor:        cursor,
+		Items:         items,
+	}, nil
+}
+
+// parseHexCursorQueryParameter parses a cursor that consists of a hex encoded key (e.g. an address ID) and the page size.
+func (s *IndexerServer) parseHexCursorQueryParameter(c echo.Context) (string, uint32, error) {
+	cursorWithPageSize := c.QueryParam(apitypes.QueryParameterCursor)
+
+	components := strings.Split(cursorWithPageSize, ".")
+	if len(components) != 2 {
+		return "", 0, ierrors.WithMessage(httpserver.ErrInvalidParameter, fmt.Sprintf("query parameter %s has wrong format", apitypes.QueryParameterCursor))
+	}
+
+	if _, err := hex.DecodeString(components[0]); err != nil || len(components[0]) == 0 {
+		return "", 0, ierrors.WithMessage(httpserver.ErrInvalidParameter, fmt.Sprintf("query parameter %s has wrong format", apitypes.QueryParameterCursor))
+	}
+
+	size, err := strconv.ParseUint(components[1], 10, 32)
+	if err != nil {
+		return "", 0, ierrors.WithMessage(httpserver.ErrInvalidParameter, fmt.Sprintf("query parameter %s has wrong format", apitypes.QueryParameterCursor))
+	}
+
+	pageSize := uint32(size)
+	if maxPageSize := s.maxPageSize(c); pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
+
+	return components[0], pageSize, nil
+}
+
 func (s *IndexerServer) parseCursorQueryParameter(c echo.Context) (string, uint32, error) {
-	cursorWithPageSize := c.QueryParam(QueryParameterCursor)
+	cursorWithPageSize := c.QueryParam(apitypes.QueryParameterCursor)
 
 	components := strings.Split(cursorWithPageSize, ".")
 	if len(components) != 2 {
-		return "", 0, ierrors.WithMessage(httpserver.ErrInvalidParameter, fmt.Sprintf("query parameter %s has wrong format", QueryParameterCursor))
+		return "", 0, ierrors.WithMessage(httpserver.ErrInvalidParameter, fmt.Sprintf("query parameter %s has wrong format", apitypes.QueryParameterCursor))
+	}
+
+	// Cursors of a non-default sort order are prefixed with the name of the sort order
+	cursorKey := components[0]
+	if idx := strings.LastIndex(cursorKey, ":"); idx >= 0 {
+		cursorKey = cursorKey[idx+1:]
 	}
 
-	if len(components[0]) != indexer.CursorLength {
-		return "", 0, ierrors.WithMessage(httpserver.ErrInvalidParameter, fmt.Sprintf("query parameter %s has wrong format", QueryParameterCursor))
+	if len(cursorKey) != indexer.CursorLength {
+		return "", 0, ierrors.WithMessage(httpserver.ErrInvalidParameter, fmt.Sprintf("query parameter %s has wrong format", apitypes.QueryParameterCursor))
 	}
 
 	size, err := strconv.ParseUint(components[1], 10, 32)
 	if err != nil {
-		return "", 0, ierrors.WithMessage(httpserver.ErrInvalidParameter, fmt.Sprintf("query parameter %s has wrong format", QueryParameterCursor))
+		return "", 0, ierrors.WithMessage(httpserver.ErrInvalidParameter, fmt.Sprintf("query parameter %s has wrong format", apitypes.QueryParameterCursor))
 	}
 
 	pageSize := uint32(size)
-	if pageSize > uint32(s.RestAPILimitsMaxResults) {
-		pageSize = uint32(s.RestAPILimitsMaxResults)
+	if maxPageSize := s.maxPageSize(c); pageSize > maxPageSize {
+		pageSize = maxPageSize
 	}
 
 	return components[0], pageSize, nil
 }
 
+// appendAsOfSlotFilter appends the filter for the slot of the asOfSlot query parameter, if it is given.
+func appendAsOfSlotFilter[T any](c echo.Context, filters []options.Option[T], asOfSlotFilter func(iotago.SlotIndex) options.Option[T]) ([]options.Option[T], error) {
+	if len(c.QueryParam(apitypes.QueryParameterAsOfSlot)) == 0 {
+		return filters, nil
+	}
+
+	slot, err := httpserver.ParseSlotQueryParam(c, apitypes.QueryParameterAsOfSlot)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(filters, asOfSlotFilter(slot)), nil
+}
+
+// appendAmountFilters appends the filters for the minAmount and maxAmount query parameters, if they are given.
+func appendAmountFilters[T any](c echo.Context, filters []options.Option[T], minAmountFilter func(iotago.BaseToken) options.Option[T], maxAmountFilter func(iotago.BaseToken) options.Option[T]) ([]options.Option[T], error) {
+	if len(c.QueryParam(apitypes.QueryParameterMinAmount)) > 0 {
+		amount, err := parseBaseTokenQueryParam(c, apitypes.QueryParameterMinAmount)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, minAmountFilter(amount))
+	}
+
+	if len(c.QueryParam(apitypes.QueryParameterMaxAmount)) > 0 {
+		amount, err := parseBaseTokenQueryParam(c, apitypes.QueryParameterMaxAmount)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, maxAmountFilter(amount))
+	}
+
+	return filters, nil
+}
+
+// appendSortOrderFilter appends the filter for the sort order of the sort query parameter, if it is given.
+func appendSortOrderFilter[T any](c echo.Context, filters []options.Option[T], sortOrderFilter func(indexer.SortOrder) options.Option[T]) ([]options.Option[T], error) {
+	if len(c.QueryParam(apitypes.QueryParameterSort)) == 0 {
+		return filters, nil
+	}
+
+	sortOrder, err := parseSortQueryParam(c)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(filters, sortOrderFilter(sortOrder)), nil
+}
+
+func parseSortQueryParam(c echo.Context) (indexer.SortOrder, error) {
+	sortOrder, err := indexer.ParseSortOrder(c.QueryParam(apitypes.QueryParameterSort))
+	if err != nil {
+		return 0, ierrors.WithMessagef(httpserver.ErrInvalidParameter, "invalid query parameter %s: %s", apitypes.QueryParameterSort, err)
+	}
+
+	return sortOrder, nil
+}
+
+// parseAddressesRequest parses the bech32 addresses of an apitypes.AddressesRequest in the request body.
+func (s *IndexerServer) parseAddressesRequest(c echo.Context) ([]iotago.Address, error) {
+	// Bech32 addresses only exist in the JSON representation, so there is no binary request format
+	if _, err := httpserver.GetRequestContentType(c, echo.MIMEApplicationJSON); err != nil {
+		return nil, err
+	}
+
+	request, err := httpserver.ParseRequestByHeader[*apitypes.AddressesRequest](c, s.APIProvider.CommittedAPI(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(request.Addresses) == 0 {
+		return nil, ierrors.WithMessage(httpserver.ErrInvalidParameter, "no addresses given")
+	}
+
+	if len(request.Addresses) > s.RestAPILimitsMaxAddresses {
+		return nil, ierrors.WithMessagef(httpserver.ErrInvalidParameter, "too many addresses given: %d, maximum: %d", len(request.Addresses), s.RestAPILimitsMaxAddresses)
+	}
+
+	addresses := make([]iotago.Address, 0, len(request.Addresses))
+	for _, bech32Address := range request.Addresses {
+		hrp, address, err := iotago.ParseBech32(strings.ToLower(bech32Address))
+		if err != nil {
+			return nil, ierrors.WithMessagef(httpserver.ErrInvalidParameter, "invalid address: %s, error: %s", bech32Address, err)
+		}
+
+		if hrp != s.Bech32HRP {
+			return nil, ierrors.WithMessagef(httpserver.ErrInvalidParameter, "invalid bech32 address, expected prefix: %s", s.Bech32HRP)
+		}
+
+		addresses = append(addresses, address)
+	}
+
+	return addresses, nil
+}
+
+// maxPageSize returns the maximum page size of the client of the request, which defaults to the configured limit.
+func (s *IndexerServer) maxPageSize(c echo.Context) uint32 {
+	if maxPageSize := auth.MaxPageSize(c); maxPageSize > 0 {
+		return uint32(maxPageSize)
+	}
+
+	return uint32(s.RestAPILimitsMaxResults)
+}
+
 func (s *IndexerServer) pageSizeFromContext(c echo.Context) uint32 {
-	maxPageSize := uint32(s.RestAPILimitsMaxResults)
-	if len(c.QueryParam(QueryParameterPageSize)) > 0 {
-		pageSizeQueryParam, err := httpserver.ParseUint32QueryParam(c, QueryParameterPageSize, maxPageSize)
+	maxPageSize := s.maxPageSize(c)
+	if len(c.QueryParam(apitypes.QueryParameterPageSize)) > 0 {
+		pageSizeQueryParam, err := httpserver.ParseUint32QueryParam(c, apitypes.QueryParameterPageSize, maxPageSize)
 		if err != nil {
 			return maxPageSize
 		}
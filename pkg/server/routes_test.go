@@ -0,0 +1,45 @@
+package server
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIndexerServer_AcquireSubscription(t *testing.T) {
+	s := &IndexerServer{
+		RestAPILimitsMaxSubscriptions:          10,
+		RestAPILimitsMaxSubscriptionsPerClient: 2,
+		clientSubscriptions:                    make(map[string]int),
+	}
+
+	// concurrent requests can't exceed the overall limit or the limit per client
+	var acquired atomic.Int32
+	var wg sync.WaitGroup
+	for i := range 100 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			if s.acquireSubscription(fmt.Sprintf("client-%d", i%20)) == nil {
+				acquired.Add(1)
+			}
+		}()
+	}
+	wg.Wait()
+
+	require.EqualValues(t, 10, acquired.Load())
+	var subscribedClientID string
+	for clientID, count := range s.clientSubscriptions {
+		require.LessOrEqual(t, count, 2)
+		subscribedClientID = clientID
+	}
+
+	// a released subscription can be acquired again
+	require.Error(t, s.acquireSubscription(subscribedClientID))
+	s.releaseSubscription(subscribedClientID)
+	require.NoError(t, s.acquireSubscription(subscribedClientID))
+}
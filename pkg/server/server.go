@@ -1,35 +1,58 @@
 package server
 
 import (
+	"sync"
+
 	"github.com/labstack/echo/v4"
 
 	"github.com/iotaledger/inx-app/pkg/nodebridge"
+	"github.com/iotaledger/inx-indexer/pkg/apitypes"
 	"github.com/iotaledger/inx-indexer/pkg/indexer"
+	"github.com/iotaledger/inx-indexer/pkg/webhook"
 	iotago "github.com/iotaledger/iota.go/v4"
 )
 
-const (
-	APIRoute = "/api/indexer/v2"
-)
-
 type IndexerServer struct {
-	Indexer                 *indexer.Indexer
-	NodeBridge              nodebridge.NodeBridge
-	RestAPILimitsMaxResults int
+	Indexer                       *indexer.Indexer
+	Webhooks                      *webhook.Manager
+	NodeBridge                    nodebridge.NodeBridge
+	RestAPILimitsMaxResults       int
+	RestAPILimitsMaxAddresses     int
+	RestAPILimitsMaxSubscriptions int
+	// RestAPILimitsMaxSubscriptionsPerClient limits the subscriptions of a single client, so that one client can't use up all subscriptions.
+	RestAPILimitsMaxSubscriptionsPerClient int
+	// WebhooksAdminKey is the key needed to manage the webhooks, the webhook routes are disabled if it is empty.
+	WebhooksAdminKey string
+	AppVersion       string
+
+	// subscriptionCount counts the active subscriptions of all clients and clientSubscriptions counts them per client ID.
+	subscriptionCount        int
+	clientSubscriptions      map[string]int
+	clientSubscriptionsMutex sync.Mutex
 
 	APIProvider iotago.APIProvider
 	Bech32HRP   iotago.NetworkPrefix
 }
 
-func NewIndexerServer(indexer *indexer.Indexer, echo *echo.Echo, nodeBridge nodebridge.NodeBridge, maxPageSize int) *IndexerServer {
+func NewIndexerServer(indexer *indexer.Indexer, webhooks *webhook.Manager, echo *echo.Echo, nodeBridge nodebridge.NodeBridge, appVersion string, maxPageSize int, maxAddresses int, maxSubscriptions int, maxSubscriptionsPerClient int, webhooksAdminKey string) *IndexerServer {
 	s := &IndexerServer{
-		Indexer:                 indexer,
-		NodeBridge:              nodeBridge,
-		RestAPILimitsMaxResults: maxPageSize,
-		APIProvider:             nodeBridge.APIProvider(),
-		Bech32HRP:               nodeBridge.APIProvider().CommittedAPI().ProtocolParameters().Bech32HRP(),
+		Indexer:                                indexer,
+		Webhooks:                               webhooks,
+		NodeBridge:                             nodeBridge,
+		RestAPILimitsMaxResults:                maxPageSize,
+		RestAPILimitsMaxAddresses:              maxAddresses,
+		RestAPILimitsMaxSubscriptions:          maxSubscriptions,
+		RestAPILimitsMaxSubscriptionsPerClient: maxSubscriptionsPerClient,
+		WebhooksAdminKey:                       webhooksAdminKey,
+		AppVersion:                             appVersion,
+		clientSubscriptions:                    make(map[string]int),
+		APIProvider:                            nodeBridge.APIProvider(),
+		Bech32HRP:                              nodeBridge.APIProvider().CommittedAPI().ProtocolParameters().Bech32HRP(),
 	}
-	s.configureRoutes(echo.Group(APIRoute))
+	s.configureRoutes(echo.Group(apitypes.APIRoute))
+
+	// End the event streams on shutdown, otherwise the server waits for the clients to disconnect
+	echo.Server.RegisterOnShutdown(indexer.UnsubscribeAll)
 
 	return s
 }
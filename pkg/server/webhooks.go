@@ -0,0 +1,196 @@
+package server
+
+import (
+	"crypto/subtle"
+	"net/http"
+	"net/url"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/hive.go/runtime/options"
+	"github.com/iotaledger/inx-app/pkg/httpserver"
+	"github.com/iotaledger/inx-indexer/pkg/apitypes"
+	"github.com/iotaledger/inx-indexer/pkg/indexer"
+	"github.com/iotaledger/inx-indexer/pkg/webhook"
+	iotago "github.com/iotaledger/iota.go/v4"
+	"github.com/iotaledger/iota.go/v4/api"
+)
+
+// ParseCombinedOutputFilters parses the filters of the combined outputs endpoint from encoded query parameters, e.g. the filters of webhooks.
+// The pagination query parameters are ignored.
+func ParseCombinedOutputFilters(bech32HRP iotago.NetworkPrefix, filter string) ([]options.Option[indexer.CombinedFilterOptions], error) {
+	if _, err := url.ParseQuery(filter); err != nil {
+		return nil, ierrors.WithMessagef(httpserver.ErrInvalidParameter, "invalid filter: %s", err)
+	}
+
+	s := &IndexerServer{Bech32HRP: bech32HRP}
+	c := echo.New().NewContext(&http.Request{URL: &url.URL{RawQuery: filter}}, nil)
+
+	return s.combinedOutputFilters(c)
+}
+
+func (s *IndexerServer) configureWebhookRoutes(routeGroup *echo.Group) {
+	routeGroup.GET(apitypes.EndpointWebhooks, func(c echo.Context) error {
+		resp, err := s.webhooks()
+		if err != nil {
+			return err
+		}
+
+		return httpserver.SendResponseByHeader(c, s.APIProvider.CommittedAPI(), resp)
+	}, s.requireAdminKey)
+
+	routeGroup.POST(apitypes.EndpointWebhooks, func(c echo.Context) error {
+		resp, err := s.addWebhook(c)
+		if err != nil {
+			return err
+		}
+
+		return httpserver.SendResponseByHeader(c, s.APIProvider.CommittedAPI(), resp, http.StatusCreated)
+	}, s.requireAdminKey)
+
+	routeGroup.DELETE(api.EndpointWithEchoParameters(apitypes.EndpointWebhookByID), func(c echo.Context) error {
+		watchID, err := parseWebhookIDParam(c)
+		if err != nil {
+			return err
+		}
+
+		if err := s.Webhooks.DeleteWatch(watchID); err != nil {
+			return webhookError(err)
+		}
+
+		return c.NoContent(http.StatusNoContent)
+	}, s.requireAdminKey)
+
+	routeGroup.GET(api.EndpointWithEchoParameters(apitypes.EndpointWebhookDeliveries), func(c echo.Context) error {
+		resp, err := s.webhookDeliveries(c)
+		if err != nil {
+			return err
+		}
+
+		return httpserver.SendResponseByHeader(c, s.APIProvider.CommittedAPI(), resp)
+	}, s.requireAdminKey)
+}
+
+// requireAdminKey only passes the requests that contain the admin key in the apitypes.HeaderAdminKey header.
+// The watches are shared by all clients and trigger requests of the indexer, so they can't be managed by every client of the REST API.
+func (s *IndexerServer) requireAdminKey(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		if subtle.ConstantTimeCompare([]byte(c.Request().Header.Get(apitypes.HeaderAdminKey)), []byte(s.WebhooksAdminKey)) != 1 {
+			return ierrors.WithMessage(echo.ErrUnauthorized, "missing or invalid admin key")
+		}
+
+		return next(c)
+	}
+}
+
+func (s *IndexerServer) webhooks() (*apitypes.WebhooksResponse, error) {
+	watches, err := s.Webhooks.Watches()
+	if err != nil {
+		return nil, webhookError(err)
+	}
+
+	items := make([]*apitypes.WebhookResponse, 0, len(watches))
+	for _, watch := range watches {
+		items = append(items, &apitypes.WebhookResponse{
+			ID:     uint64(watch.ID),
+			Filter: watch.Filter,
+			URL:    watch.URL,
+		})
+	}
+
+	return &apitypes.WebhooksResponse{
+		Items: items,
+	}, nil
+}
+
+func (s *IndexerServer) addWebhook(c echo.Context) (*apitypes.WebhookResponse, error) {
+	// Bech32 addresses and filters only exist in the JSON representation, so there is no binary request format
+	if _, err := httpserver.GetRequestContentType(c, echo.MIMEApplicationJSON); err != nil {
+		return nil, err
+	}
+
+	request, err := httpserver.ParseRequestByHeader[*apitypes.WebhookRequest](c, s.APIProvider.CommittedAPI(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := request.Filter
+	switch {
+	case request.Address != "" && request.Filter != "":
+		return nil, ierrors.WithMessage(httpserver.ErrInvalidParameter, "either an address or a filter can be given, not both")
+
+	case request.Address != "":
+		filter = url.Values{apitypes.QueryParameterUnlockableByAddress: []string{request.Address}}.Encode()
+
+	case request.Filter == "":
+		return nil, ierrors.WithMessage(httpserver.ErrInvalidParameter, "either an address or a filter has to be given")
+	}
+
+	watch, err := s.Webhooks.AddWatch(filter, request.URL, request.Secret)
+	if err != nil {
+		return nil, webhookError(err)
+	}
+
+	return &apitypes.WebhookResponse{
+		ID:     uint64(watch.ID),
+		Filter: watch.Filter,
+		URL:    watch.URL,
+		Secret: watch.Secret,
+	}, nil
+}
+
+func (s *IndexerServer) webhookDeliveries(c echo.Context) (*apitypes.WebhookDeliveriesResponse, error) {
+	watchID, err := parseWebhookIDParam(c)
+	if err != nil {
+		return nil, err
+	}
+
+	deliveries, err := s.Webhooks.Deliveries(watchID, int(s.pageSizeFromContext(c)))
+	if err != nil {
+		return nil, webhookError(err)
+	}
+
+	items := make([]*apitypes.WebhookDeliveryResponse, 0, len(deliveries))
+	for _, delivery := range deliveries {
+		items = append(items, &apitypes.WebhookDeliveryResponse{
+			ID:        uint64(delivery.ID),
+			OutputID:  iotago.OutputID(delivery.OutputID),
+			Slot:      delivery.Slot,
+			Spent:     delivery.Spent,
+			Committed: delivery.Committed,
+			Retracted: delivery.Retracted,
+			Status:    delivery.Status.String(),
+			Attempts:  uint32(delivery.Attempts),
+			LastError: delivery.LastError,
+		})
+	}
+
+	return &apitypes.WebhookDeliveriesResponse{
+		Items: items,
+	}, nil
+}
+
+func parseWebhookIDParam(c echo.Context) (uint, error) {
+	watchID, err := httpserver.ParseUint64Param(c, apitypes.ParameterWebhookID)
+	if err != nil {
+		return 0, err
+	}
+
+	return uint(watchID), nil
+}
+
+func webhookError(err error) error {
+	switch {
+	case ierrors.Is(err, webhook.ErrWatchNotFound):
+		return ierrors.WithMessage(echo.ErrNotFound, "record not found")
+	case ierrors.Is(err, webhook.ErrForbiddenTarget):
+		return ierrors.WithMessagef(echo.ErrForbidden, "invalid webhook: %s", err)
+	case ierrors.Is(err, webhook.ErrMaxWatchesReached):
+		return ierrors.WithMessagef(echo.ErrServiceUnavailable, "%s", err)
+	case ierrors.Is(err, webhook.ErrInvalidWatch):
+		return ierrors.WithMessagef(httpserver.ErrInvalidParameter, "invalid webhook: %s", err)
+	default:
+		return ierrors.WithMessagef(echo.ErrInternalServerError, "managing webhooks failed: %s", err)
+	}
+}
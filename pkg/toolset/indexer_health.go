@@ -3,14 +3,12 @@ package toolset
 import (
 	"context"
 	"fmt"
-	"net/http"
 	"os"
 
 	flag "github.com/spf13/pflag"
 
 	"github.com/iotaledger/hive.go/app/configuration"
-	"github.com/iotaledger/inx-indexer/pkg/server"
-	"github.com/iotaledger/iota.go/v4/api"
+	"github.com/iotaledger/inx-indexer/pkg/client"
 )
 
 func checkHealth(args []string) error {
@@ -31,19 +29,13 @@ func checkHealth(args []string) error {
 		return err
 	}
 
-	url := fmt.Sprintf("%s%s%s", *nodeURLFlag, server.APIRoute, api.RouteHealth)
-	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, url, nil)
+	// The health check does not decode any responses, so there is no need for an API provider
+	healthy, err := client.New(*nodeURLFlag, nil).Health(context.Background())
 	if err != nil {
 		return err
 	}
 
-	resp, err := http.DefaultClient.Do(req)
-	if err != nil {
-		return err
-	}
-	defer resp.Body.Close()
-
-	fmt.Printf("IsHealthy: %s\n", yesOrNo(resp.StatusCode == http.StatusOK))
+	fmt.Printf("IsHealthy: %s\n", yesOrNo(healthy))
 
 	return nil
 }
@@ -0,0 +1,755 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/hive.go/log"
+	"github.com/iotaledger/hive.go/runtime/options"
+	"github.com/iotaledger/hive.go/sql"
+	"github.com/iotaledger/inx-indexer/pkg/indexer"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+const (
+	// deliveryInterval is the interval in which the changes are recorded and the pending deliveries are attempted.
+	deliveryInterval = 1 * time.Second
+	// deliveryBatchSize is the maximum amount of deliveries of a watch that are attempted per interval.
+	deliveryBatchSize = 100
+	// changeLogPageSize is the amount of entries of the change log that are scanned at once.
+	changeLogPageSize = 1000
+	// maxChangeLogPagesPerRound is the maximum amount of pages of the change log that are scanned for a watch per interval.
+	maxChangeLogPagesPerRound = 10
+	// pruningInterval is the interval in which finished deliveries outside of the retention window are removed from the delivery log.
+	pruningInterval = 1 * time.Minute
+	// secretLength is the length of the generated secrets in bytes.
+	secretLength = 32
+	// maxDrainedResponseSize is the maximum amount of bytes of a response body that are read, so that a target can't keep a worker busy.
+	maxDrainedResponseSize = 4 * 1024
+)
+
+var (
+	ErrWatchNotFound     = ierrors.New("watch not found")
+	ErrInvalidWatch      = ierrors.New("invalid watch")
+	ErrMaxWatchesReached = ierrors.New("maximum number of watches reached")
+)
+
+// FilterParser parses the encoded query parameters of the combined outputs endpoint.
+type FilterParser func(filter string) ([]options.Option[indexer.CombinedFilterOptions], error)
+
+// Manager keeps the registry of the watches and delivers their notifications.
+// The committed events are read from the change log of the indexer, every watch keeps its own position in the change log.
+// The accepted events are received via the subscriptions of the indexer, which might drop events if the manager doesn't keep up.
+type Manager struct {
+	log.Logger
+	db          *gorm.DB
+	indexer     *indexer.Indexer
+	parseFilter FilterParser
+	client      *http.Client
+
+	// subscriptions contains the subscriptions of the watches while the manager is running.
+	subscriptions      map[uint]*indexer.OutputSubscription
+	subscriptionsMutex sync.Mutex
+	running            bool
+	recordersWaitGroup sync.WaitGroup
+
+	// workerSlots limits the number of watches that are processed concurrently.
+	workerSlots chan struct{}
+	// processing contains the watches that are currently processed by a worker.
+	processing       map[uint]struct{}
+	processingMutex  sync.Mutex
+	workersWaitGroup sync.WaitGroup
+	// nextWatchIndex is the index of the watch the next round of workers starts with.
+	nextWatchIndex int
+
+	// optsMaxAttempts defines the maximum number of attempts to deliver a notification.
+	optsMaxAttempts uint
+	// optsRetryInterval defines the interval before the first retry, it is doubled for every further attempt.
+	optsRetryInterval time.Duration
+	// optsMaxRetryInterval defines the maximum interval between two attempts.
+	optsMaxRetryInterval time.Duration
+	// optsTimeout defines the timeout of a single attempt.
+	optsTimeout time.Duration
+	// optsDeliveryRetention defines for how long finished deliveries are kept in the delivery log.
+	optsDeliveryRetention time.Duration
+	// optsMaxWatches defines the maximum number of registered watches.
+	optsMaxWatches int
+	// optsAllowedHosts defines the hosts the notifications may be posted to, all public hosts are allowed if it is empty.
+	optsAllowedHosts []string
+	// optsPrivateTargetsAllowed defines whether notifications may be posted to loopback, private and link-local addresses.
+	optsPrivateTargetsAllowed bool
+	// optsMaxConcurrentDeliveries defines the maximum number of watches whose notifications are delivered concurrently.
+	optsMaxConcurrentDeliveries int
+}
+
+func NewManager(dbParams sql.DatabaseParameters, idx *indexer.Indexer, parseFilter FilterParser, logger log.Logger, opts ...options.Option[Manager]) (*Manager, error) {
+	db, _, err := sql.New(logger, dbParams, true, indexer.AllowedEngines)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := db.AutoMigrate(&Watch{}, &Delivery{}); err != nil {
+		return nil, err
+	}
+
+	m := options.Apply(&Manager{
+		Logger:                      logger,
+		db:                          db,
+		indexer:                     idx,
+		parseFilter:                 parseFilter,
+		subscriptions:               make(map[uint]*indexer.OutputSubscription),
+		processing:                  make(map[uint]struct{}),
+		optsMaxAttempts:             10,
+		optsRetryInterval:           10 * time.Second,
+		optsMaxRetryInterval:        1 * time.Hour,
+		optsTimeout:                 10 * time.Second,
+		optsDeliveryRetention:       24 * time.Hour,
+		optsMaxWatches:              100,
+		optsMaxConcurrentDeliveries: 10,
+	}, opts)
+	m.client = m.newHTTPClient()
+	m.workerSlots = make(chan struct{}, max(m.optsMaxConcurrentDeliveries, 1))
+
+	return m, nil
+}
+
+// WithMaxAttempts sets the maximum number of attempts to deliver a notification.
+func WithMaxAttempts(maxAttempts uint) options.Option[Manager] {
+	return func(m *Manager) {
+		m.optsMaxAttempts = maxAttempts
+	}
+}
+
+// WithRetryInterval sets the interval before the first retry of a failed delivery, which is doubled for every further attempt up to the given maximum.
+func WithRetryInterval(retryInterval time.Duration, maxRetryInterval time.Duration) options.Option[Manager] {
+	return func(m *Manager) {
+		m.optsRetryInterval = retryInterval
+		m.optsMaxRetryInterval = maxRetryInterval
+	}
+}
+
+// WithTimeout sets the timeout of a single delivery attempt.
+func WithTimeout(timeout time.Duration) options.Option[Manager] {
+	return func(m *Manager) {
+		m.optsTimeout = timeout
+	}
+}
+
+// WithDeliveryRetention sets for how long finished deliveries are kept in the delivery log.
+func WithDeliveryRetention(retention time.Duration) options.Option[Manager] {
+	return func(m *Manager) {
+		m.optsDeliveryRetention = retention
+	}
+}
+
+// WithMaxWatches sets the maximum number of registered watches.
+func WithMaxWatches(maxWatches int) options.Option[Manager] {
+	return func(m *Manager) {
+		m.optsMaxWatches = maxWatches
+	}
+}
+
+// WithAllowedHosts sets the hosts the notifications may be posted to.
+// An entry starting with "*." allows all subdomains of the given domain, all public hosts are allowed if no hosts are given.
+func WithAllowedHosts(hosts ...string) options.Option[Manager] {
+	return func(m *Manager) {
+		m.optsAllowedHosts = hosts
+	}
+}
+
+// WithPrivateTargetsAllowed sets whether notifications may be posted to loopback, private and link-local addresses.
+func WithPrivateTargetsAllowed(allowed bool) options.Option[Manager] {
+	return func(m *Manager) {
+		m.optsPrivateTargetsAllowed = allowed
+	}
+}
+
+// WithMaxConcurrentDeliveries sets the maximum number of watches whose notifications are delivered concurrently.
+func WithMaxConcurrentDeliveries(maxConcurrentDeliveries int) options.Option[Manager] {
+	return func(m *Manager) {
+		m.optsMaxConcurrentDeliveries = maxConcurrentDeliveries
+	}
+}
+
+// AddWatch registers a new watch. A secret is generated if none is given.
+func (m *Manager) AddWatch(filter string, targetURL string, secret string) (*Watch, error) {
+	parsedURL, err := url.Parse(targetURL)
+	if err != nil {
+		return nil, ierrors.Wrapf(ErrInvalidWatch, "invalid URL: %s", err)
+	}
+	if (parsedURL.Scheme != "http" && parsedURL.Scheme != "https") || parsedURL.Host == "" {
+		return nil, ierrors.Wrapf(ErrInvalidWatch, "invalid URL: %s, only absolute http and https URLs are supported", targetURL)
+	}
+
+	if err := m.checkTarget(parsedURL); err != nil {
+		return nil, err
+	}
+
+	if _, err := m.parseFilter(filter); err != nil {
+		return nil, ierrors.Wrapf(ErrInvalidWatch, "invalid filter: %s", err)
+	}
+
+	if secret == "" {
+		secretBytes := make([]byte, secretLength)
+		if _, err := rand.Read(secretBytes); err != nil {
+			return nil, err
+		}
+		secret = hex.EncodeToString(secretBytes)
+	}
+
+	// The watch is notified about the changes that are committed after it was registered
+	changeLogID, nextSequenceNumber, err := m.indexer.ChangeLogHead()
+	if err != nil {
+		return nil, err
+	}
+
+	watch := &Watch{
+		Filter:             filter,
+		URL:                targetURL,
+		Secret:             secret,
+		ChangeLogID:        changeLogID,
+		NextSequenceNumber: nextSequenceNumber,
+	}
+	if err := m.db.Transaction(func(tx *gorm.DB) error {
+		var count int64
+		if err := tx.Model(&Watch{}).Count(&count).Error; err != nil {
+			return err
+		}
+		if count >= int64(m.optsMaxWatches) {
+			return ierrors.Wrapf(ErrMaxWatchesReached, "%d watches are registered", count)
+		}
+
+		return tx.Create(watch).Error
+	}); err != nil {
+		return nil, err
+	}
+
+	m.subscriptionsMutex.Lock()
+	defer m.subscriptionsMutex.Unlock()
+
+	if m.running {
+		if err := m.subscribe(watch); err != nil {
+			return nil, err
+		}
+	}
+
+	return watch, nil
+}
+
+// Watches returns all registered watches.
+func (m *Manager) Watches() ([]*Watch, error) {
+	var watches []*Watch
+	if err := m.db.Order("id").Find(&watches).Error; err != nil {
+		return nil, err
+	}
+
+	return watches, nil
+}
+
+// DeleteWatch removes the watch together with its delivery log.
+func (m *Manager) DeleteWatch(watchID uint) error {
+	if err := m.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Delete(&Watch{}, watchID)
+		if err := result.Error; err != nil {
+			return err
+		}
+		if result.RowsAffected == 0 {
+			return ierrors.Wrapf(ErrWatchNotFound, "watch %d", watchID)
+		}
+
+		return tx.Where("watch_id = ?", watchID).Delete(&Delivery{}).Error
+	}); err != nil {
+		return err
+	}
+
+	m.subscriptionsMutex.Lock()
+	defer m.subscriptionsMutex.Unlock()
+
+	if subscription, exists := m.subscriptions[watchID]; exists {
+		delete(m.subscriptions, watchID)
+		m.indexer.Unsubscribe(subscription)
+	}
+
+	return nil
+}
+
+// Deliveries returns the latest deliveries of the watch, newest first.
+func (m *Manager) Deliveries(watchID uint, limit int) ([]*Delivery, error) {
+	if err := m.db.Take(&Watch{}, watchID).Error; err != nil {
+		if ierrors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ierrors.Wrapf(ErrWatchNotFound, "watch %d", watchID)
+		}
+
+		return nil, err
+	}
+
+	var deliveries []*Delivery
+	if err := m.db.Where("watch_id = ?", watchID).Order("id DESC").Limit(limit).Find(&deliveries).Error; err != nil {
+		return nil, err
+	}
+
+	return deliveries, nil
+}
+
+// Run subscribes to the outputs of the watches, records their committed changes and delivers the notifications until the context is done.
+func (m *Manager) Run(ctx context.Context) error {
+	watches, err := m.Watches()
+	if err != nil {
+		return err
+	}
+
+	m.subscriptionsMutex.Lock()
+	m.running = true
+	for _, watch := range watches {
+		if err := m.subscribe(watch); err != nil {
+			// The filter might not be valid anymore, e.g. if the network changed
+			m.LogWarnf("subscribing to the outputs of watch %d failed: %s", watch.ID, err)
+		}
+	}
+	m.subscriptionsMutex.Unlock()
+
+	defer func() {
+		m.subscriptionsMutex.Lock()
+		m.running = false
+		for watchID, subscription := range m.subscriptions {
+			delete(m.subscriptions, watchID)
+			m.indexer.Unsubscribe(subscription)
+		}
+		m.subscriptionsMutex.Unlock()
+
+		m.recordersWaitGroup.Wait()
+		m.workersWaitGroup.Wait()
+	}()
+
+	deliveryTicker := time.NewTicker(deliveryInterval)
+	defer deliveryTicker.Stop()
+
+	lastPruning := time.Now()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+
+		case <-deliveryTicker.C:
+			if err := m.startWorkers(ctx); err != nil {
+				m.LogWarnf("starting the delivery workers failed: %s", err)
+			}
+
+			if time.Since(lastPruning) >= pruningInterval {
+				lastPruning = time.Now()
+				if err := m.pruneDeliveries(); err != nil {
+					m.LogWarnf("pruning delivery log failed: %s", err)
+				}
+			}
+		}
+	}
+}
+
+// Close closes the database of the manager.
+func (m *Manager) Close() error {
+	sqlDB, err := m.db.DB()
+	if err != nil {
+		return err
+	}
+
+	return sqlDB.Close()
+}
+
+// subscribe subscribes to the outputs of the watch. The subscriptionsMutex must be held by the caller.
+func (m *Manager) subscribe(watch *Watch) error {
+	if _, exists := m.subscriptions[watch.ID]; exists {
+		// The watch was added while the manager was starting
+		return nil
+	}
+
+	filters, err := m.parseFilter(watch.Filter)
+	if err != nil {
+		return err
+	}
+
+	subscription := m.indexer.CombinedSubscription(filters...)
+	m.subscriptions[watch.ID] = subscription
+
+	m.recordersWaitGroup.Add(1)
+	go m.recordDeliveries(watch, subscription)
+
+	return nil
+}
+
+// recordDeliveries adds a delivery for every accepted event of the subscription until it is cancelled.
+// The committed events are recorded from the change log instead, see recordChanges.
+func (m *Manager) recordDeliveries(watch *Watch, subscription *indexer.OutputSubscription) {
+	defer m.recordersWaitGroup.Done()
+
+	for event := range subscription.Events() {
+		if event.Committed {
+			continue
+		}
+
+		if err := m.db.Create(&Delivery{
+			WatchID:       watch.ID,
+			OutputID:      event.OutputID[:],
+			Slot:          event.Slot,
+			Spent:         event.Spent,
+			Committed:     event.Committed,
+			Status:        DeliveryStatusPending,
+			NextAttemptAt: time.Now(),
+		}).Error; err != nil {
+			m.LogErrorf("recording delivery of output %s for watch %d failed: %s", event.OutputID.ToHex(), watch.ID, err)
+		}
+	}
+
+	m.subscriptionsMutex.Lock()
+	defer m.subscriptionsMutex.Unlock()
+
+	// The indexer cancels subscriptions that do not keep up with the events, in that case we need to subscribe again
+	if current, exists := m.subscriptions[watch.ID]; exists && current == subscription {
+		m.LogWarnf("subscription of watch %d was cancelled by the indexer, notifications of accepted events might have been missed", watch.ID)
+		delete(m.subscriptions, watch.ID)
+		if err := m.subscribe(watch); err != nil {
+			m.LogErrorf("subscribing to the outputs of watch %d failed: %s", watch.ID, err)
+		}
+	}
+}
+
+// startWorkers starts a worker for every watch that is not processed yet, as long as there are free worker slots.
+// The watches are visited round-robin, so that the watches with slow targets can't starve the others.
+func (m *Manager) startWorkers(ctx context.Context) error {
+	watches, err := m.Watches()
+	if err != nil {
+		return err
+	}
+
+	for n := range len(watches) {
+		watch := watches[(m.nextWatchIndex+n)%len(watches)]
+
+		m.processingMutex.Lock()
+		_, isProcessing := m.processing[watch.ID]
+		m.processingMutex.Unlock()
+		if isProcessing {
+			continue
+		}
+
+		select {
+		case m.workerSlots <- struct{}{}:
+		default:
+			// All workers are busy, the remaining watches are processed first in the next round
+			m.nextWatchIndex = (m.nextWatchIndex + n) % len(watches)
+
+			return nil
+		}
+
+		m.processingMutex.Lock()
+		m.processing[watch.ID] = struct{}{}
+		m.processingMutex.Unlock()
+
+		m.workersWaitGroup.Add(1)
+		go m.processWatch(ctx, watch)
+	}
+
+	return nil
+}
+
+// processWatch records the committed changes of the watch and delivers its pending notifications.
+func (m *Manager) processWatch(ctx context.Context, watch *Watch) {
+	defer func() {
+		m.processingMutex.Lock()
+		delete(m.processing, watch.ID)
+		m.processingMutex.Unlock()
+
+		<-m.workerSlots
+		m.workersWaitGroup.Done()
+	}()
+
+	if err := m.recordChanges(ctx, watch); err != nil {
+		if ierrors.Is(err, ErrWatchNotFound) {
+			// The watch was deleted in the meantime
+			return
+		}
+
+		m.LogWarnf("recording the changes of watch %d failed: %s", watch.ID, err)
+	}
+
+	if err := m.deliverPending(ctx, watch); err != nil {
+		m.LogWarnf("delivering the notifications of watch %d failed: %s", watch.ID, err)
+	}
+}
+
+// recordChanges adds a delivery for every committed change of the indexer that matches the filter of the watch.
+// The deliveries are recorded in the same transaction that advances the position of the watch in the change log,
+// so that no committed change is missed or notified twice, even if the manager is restarted.
+// Once all committed changes are recorded, the accepted deliveries of the committed slots are settled.
+func (m *Manager) recordChanges(ctx context.Context, watch *Watch) error {
+	filters, err := m.parseFilter(watch.Filter)
+	if err != nil {
+		return err
+	}
+
+	changeLogID := watch.ChangeLogID
+	nextSequenceNumber := watch.NextSequenceNumber
+	if changeLogID == "" {
+		// The watch was registered before the positions in the change log were recorded
+		if changeLogID, nextSequenceNumber, err = m.indexer.ChangeLogHead(); err != nil {
+			return err
+		}
+	}
+
+	for range maxChangeLogPagesPerRound {
+		if ctx.Err() != nil {
+			return nil
+		}
+
+		// The committed slot is read before the changes, so all changes up to this slot were read once there are no further changes
+		status, err := m.indexer.Status()
+		if err != nil {
+			return err
+		}
+
+		result := m.indexer.Changes(
+			indexer.ChangesChangeLogID(changeLogID),
+			indexer.ChangesFromSequenceNumber(nextSequenceNumber),
+			indexer.ChangesPageSize(changeLogPageSize),
+			indexer.ChangesCombinedFilter(filters...),
+		)
+		if err := result.Error; err != nil {
+			switch {
+			case ierrors.Is(err, indexer.ErrChangeLogIDMismatch):
+				// The ledger was re-imported, the changes of the replaced change log can't be recovered
+				m.LogWarnf("change log of watch %d was replaced, notifications might have been missed: %s", watch.ID, err)
+				if changeLogID, nextSequenceNumber, err = m.indexer.ChangeLogHead(); err != nil {
+					return err
+				}
+
+			case ierrors.Is(err, indexer.ErrChangeLogPruned):
+				// The manager didn't keep up with the retention window of the change log, so we continue with the oldest change that is left
+				m.LogWarnf("position of watch %d in the change log was pruned, notifications might have been missed: %s", watch.ID, err)
+				nextSequenceNumber = 0
+
+			default:
+				return err
+			}
+
+			continue
+		}
+
+		caughtUp := result.NextSequenceNumber == nextSequenceNumber
+		if !caughtUp || changeLogID != watch.ChangeLogID || nextSequenceNumber != watch.NextSequenceNumber {
+			if err := m.recordChangesPage(watch.ID, result); err != nil {
+				return err
+			}
+			watch.ChangeLogID = result.ChangeLogID
+			watch.NextSequenceNumber = result.NextSequenceNumber
+		}
+		changeLogID = result.ChangeLogID
+		nextSequenceNumber = result.NextSequenceNumber
+
+		if caughtUp {
+			return m.settleAcceptedDeliveries(watch.ID, status.CommittedSlot)
+		}
+	}
+
+	return nil
+}
+
+// recordChangesPage adds the deliveries of the changes and advances the position of the watch in the change log.
+func (m *Manager) recordChangesPage(watchID uint, result *indexer.ChangesResult) error {
+	return m.db.Transaction(func(tx *gorm.DB) error {
+		// The position is updated first, so that no deliveries are recorded for watches that were deleted in the meantime
+		updateResult := tx.Model(&Watch{}).Where("id = ?", watchID).Updates(map[string]interface{}{
+			"change_log_id":        result.ChangeLogID,
+			"next_sequence_number": result.NextSequenceNumber,
+		})
+		if err := updateResult.Error; err != nil {
+			return err
+		}
+		if updateResult.RowsAffected == 0 {
+			return ierrors.Wrapf(ErrWatchNotFound, "watch %d", watchID)
+		}
+
+		if len(result.Changes) == 0 {
+			return nil
+		}
+
+		deliveries := make([]*Delivery, 0, len(result.Changes))
+		for _, change := range result.Changes {
+			deliveries = append(deliveries, &Delivery{
+				WatchID:       watchID,
+				OutputID:      change.OutputID[:],
+				Slot:          change.Slot,
+				Spent:         change.Spent,
+				Committed:     true,
+				Settled:       true,
+				Status:        DeliveryStatusPending,
+				NextAttemptAt: time.Now(),
+			})
+		}
+
+		return tx.Create(deliveries).Error
+	})
+}
+
+// settleAcceptedDeliveries checks for the accepted deliveries up to the committed slot whether their events were committed as well.
+// A retraction is delivered for every accepted event that didn't make it into the change log, e.g. because the output was spent in the same slot.
+func (m *Manager) settleAcceptedDeliveries(watchID uint, committedSlot iotago.SlotIndex) error {
+	return m.db.Transaction(func(tx *gorm.DB) error {
+		var acceptedDeliveries []*Delivery
+		if err := tx.Where("watch_id = ? AND committed = ? AND settled = ? AND slot <= ?", watchID, false, false, committedSlot).Order("id").Find(&acceptedDeliveries).Error; err != nil {
+			return err
+		}
+
+		if len(acceptedDeliveries) == 0 {
+			return nil
+		}
+
+		settledIDs := make([]uint, 0, len(acceptedDeliveries))
+		for _, delivery := range acceptedDeliveries {
+			settledIDs = append(settledIDs, delivery.ID)
+
+			var committedCount int64
+			if err := tx.Model(&Delivery{}).
+				Where("watch_id = ? AND output_id = ? AND spent = ? AND committed = ? AND retracted = ?", watchID, delivery.OutputID, delivery.Spent, true, false).
+				Count(&committedCount).Error; err != nil {
+				return err
+			}
+
+			if committedCount > 0 {
+				continue
+			}
+
+			if err := tx.Create(&Delivery{
+				WatchID:       watchID,
+				OutputID:      delivery.OutputID,
+				Slot:          delivery.Slot,
+				Spent:         delivery.Spent,
+				Committed:     true,
+				Retracted:     true,
+				Settled:       true,
+				Status:        DeliveryStatusPending,
+				NextAttemptAt: time.Now(),
+			}).Error; err != nil {
+				return err
+			}
+		}
+
+		return tx.Model(&Delivery{}).Where("id IN ?", settledIDs).Update("settled", true).Error
+	})
+}
+
+// deliverPending attempts the due deliveries of the watch in the order they were recorded.
+// The remaining deliveries are postponed to the next round after a failed attempt, so that an unavailable target isn't flooded with requests.
+func (m *Manager) deliverPending(ctx context.Context, watch *Watch) error {
+	var deliveries []*Delivery
+	if err := m.db.Where("watch_id = ? AND status = ? AND next_attempt_at <= ?", watch.ID, DeliveryStatusPending, time.Now()).Order("id").Limit(deliveryBatchSize).Find(&deliveries).Error; err != nil {
+		return err
+	}
+
+	for _, delivery := range deliveries {
+		if ctx.Err() != nil {
+			return nil
+		}
+
+		delivered, err := m.deliver(ctx, watch, delivery)
+		if err != nil {
+			return err
+		}
+		if !delivered {
+			return nil
+		}
+	}
+
+	return nil
+}
+
+// deliver makes an attempt to post the notification, updates the delivery log with the outcome and returns whether the attempt succeeded.
+func (m *Manager) deliver(ctx context.Context, watch *Watch, delivery *Delivery) (bool, error) {
+	updates := map[string]interface{}{
+		"attempts": delivery.Attempts + 1,
+	}
+
+	delivered := true
+	if err := m.post(ctx, watch, delivery); err != nil {
+		delivered = false
+		if ctx.Err() != nil {
+			// The attempt was aborted by the shutdown, so it does not count
+			return false, nil
+		}
+
+		updates["last_error"] = err.Error()
+		if delivery.Attempts+1 >= m.optsMaxAttempts {
+			updates["status"] = DeliveryStatusFailed
+			m.LogWarnf("delivery %d of output %s to watch %d failed after %d attempts: %s", delivery.ID, iotago.OutputID(delivery.OutputID).ToHex(), watch.ID, delivery.Attempts+1, err)
+		} else {
+			updates["next_attempt_at"] = time.Now().Add(m.retryInterval(delivery.Attempts + 1))
+		}
+	} else {
+		updates["status"] = DeliveryStatusDelivered
+		updates["last_error"] = ""
+	}
+
+	if err := m.db.Model(delivery).Updates(updates).Error; err != nil {
+		return false, err
+	}
+
+	return delivered, nil
+}
+
+func (m *Manager) post(ctx context.Context, watch *Watch, delivery *Delivery) error {
+	body, err := json.Marshal(notificationForDelivery(delivery))
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, watch.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderSignature, Signature(watch.Secret, body))
+	req.Header.Set(HeaderDelivery, strconv.FormatUint(uint64(delivery.ID), 10))
+
+	resp, err := m.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	// Drain the body so that the connection can be reused, larger bodies are not worth reading just to keep the connection
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, maxDrainedResponseSize))
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return ierrors.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// retryInterval returns the interval before the next attempt after the given amount of failed attempts.
+func (m *Manager) retryInterval(failedAttempts uint) time.Duration {
+	interval := m.optsRetryInterval
+	for i := uint(1); i < failedAttempts && interval < m.optsMaxRetryInterval; i++ {
+		interval *= 2
+	}
+
+	return min(interval, m.optsMaxRetryInterval)
+}
+
+func (m *Manager) pruneDeliveries() error {
+	// Accepted events might be recorded for watches that were deleted in the meantime
+	if err := m.db.Where("watch_id NOT IN (?)", m.db.Model(&Watch{}).Select("id")).Delete(&Delivery{}).Error; err != nil {
+		return err
+	}
+
+	return m.db.Where("status != ? AND updated_at < ?", DeliveryStatusPending, time.Now().Add(-m.optsDeliveryRetention)).Delete(&Delivery{}).Error
+}
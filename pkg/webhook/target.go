@@ -0,0 +1,121 @@
+package webhook
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/iotaledger/hive.go/ierrors"
+)
+
+// ErrForbiddenTarget is returned if the target of a watch is not allowed.
+var ErrForbiddenTarget = ierrors.New("forbidden target")
+
+// isForbiddenIP returns whether the IP address belongs to the host itself or to a private network.
+// Notifications to these addresses would allow the clients of the REST API to reach services that are not public.
+func isForbiddenIP(ip net.IP) bool {
+	return ip.IsLoopback() ||
+		ip.IsPrivate() ||
+		ip.IsLinkLocalUnicast() ||
+		ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() ||
+		ip.IsMulticast() ||
+		ip.IsUnspecified()
+}
+
+// isAllowedHost returns whether the host is part of the allow-list of the manager.
+// An entry starting with "*." matches all subdomains of the given domain, an empty list allows all hosts.
+func (m *Manager) isAllowedHost(host string) bool {
+	if len(m.optsAllowedHosts) == 0 {
+		return true
+	}
+
+	host = strings.ToLower(host)
+	for _, allowedHost := range m.optsAllowedHosts {
+		allowedHost = strings.ToLower(allowedHost)
+		if domain, found := strings.CutPrefix(allowedHost, "*."); found {
+			if strings.HasSuffix(host, "."+domain) {
+				return true
+			}
+
+			continue
+		}
+
+		if host == allowedHost {
+			return true
+		}
+	}
+
+	return false
+}
+
+// checkTarget checks that the host of the URL is allowed and that it doesn't resolve to a forbidden address.
+// The addresses are checked again whenever a notification is posted, since the DNS records might change in the meantime.
+func (m *Manager) checkTarget(targetURL *url.URL) error {
+	host := targetURL.Hostname()
+	if !m.isAllowedHost(host) {
+		return ierrors.Wrapf(ErrForbiddenTarget, "host %s is not allowed", host)
+	}
+
+	if m.optsPrivateTargetsAllowed {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), m.optsTimeout)
+	defer cancel()
+
+	addresses, err := net.DefaultResolver.LookupIPAddr(ctx, host)
+	if err != nil {
+		return ierrors.Wrapf(ErrForbiddenTarget, "resolving host %s failed: %s", host, err)
+	}
+
+	for _, address := range addresses {
+		if isForbiddenIP(address.IP) {
+			return ierrors.Wrapf(ErrForbiddenTarget, "host %s resolves to the private address %s", host, address.IP)
+		}
+	}
+
+	return nil
+}
+
+// newHTTPClient returns the client that posts the notifications.
+// It only connects to allowed addresses and doesn't follow redirects, so that the checks of the target can't be bypassed.
+func (m *Manager) newHTTPClient() *http.Client {
+	dialer := &net.Dialer{
+		Timeout:   m.optsTimeout,
+		KeepAlive: 30 * time.Second,
+	}
+
+	if !m.optsPrivateTargetsAllowed {
+		// The address is checked after the DNS resolution, right before the connection is established
+		dialer.Control = func(_ string, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+
+			if ip := net.ParseIP(host); ip == nil || isForbiddenIP(ip) {
+				return ierrors.Wrapf(ErrForbiddenTarget, "connecting to the private address %s is not allowed", host)
+			}
+
+			return nil
+		}
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	// A proxy would connect to the target on our behalf without the checks of the dialer
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	return &http.Client{
+		Timeout:   m.optsTimeout,
+		Transport: transport,
+		CheckRedirect: func(_ *http.Request, _ []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
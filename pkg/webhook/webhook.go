@@ -0,0 +1,135 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+const (
+	// HeaderSignature contains the hex encoded HMAC-SHA256 signature of the notification, keyed with the secret of the watch.
+	HeaderSignature = "X-Indexer-Signature"
+	// HeaderDelivery contains the ID of the delivery, which stays the same for all attempts.
+	HeaderDelivery = "X-Indexer-Delivery"
+
+	signaturePrefix = "sha256="
+)
+
+// Watch registers a target URL that is notified about the outputs matching a filter.
+type Watch struct {
+	ID uint `gorm:"primaryKey;notnull"`
+	// Filter contains the encoded query parameters of the combined outputs endpoint the outputs are matched against.
+	Filter string `gorm:"notnull"`
+	URL    string `gorm:"notnull"`
+	// Secret is the key of the signatures of the notifications.
+	Secret string `gorm:"notnull"`
+	// ChangeLogID and NextSequenceNumber define the position in the change log of the indexer up to which the committed changes were recorded.
+	ChangeLogID        string
+	NextSequenceNumber uint64
+	CreatedAt          time.Time
+}
+
+func (w *Watch) TableName() string {
+	return "webhook_watches"
+}
+
+func (w *Watch) String() string {
+	return fmt.Sprintf("webhook watch => ID: %d, URL: %s, Filter: %s", w.ID, w.URL, w.Filter)
+}
+
+// DeliveryStatus defines the state of a delivery.
+type DeliveryStatus uint8
+
+const (
+	// DeliveryStatusPending is the status of deliveries that are waiting for their next attempt.
+	DeliveryStatusPending DeliveryStatus = iota
+	// DeliveryStatusDelivered is the status of deliveries that were accepted by the target.
+	DeliveryStatusDelivered
+	// DeliveryStatusFailed is the status of deliveries that were not accepted within the maximum number of attempts.
+	DeliveryStatusFailed
+)
+
+func (s DeliveryStatus) String() string {
+	switch s {
+	case DeliveryStatusPending:
+		return "pending"
+	case DeliveryStatusDelivered:
+		return "delivered"
+	case DeliveryStatusFailed:
+		return "failed"
+	default:
+		return fmt.Sprintf("unknown(%d)", s)
+	}
+}
+
+// Delivery is the notification of a watch about an output event, it also serves as the delivery log.
+type Delivery struct {
+	ID        uint             `gorm:"primaryKey;notnull"`
+	WatchID   uint             `gorm:"notnull;index:webhook_deliveries_watch_id"`
+	OutputID  []byte           `gorm:"notnull"`
+	Slot      iotago.SlotIndex `gorm:"notnull"`
+	Spent     bool
+	Committed bool
+	// Retracted is set if the delivery notifies that the accepted event of the output was not committed.
+	Retracted bool
+	// Settled is set once it is known whether the accepted event of the delivery was committed.
+	Settled       bool
+	Status        DeliveryStatus `gorm:"notnull;index:webhook_deliveries_status_next_attempt_at,priority:1"`
+	Attempts      uint           `gorm:"notnull"`
+	NextAttemptAt time.Time      `gorm:"notnull;index:webhook_deliveries_status_next_attempt_at,priority:2"`
+	LastError     string
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+func (d *Delivery) TableName() string {
+	return "webhook_deliveries"
+}
+
+func (d *Delivery) String() string {
+	return fmt.Sprintf("webhook delivery => ID: %d, WatchID: %d, OutputID: %s, Status: %s", d.ID, d.WatchID, hex.EncodeToString(d.OutputID), d.Status)
+}
+
+// Notification is the JSON body that is posted to the URL of a watch.
+type Notification struct {
+	WatchID    uint   `json:"watchId"`
+	DeliveryID uint   `json:"deliveryId"`
+	OutputID   string `json:"outputId"`
+	// Slot is the slot of the ledger update that created or spent the output.
+	Slot  iotago.SlotIndex `json:"slot"`
+	Spent bool             `json:"spent"`
+	// Committed is true if the notification was caused by a committed ledger update and false if it was caused by an accepted one.
+	// Notifications of accepted events are best effort, the notifications of committed events are never missed.
+	Committed bool `json:"committed"`
+	// Retracted is true if the accepted event with the same outputID and spent flag was not committed.
+	Retracted bool `json:"retracted"`
+}
+
+func notificationForDelivery(delivery *Delivery) *Notification {
+	return &Notification{
+		WatchID:    delivery.WatchID,
+		DeliveryID: delivery.ID,
+		OutputID:   iotago.OutputID(delivery.OutputID).ToHex(),
+		Slot:       delivery.Slot,
+		Spent:      delivery.Spent,
+		Committed:  delivery.Committed,
+		Retracted:  delivery.Retracted,
+	}
+}
+
+// Signature returns the value of the signature header for the given body.
+func Signature(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifySignature checks the value of the signature header of a received notification.
+func VerifySignature(secret string, body []byte, signature string) bool {
+	return hmac.Equal([]byte(Signature(secret, body)), []byte(signature))
+}
@@ -0,0 +1,471 @@
+package webhook_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/hive.go/db"
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/hive.go/log"
+	"github.com/iotaledger/hive.go/runtime/options"
+	"github.com/iotaledger/hive.go/sql"
+	"github.com/iotaledger/inx-indexer/pkg/apitypes"
+	"github.com/iotaledger/inx-indexer/pkg/indexer"
+	"github.com/iotaledger/inx-indexer/pkg/server"
+	"github.com/iotaledger/inx-indexer/pkg/webhook"
+	iotago "github.com/iotaledger/iota.go/v4"
+	iotago_tpkg "github.com/iotaledger/iota.go/v4/tpkg"
+)
+
+// targetServer is a stand-in for the receiver of the notifications.
+type targetServer struct {
+	*httptest.Server
+
+	mutex         sync.Mutex
+	secret        string
+	failures      int
+	notifications []*webhook.Notification
+}
+
+func newTargetServer(t *testing.T, secret string, failures int) *targetServer {
+	target := &targetServer{
+		secret:   secret,
+		failures: failures,
+	}
+
+	target.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		require.True(t, webhook.VerifySignature(target.secret, body, r.Header.Get(webhook.HeaderSignature)), "invalid signature")
+		require.NotEmpty(t, r.Header.Get(webhook.HeaderDelivery))
+
+		target.mutex.Lock()
+		defer target.mutex.Unlock()
+
+		if target.failures > 0 {
+			target.failures--
+			w.WriteHeader(http.StatusInternalServerError)
+
+			return
+		}
+
+		notification := &webhook.Notification{}
+		require.NoError(t, json.Unmarshal(body, notification))
+		target.notifications = append(target.notifications, notification)
+
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	t.Cleanup(target.Close)
+
+	return target
+}
+
+func (s *targetServer) Notifications() []*webhook.Notification {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return append([]*webhook.Notification(nil), s.notifications...)
+}
+
+func newTestIndexer(t *testing.T) *indexer.Indexer {
+	dbParams := sql.DatabaseParameters{
+		Engine:   db.EngineSQLite,
+		Path:     t.TempDir(),
+		Filename: "indexer_test.db",
+	}
+
+	idx, err := indexer.NewIndexer(dbParams, iotago.SingleVersionProvider(iotago_tpkg.ZeroCostTestAPI), log.NewLogger().NewChildLogger(t.Name()))
+	require.NoError(t, err)
+	require.NoError(t, idx.CreateTables())
+	require.NoError(t, idx.ImportTransaction(context.Background()).Finalize(0, t.Name(), 1))
+	require.NoError(t, idx.AutoMigrate())
+
+	return idx
+}
+
+func newTestManager(t *testing.T, idx *indexer.Indexer, opts ...options.Option[webhook.Manager]) *webhook.Manager {
+	dbParams := sql.DatabaseParameters{
+		Engine:   db.EngineSQLite,
+		Path:     t.TempDir(),
+		Filename: "webhooks_test.db",
+	}
+
+	parseFilter := func(filter string) ([]options.Option[indexer.CombinedFilterOptions], error) {
+		return server.ParseCombinedOutputFilters(iotago_tpkg.ZeroCostTestAPI.ProtocolParameters().Bech32HRP(), filter)
+	}
+
+	// The target servers of the tests listen on the loopback interface
+	opts = append([]options.Option[webhook.Manager]{webhook.WithPrivateTargetsAllowed(true)}, opts...)
+
+	manager, err := webhook.NewManager(dbParams, idx, parseFilter, log.NewLogger().NewChildLogger(t.Name()), opts...)
+	require.NoError(t, err)
+
+	return manager
+}
+
+func addressFilter(address iotago.Address) string {
+	return url.Values{apitypes.QueryParameterUnlockableByAddress: []string{address.Bech32(iotago_tpkg.ZeroCostTestAPI.ProtocolParameters().Bech32HRP())}}.Encode()
+}
+
+func basicOutputWithAddress(address iotago.Address) iotago.Output {
+	return &iotago.BasicOutput{
+		Amount: 100000,
+		UnlockConditions: iotago.BasicOutputUnlockConditions{
+			&iotago.AddressUnlockCondition{
+				Address: address,
+			},
+		},
+	}
+}
+
+func requireDeliveryStatus(t *testing.T, manager *webhook.Manager, watchID uint, status webhook.DeliveryStatus) *webhook.Delivery {
+	var delivery *webhook.Delivery
+	require.Eventually(t, func() bool {
+		deliveries, err := manager.Deliveries(watchID, 10)
+		require.NoError(t, err)
+		if len(deliveries) != 1 || deliveries[0].Status != status {
+			return false
+		}
+		delivery = deliveries[0]
+
+		return true
+	}, 10*time.Second, 50*time.Millisecond, "delivery of watch %d did not reach status %s", watchID, status)
+
+	return delivery
+}
+
+// requireDelivered waits until the given amount of deliveries of the watch were delivered and returns them, oldest first.
+func requireDelivered(t *testing.T, manager *webhook.Manager, watchID uint, count int) []*webhook.Delivery {
+	var deliveries []*webhook.Delivery
+	require.Eventually(t, func() bool {
+		var err error
+		deliveries, err = manager.Deliveries(watchID, count+1)
+		require.NoError(t, err)
+		if len(deliveries) != count {
+			return false
+		}
+		for _, delivery := range deliveries {
+			if delivery.Status != webhook.DeliveryStatusDelivered {
+				return false
+			}
+		}
+
+		return true
+	}, 10*time.Second, 50*time.Millisecond, "deliveries of watch %d were not delivered", watchID)
+
+	slices.Reverse(deliveries)
+
+	return deliveries
+}
+
+func runManager(t *testing.T, manager *webhook.Manager) (cancel func()) {
+	ctx, cancelCtx := context.WithCancel(context.Background())
+	runDone := make(chan struct{})
+	go func() {
+		defer close(runDone)
+		require.NoError(t, manager.Run(ctx))
+	}()
+
+	return func() {
+		cancelCtx()
+		<-runDone
+	}
+}
+
+func TestManager_AddWatch(t *testing.T) {
+	manager := newTestManager(t, newTestIndexer(t))
+	defer manager.Close()
+
+	filter := addressFilter(iotago_tpkg.RandEd25519Address())
+
+	_, err := manager.AddWatch(filter, "ftp://localhost/notify", "")
+	require.True(t, ierrors.Is(err, webhook.ErrInvalidWatch))
+
+	_, err = manager.AddWatch(filter, "/notify", "")
+	require.True(t, ierrors.Is(err, webhook.ErrInvalidWatch))
+
+	_, err = manager.AddWatch(apitypes.QueryParameterUnlockableByAddress+"=invalid", "http://localhost/notify", "")
+	require.True(t, ierrors.Is(err, webhook.ErrInvalidWatch))
+
+	// A secret is generated if none is given
+	watch, err := manager.AddWatch(filter, "http://localhost/notify", "")
+	require.NoError(t, err)
+	require.Len(t, watch.Secret, 64)
+
+	otherWatch, err := manager.AddWatch(filter, "https://localhost/notify", "secret")
+	require.NoError(t, err)
+	require.Equal(t, "secret", otherWatch.Secret)
+
+	watches, err := manager.Watches()
+	require.NoError(t, err)
+	require.Len(t, watches, 2)
+	require.Equal(t, watch.ID, watches[0].ID)
+	require.Equal(t, otherWatch.ID, watches[1].ID)
+
+	require.NoError(t, manager.DeleteWatch(watch.ID))
+	require.True(t, ierrors.Is(manager.DeleteWatch(watch.ID), webhook.ErrWatchNotFound))
+
+	_, err = manager.Deliveries(watch.ID, 10)
+	require.True(t, ierrors.Is(err, webhook.ErrWatchNotFound))
+
+	watches, err = manager.Watches()
+	require.NoError(t, err)
+	require.Len(t, watches, 1)
+}
+
+func TestManager_AddWatch_Targets(t *testing.T) {
+	manager := newTestManager(t, newTestIndexer(t),
+		webhook.WithPrivateTargetsAllowed(false),
+		webhook.WithAllowedHosts("*.example.com", "10.0.0.1", "8.8.8.8"),
+		webhook.WithMaxWatches(1),
+	)
+	defer manager.Close()
+
+	filter := addressFilter(iotago_tpkg.RandEd25519Address())
+
+	// Hosts that are not part of the allow-list are rejected before they are resolved
+	_, err := manager.AddWatch(filter, "http://example.org/notify", "")
+	require.True(t, ierrors.Is(err, webhook.ErrForbiddenTarget))
+
+	_, err = manager.AddWatch(filter, "http://example.com.evil.org/notify", "")
+	require.True(t, ierrors.Is(err, webhook.ErrForbiddenTarget))
+
+	// Allowed hosts still need to resolve to public addresses
+	_, err = manager.AddWatch(filter, "http://10.0.0.1/notify", "")
+	require.True(t, ierrors.Is(err, webhook.ErrForbiddenTarget))
+
+	_, err = manager.AddWatch(filter, "http://8.8.8.8/notify", "")
+	require.NoError(t, err)
+
+	// The number of watches is limited
+	_, err = manager.AddWatch(filter, "https://8.8.8.8/notify", "")
+	require.True(t, ierrors.Is(err, webhook.ErrMaxWatchesReached))
+}
+
+func TestManager_Deliveries(t *testing.T) {
+	idx := newTestIndexer(t)
+	manager := newTestManager(t, idx, webhook.WithMaxAttempts(3), webhook.WithRetryInterval(10*time.Millisecond, 20*time.Millisecond))
+	defer manager.Close()
+
+	address := iotago_tpkg.RandEd25519Address()
+
+	// The first attempt fails, the retry is accepted
+	target := newTargetServer(t, "secret", 1)
+	watch, err := manager.AddWatch(addressFilter(address), target.URL, "secret")
+	require.NoError(t, err)
+
+	// All attempts fail
+	failingTarget := newTargetServer(t, "other-secret", 100)
+	failingWatch, err := manager.AddWatch(addressFilter(address), failingTarget.URL, "other-secret")
+	require.NoError(t, err)
+
+	// Outputs of other addresses do not match any watch
+	otherWatch, err := manager.AddWatch(addressFilter(iotago_tpkg.RandEd25519Address()), target.URL, "secret")
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	runDone := make(chan struct{})
+	go func() {
+		defer close(runDone)
+		require.NoError(t, manager.Run(ctx))
+	}()
+
+	require.Eventually(t, func() bool {
+		return idx.SubscriptionCount() == 3
+	}, 5*time.Second, 10*time.Millisecond)
+
+	outputID := iotago_tpkg.RandOutputID(0)
+	require.NoError(t, idx.CommitLedgerUpdate(&indexer.LedgerUpdate{
+		Slot:    1,
+		Created: []*indexer.LedgerOutput{{OutputID: outputID, Output: basicOutputWithAddress(address), BookedAt: 1}},
+	}))
+
+	delivery := requireDeliveryStatus(t, manager, watch.ID, webhook.DeliveryStatusDelivered)
+	require.Equal(t, outputID[:], delivery.OutputID)
+	require.Equal(t, iotago.SlotIndex(1), delivery.Slot)
+	require.False(t, delivery.Spent)
+	require.True(t, delivery.Committed)
+	require.EqualValues(t, 2, delivery.Attempts)
+	require.Empty(t, delivery.LastError)
+
+	require.Equal(t, []*webhook.Notification{{
+		WatchID:    watch.ID,
+		DeliveryID: delivery.ID,
+		OutputID:   outputID.ToHex(),
+		Slot:       1,
+		Committed:  true,
+	}}, target.Notifications())
+
+	failedDelivery := requireDeliveryStatus(t, manager, failingWatch.ID, webhook.DeliveryStatusFailed)
+	require.EqualValues(t, 3, failedDelivery.Attempts)
+	require.Contains(t, failedDelivery.LastError, "500")
+	require.Empty(t, failingTarget.Notifications())
+
+	deliveries, err := manager.Deliveries(otherWatch.ID, 10)
+	require.NoError(t, err)
+	require.Empty(t, deliveries)
+
+	// Deleted watches are not notified anymore
+	require.NoError(t, manager.DeleteWatch(watch.ID))
+	require.Equal(t, 2, idx.SubscriptionCount())
+
+	cancel()
+	<-runDone
+	require.Zero(t, idx.SubscriptionCount())
+}
+
+func TestManager_Deliveries_ChangeLog(t *testing.T) {
+	idx := newTestIndexer(t)
+	manager := newTestManager(t, idx)
+	defer manager.Close()
+
+	address := iotago_tpkg.RandEd25519Address()
+	target := newTargetServer(t, "secret", 0)
+
+	// Changes that were committed before the watch was registered are not notified
+	require.NoError(t, idx.CommitLedgerUpdate(&indexer.LedgerUpdate{
+		Slot:    1,
+		Created: []*indexer.LedgerOutput{{OutputID: iotago_tpkg.RandOutputID(0), Output: basicOutputWithAddress(address), BookedAt: 1}},
+	}))
+
+	watch, err := manager.AddWatch(addressFilter(address), target.URL, "secret")
+	require.NoError(t, err)
+
+	// Changes that are committed while the manager is not running are notified once it is started
+	outputID := iotago_tpkg.RandOutputID(0)
+	output := basicOutputWithAddress(address)
+	require.NoError(t, idx.CommitLedgerUpdate(&indexer.LedgerUpdate{
+		Slot:    2,
+		Created: []*indexer.LedgerOutput{{OutputID: outputID, Output: output, BookedAt: 2}},
+	}))
+
+	cancel := runManager(t, manager)
+	deliveries := requireDelivered(t, manager, watch.ID, 1)
+	require.Equal(t, outputID[:], deliveries[0].OutputID)
+	cancel()
+
+	// The spending is notified even though the output is deleted by then, restarting the manager doesn't notify the changes again
+	require.NoError(t, idx.CommitLedgerUpdate(&indexer.LedgerUpdate{
+		Slot:     3,
+		Consumed: []*indexer.LedgerOutput{{OutputID: outputID, Output: output, BookedAt: 2, SpentAt: 3}},
+	}))
+
+	cancel = runManager(t, manager)
+	defer cancel()
+
+	deliveries = requireDelivered(t, manager, watch.ID, 2)
+	require.Equal(t, outputID[:], deliveries[1].OutputID)
+	require.Equal(t, iotago.SlotIndex(3), deliveries[1].Slot)
+	require.True(t, deliveries[1].Spent)
+	require.True(t, deliveries[1].Committed)
+	require.Len(t, target.Notifications(), 2)
+}
+
+func TestManager_Deliveries_Retraction(t *testing.T) {
+	idx := newTestIndexer(t)
+	manager := newTestManager(t, idx)
+	defer manager.Close()
+
+	address := iotago_tpkg.RandEd25519Address()
+	target := newTargetServer(t, "secret", 0)
+	watch, err := manager.AddWatch(addressFilter(address), target.URL, "secret")
+	require.NoError(t, err)
+
+	cancel := runManager(t, manager)
+	defer cancel()
+
+	require.Eventually(t, func() bool {
+		return idx.SubscriptionCount() == 1
+	}, 5*time.Second, 10*time.Millisecond)
+
+	committedOutputID := iotago_tpkg.RandOutputID(0)
+	retractedOutputID := iotago_tpkg.RandOutputID(0)
+	require.NoError(t, idx.AcceptLedgerUpdate(&indexer.LedgerUpdate{
+		Slot: 1,
+		Created: []*indexer.LedgerOutput{
+			{OutputID: committedOutputID, Output: basicOutputWithAddress(address), BookedAt: 1},
+			{OutputID: retractedOutputID, Output: basicOutputWithAddress(address), BookedAt: 1},
+		},
+	}))
+
+	// Wait for the accepted events to be recorded before they are settled by the commitment
+	require.Eventually(t, func() bool {
+		deliveries, err := manager.Deliveries(watch.ID, 10)
+		require.NoError(t, err)
+
+		return len(deliveries) == 2
+	}, 5*time.Second, 10*time.Millisecond)
+
+	require.NoError(t, idx.CommitLedgerUpdate(&indexer.LedgerUpdate{
+		Slot:    1,
+		Created: []*indexer.LedgerOutput{{OutputID: committedOutputID, Output: basicOutputWithAddress(address), BookedAt: 1}},
+	}))
+
+	deliveries := requireDelivered(t, manager, watch.ID, 4)
+
+	notifications := make(map[webhook.Notification]struct{})
+	for _, notification := range target.Notifications() {
+		notification.DeliveryID = 0
+		notifications[*notification] = struct{}{}
+	}
+	require.Equal(t, map[webhook.Notification]struct{}{
+		{WatchID: watch.ID, OutputID: committedOutputID.ToHex(), Slot: 1}:                                   {},
+		{WatchID: watch.ID, OutputID: retractedOutputID.ToHex(), Slot: 1}:                                   {},
+		{WatchID: watch.ID, OutputID: committedOutputID.ToHex(), Slot: 1, Committed: true}:                  {},
+		{WatchID: watch.ID, OutputID: retractedOutputID.ToHex(), Slot: 1, Committed: true, Retracted: true}: {},
+	}, notifications)
+
+	for _, delivery := range deliveries {
+		require.True(t, delivery.Settled)
+	}
+}
+
+func TestManager_Deliveries_Concurrent(t *testing.T) {
+	idx := newTestIndexer(t)
+	manager := newTestManager(t, idx, webhook.WithTimeout(5*time.Second))
+	defer manager.Close()
+
+	address := iotago_tpkg.RandEd25519Address()
+
+	// The slow target doesn't answer until the test is finished
+	release := make(chan struct{})
+	slowTarget := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer slowTarget.Close()
+	defer close(release)
+
+	_, err := manager.AddWatch(addressFilter(address), slowTarget.URL, "secret")
+	require.NoError(t, err)
+
+	target := newTargetServer(t, "secret", 0)
+	_, err = manager.AddWatch(addressFilter(address), target.URL, "secret")
+	require.NoError(t, err)
+
+	cancel := runManager(t, manager)
+	defer cancel()
+
+	require.NoError(t, idx.CommitLedgerUpdate(&indexer.LedgerUpdate{
+		Slot:    1,
+		Created: []*indexer.LedgerOutput{{OutputID: iotago_tpkg.RandOutputID(0), Output: basicOutputWithAddress(address), BookedAt: 1}},
+	}))
+
+	// The notifications of the other watch are not delayed by the slow target
+	require.Eventually(t, func() bool {
+		return len(target.Notifications()) == 1
+	}, 3*time.Second, 10*time.Millisecond)
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/iotaledger/hive.go/app"
 	"github.com/iotaledger/hive.go/apputils/config"
 	indexerApp "github.com/iotaledger/inx-indexer/components/app"
+	"github.com/iotaledger/inx-indexer/pkg/server"
 )
 
 func createMarkdownFile(app *app.App, markdownHeaderPath string, markdownFilePath string, ignoreFlags map[string]struct{}, replaceTopicNames map[string]string) {
@@ -55,6 +56,18 @@ func createDefaultConfigFile(app *app.App, configFilePath string, ignoreFlags ma
 	println(fmt.Sprintf("Default configuration file for %s stored: %s", app.Info().Name, configFilePath))
 }
 
+func createOpenAPIFile(app *app.App, openAPIFilePath string) {
+	println(fmt.Sprintf("Create OpenAPI document for %s...", app.Info().Name))
+	spec, err := server.OpenAPISpec(app.Info().Version)
+	if err != nil {
+		panic(err)
+	}
+	if err := os.WriteFile(openAPIFilePath, append(spec, '\n'), os.ModePerm); err != nil {
+		panic(err)
+	}
+	println(fmt.Sprintf("OpenAPI document for %s stored: %s", app.Info().Name, openAPIFilePath))
+}
+
 func main() {
 
 	// MUST BE LOWER CASE
@@ -82,4 +95,9 @@ func main() {
 		"../../config_defaults.json",
 		ignoreFlags,
 	)
+
+	createOpenAPIFile(
+		application,
+		"../../openapi.json",
+	)
 }